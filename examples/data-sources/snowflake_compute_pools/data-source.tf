@@ -0,0 +1,2 @@
+data "snowflake_compute_pools" "current" {
+}
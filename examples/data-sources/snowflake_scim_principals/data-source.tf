@@ -0,0 +1,3 @@
+data "snowflake_scim_principals" "okta" {
+  provisioner_role = "OKTA_PROVISIONER"
+}
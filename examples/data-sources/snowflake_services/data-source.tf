@@ -0,0 +1,4 @@
+data "snowflake_services" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -0,0 +1,4 @@
+data "snowflake_streamlits" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -17,9 +17,27 @@ provider "snowflake" {
   role      = "..."
   host      = "..."
   warehouse = "..."
+
+  // optional, for large configurations applied with a high -parallelism
+  max_open_connections      = 10
+  max_idle_connections      = 10
+  connection_max_lifetime   = 3600
+  client_session_keep_alive = true
+  max_concurrent_operations = 20
+  read_cache                = true
 }
 
 
 provider snowflake {
   profile = "securityadmin"
 }
+
+
+provider snowflake {
+  profile = "primary"
+
+  // resources with account_alias = "secondary" are managed in the account of the secondary profile
+  accounts = {
+    secondary = "secondary"
+  }
+}
@@ -11,3 +11,19 @@ resource "snowflake_alert" "alert" {
   enabled   = true
   comment   = "my alert"
 }
+
+resource "snowflake_alert" "alert_on_new_data" {
+  database  = "database"
+  schema    = "schema"
+  name      = "alert_on_new_data"
+  warehouse = "warehouse"
+  condition = "select * from errors"
+  enabled   = true
+
+  email_action {
+    integration = "email_integration"
+    recipients  = ["alerts@example.com"]
+    subject     = "New errors"
+    body        = "New rows were added to the errors table."
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_compute_pool.example computePoolName
@@ -0,0 +1,9 @@
+resource "snowflake_compute_pool" "pool" {
+  name              = "EXAMPLE_COMPUTE_POOL"
+  min_nodes         = 1
+  max_nodes         = 2
+  instance_family   = "CPU_X64_XS"
+  auto_resume       = true
+  auto_suspend_secs = 600
+  comment           = "Compute pool for the example services."
+}
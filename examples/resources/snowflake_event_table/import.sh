@@ -0,0 +1,2 @@
+# format is database name | schema name | event table name
+terraform import snowflake_event_table.example 'dbName|schemaName|eventTableName'
@@ -0,0 +1,25 @@
+resource "snowflake_event_table" "events" {
+  name                        = "EXAMPLE_EVENTS"
+  database                    = "EXAMPLE_DB"
+  schema                      = "EXAMPLE_SCHEMA"
+  data_retention_time_in_days = 7
+  change_tracking             = true
+  comment                     = "Logs and traces of the example functions and procedures."
+}
+
+resource "snowflake_account_parameter" "event_table" {
+  key   = "EVENT_TABLE"
+  value = "${snowflake_event_table.events.database}.${snowflake_event_table.events.schema}.${snowflake_event_table.events.name}"
+}
+
+resource "snowflake_object_parameter" "log_level" {
+  key         = "LOG_LEVEL"
+  value       = "INFO"
+  object_type = "FUNCTION"
+  object_identifier {
+    database  = "EXAMPLE_DB"
+    schema    = "EXAMPLE_SCHEMA"
+    name      = "EXAMPLE_FUNCTION"
+    arguments = ["VARCHAR"]
+  }
+}
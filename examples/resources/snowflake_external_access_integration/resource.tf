@@ -0,0 +1,31 @@
+resource "snowflake_external_access_integration" "integration" {
+  name                           = "EXAMPLE_EXTERNAL_ACCESS_INTEGRATION"
+  allowed_network_rules          = [snowflake_network_rule.rule.qualified_name]
+  allowed_authentication_secrets = [snowflake_secret.password.qualified_name]
+  enabled                        = true
+  comment                        = "Allows access to the example API."
+}
+
+resource "snowflake_function" "function" {
+  name            = "EXAMPLE_FUNCTION"
+  database        = "EXAMPLE_DB"
+  schema          = "EXAMPLE_SCHEMA"
+  language        = "python"
+  runtime_version = "3.8"
+  return_type     = "VARCHAR"
+  handler         = "main"
+  packages        = ["snowflake-snowpark-python", "requests"]
+
+  external_access_integrations = [snowflake_external_access_integration.integration.name]
+  secrets = {
+    cred = snowflake_secret.password.qualified_name
+  }
+
+  statement = <<-EOT
+    import _snowflake
+    import requests
+    def main():
+        cred = _snowflake.get_username_password('cred')
+        return requests.get('https://api.example.com', auth=(cred.username, cred.password)).text
+  EOT
+}
@@ -19,16 +19,16 @@ resource "snowflake_failover_group" "source_failover_group" {
   }
 }
 
-provider "snowflake" {
-  alias = "account2"
-}
-
+// account2 is a profile alias from the accounts provider argument
 resource "snowflake_failover_group" "target_failover_group" {
-  provider = snowflake.account2
-  name     = "FG1"
+  account_alias = "account2"
+  name          = "FG1"
   from_replica {
     organization_name   = "..."
     source_account_name = "..."
     name                = snowflake_failover_group.fg.name
   }
+
+  // set to true to promote the secondary failover group to primary, e.g. during a failover
+  promote_to_primary = false
 }
@@ -0,0 +1,2 @@
+# format is database name | schema name | image repository name
+terraform import snowflake_image_repository.example 'dbName|schemaName|imageRepositoryName'
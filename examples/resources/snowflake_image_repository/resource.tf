@@ -0,0 +1,6 @@
+resource "snowflake_image_repository" "repository" {
+  name     = "EXAMPLE_IMAGE_REPOSITORY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  comment  = "Images for the example services."
+}
@@ -0,0 +1,9 @@
+resource "snowflake_network_rule" "rule" {
+  name       = "EXAMPLE_NETWORK_RULE"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com", "api.example.com:443"]
+  comment    = "Allows egress to the example API."
+}
@@ -10,4 +10,11 @@ resource "snowflake_pipe" "pipe" {
 
   aws_sns_topic_arn    = "..."
   notification_channel = "..."
+
+  paused = false
+
+  refresh_on_create {
+    prefix         = "d1/"
+    modified_after = "2023-01-01T00:00:00Z"
+  }
 }
@@ -0,0 +1,29 @@
+resource "snowflake_database" "db" {
+  name = "db1"
+}
+
+resource "snowflake_replication_group" "source_replication_group" {
+  name              = "RG1"
+  object_types      = ["DATABASES"]
+  allowed_accounts  = ["<org_name>.<target_account_name1>", "<org_name>.<target_account_name2>"]
+  allowed_databases = [snowflake_database.db.name]
+  replication_schedule {
+    interval = 10
+  }
+}
+
+// account2 is a profile alias from the accounts provider argument
+resource "snowflake_replication_group" "target_replication_group" {
+  account_alias = "account2"
+  name          = "RG1"
+  from_replica {
+    organization_name   = "..."
+    source_account_name = "..."
+    name                = snowflake_replication_group.source_replication_group.name
+  }
+
+  // changing any value refreshes the secondary replication group
+  refresh_triggers = {
+    release = "2023-10-01"
+  }
+}
@@ -0,0 +1,3 @@
+# format is resource monitor name | warehouse name for a warehouse, or the resource monitor name for the account
+terraform import snowflake_resource_monitor_attachment.warehouse 'resourceMonitorName|warehouseName'
+terraform import snowflake_resource_monitor_attachment.account 'resourceMonitorName'
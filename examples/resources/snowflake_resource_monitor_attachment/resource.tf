@@ -0,0 +1,9 @@
+resource "snowflake_resource_monitor_attachment" "warehouse" {
+  resource_monitor = snowflake_resource_monitor.monitor.name
+  warehouse        = snowflake_warehouse.warehouse.name
+}
+
+resource "snowflake_resource_monitor_attachment" "account" {
+  resource_monitor = snowflake_resource_monitor.account_monitor.name
+  set_for_account  = true
+}
@@ -0,0 +1,18 @@
+resource "snowflake_secret" "password" {
+  name     = "EXAMPLE_PASSWORD_SECRET"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  type     = "PASSWORD"
+  username = "service_user"
+  password = var.service_password
+  comment  = "Credentials for the external service."
+}
+
+resource "snowflake_secret" "oauth" {
+  name               = "EXAMPLE_OAUTH_SECRET"
+  database           = "EXAMPLE_DB"
+  schema             = "EXAMPLE_SCHEMA"
+  type               = "OAUTH2"
+  api_authentication = "EXAMPLE_SECURITY_INTEGRATION"
+  oauth_scopes       = ["read", "write"]
+}
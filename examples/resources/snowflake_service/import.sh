@@ -0,0 +1,2 @@
+# format is database name | schema name | service name
+terraform import snowflake_service.example 'dbName|schemaName|serviceName'
@@ -0,0 +1,30 @@
+# service with an inline specification
+resource "snowflake_service" "inline" {
+  name          = "EXAMPLE_SERVICE"
+  database      = "EXAMPLE_DB"
+  schema        = "EXAMPLE_SCHEMA"
+  compute_pool  = snowflake_compute_pool.pool.name
+  specification = <<-EOT
+    spec:
+      containers:
+      - name: main
+        image: /example_db/example_schema/example_image_repository/echo:latest
+      endpoints:
+      - name: echo
+        port: 8080
+  EOT
+  min_instances = 1
+  max_instances = 2
+  comment       = "Example service."
+}
+
+# service with a specification file uploaded to a stage
+resource "snowflake_service" "from_stage" {
+  name               = "EXAMPLE_STAGED_SERVICE"
+  database           = "EXAMPLE_DB"
+  schema             = "EXAMPLE_SCHEMA"
+  compute_pool       = snowflake_compute_pool.pool.name
+  stage              = "@EXAMPLE_DB.EXAMPLE_SCHEMA.SPECS"
+  specification_file = "echo_spec.yaml"
+  suspended          = true
+}
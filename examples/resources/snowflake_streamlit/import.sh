@@ -0,0 +1,2 @@
+# format is database name | schema name | streamlit name
+terraform import snowflake_streamlit.example 'dbName|schemaName|streamlitName'
@@ -0,0 +1,10 @@
+resource "snowflake_streamlit" "app" {
+  name            = "EXAMPLE_STREAMLIT"
+  database        = "EXAMPLE_DB"
+  schema          = "EXAMPLE_SCHEMA"
+  root_location   = "@EXAMPLE_DB.EXAMPLE_SCHEMA.EXAMPLE_STAGE/app"
+  main_file       = "streamlit_app.py"
+  query_warehouse = "EXAMPLE_WAREHOUSE"
+  title           = "Example app"
+  comment         = "Example Streamlit app."
+}
@@ -52,3 +52,22 @@ resource "snowflake_task" "test_task" {
   allow_overlapping_execution = true
   enabled                     = true
 }
+
+resource "snowflake_task" "backfill_task" {
+  comment = "serverless task with retries and a graph configuration"
+
+  database = "database"
+  schema   = "schema"
+
+  name          = "backfill_task"
+  schedule      = "60 MINUTE"
+  sql_statement = "call backfill();"
+
+  serverless_task_min_statement_size = "XSMALL"
+  serverless_task_max_statement_size = "MEDIUM"
+  suspend_task_after_num_failures    = 3
+  task_auto_retry_attempts           = 2
+  config                             = jsonencode({ output_dir = "/temp/" })
+  execute_on_change                  = true
+  enabled                            = true
+}
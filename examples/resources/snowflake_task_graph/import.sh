@@ -0,0 +1,2 @@
+# format is database name | schema name | root task name
+terraform import snowflake_task_graph.example 'dbName|schemaName|rootTaskName'
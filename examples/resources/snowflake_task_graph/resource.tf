@@ -0,0 +1,31 @@
+resource "snowflake_task_graph" "graph" {
+  database = "database"
+  schema   = "schema"
+  enabled  = true
+
+  task {
+    name          = "load"
+    warehouse     = "warehouse"
+    schedule      = "10 MINUTE"
+    sql_statement = "CALL load_raw_data()"
+  }
+
+  task {
+    name          = "transform"
+    warehouse     = "warehouse"
+    after         = ["load"]
+    sql_statement = "CALL transform_data()"
+  }
+
+  task {
+    name          = "report"
+    after         = ["transform"]
+    when          = "SYSTEM$STREAM_HAS_DATA('report_stream')"
+    sql_statement = "CALL refresh_reports()"
+  }
+
+  finalizer {
+    name          = "cleanup"
+    sql_statement = "CALL cleanup_staging()"
+  }
+}
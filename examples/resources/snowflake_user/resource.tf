@@ -18,3 +18,13 @@ resource "snowflake_user" "user" {
 
   must_change_password = false
 }
+
+resource "snowflake_user" "service" {
+  name           = "Service User"
+  type           = "SERVICE"
+  rsa_public_key = "..."
+
+  network_policy = "policy"
+  timezone       = "UTC"
+  query_tag      = "service"
+}
@@ -2,4 +2,5 @@ resource "snowflake_warehouse" "warehouse" {
   name           = "test"
   comment        = "foo"
   warehouse_size = "small"
+  state          = "SUSPENDED"
 }
@@ -0,0 +1,14 @@
+resource "snowflake_warehouse_resize" "nightly_load" {
+  warehouse                = "warehouse"
+  warehouse_size           = "LARGE"
+  wait_for_running_queries = true
+  abort_queries_on_timeout = false
+
+  triggers = {
+    load_date = "2023-06-01"
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
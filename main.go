@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 const ProviderAddr = "registry.terraform.io/Snowflake-Labs/snowflake"
@@ -13,9 +15,17 @@ func main() {
 	debug := flag.Bool("debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		Debug:        *debug,
-		ProviderAddr: ProviderAddr,
-		ProviderFunc: provider.Provider,
-	})
+	providerServer, err := provider.ProviderServer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if *debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve(ProviderAddr, providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,105 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var computePoolsSchema = map[string]*schema.Schema{
+	"compute_pools": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The compute pools in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"instance_family": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"num_services": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_resume": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"auto_suspend_secs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ComputePools() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadComputePools,
+		Schema: computePoolsSchema,
+	}
+}
+
+func ReadComputePools(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	account, err := snowflake.ReadCurrentAccount(db)
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
+
+	result, err := client.ComputePools.Show(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	computePools := []map[string]interface{}{}
+
+	for _, computePool := range result {
+		computePoolMap := map[string]interface{}{}
+
+		computePoolMap["name"] = computePool.Name
+		computePoolMap["state"] = string(computePool.State)
+		computePoolMap["min_nodes"] = computePool.MinNodes
+		computePoolMap["max_nodes"] = computePool.MaxNodes
+		computePoolMap["instance_family"] = string(computePool.InstanceFamily)
+		computePoolMap["num_services"] = computePool.NumServices
+		computePoolMap["auto_resume"] = computePool.AutoResume
+		computePoolMap["auto_suspend_secs"] = computePool.AutoSuspendSecs
+		computePoolMap["comment"] = computePool.Comment
+
+		computePools = append(computePools, computePoolMap)
+	}
+
+	return d.Set("compute_pools", computePools)
+}
@@ -0,0 +1,43 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ComputePools(t *testing.T) {
+	computePoolName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: computePools(computePoolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_compute_pools.s", "compute_pools.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_compute_pools.s", "compute_pools.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func computePools(computePoolName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_compute_pool "s" {
+		name            = "%v"
+		min_nodes       = 1
+		max_nodes       = 1
+		instance_family = "CPU_X64_XS"
+		suspended       = true
+	}
+
+	data snowflake_compute_pools "s" {
+		depends_on = [snowflake_compute_pool.s]
+	}
+	`, computePoolName)
+}
@@ -1,7 +1,10 @@
 package datasources_test
 
 import (
+	"context"
+
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,3 +14,17 @@ func providers() map[string]*schema.Provider {
 		"snowflake": p,
 	}
 }
+
+// protoV5ProviderFactories serves the provider the way main does, muxing the SDKv2 provider with the plugin framework
+// provider. Configurations using resources built on the plugin framework need it.
+func protoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"snowflake": func() (tfprotov5.ProviderServer, error) {
+			providerServer, err := provider.ProviderServer(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
+	}
+}
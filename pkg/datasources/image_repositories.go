@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var imageRepositoriesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the image repositories from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the image repositories from.",
+	},
+	"image_repositories": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The image repositories in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"repository_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func ImageRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadImageRepositories,
+		Schema: imageRepositoriesSchema,
+	}
+}
+
+func ReadImageRepositories(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	imageRepositories, err := client.ImageRepositories.Show(ctx, &sdk.ImageRepositoryShowOptions{
+		In: &sdk.In{
+			Schema: sdk.NewSchemaIdentifier(databaseName, schemaName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	imageRepositoriesList := []map[string]interface{}{}
+	for _, imageRepository := range imageRepositories {
+		imageRepositoryMap := map[string]interface{}{}
+		imageRepositoryMap["name"] = imageRepository.Name
+		imageRepositoryMap["database"] = imageRepository.DatabaseName
+		imageRepositoryMap["schema"] = imageRepository.SchemaName
+		imageRepositoryMap["repository_url"] = imageRepository.RepositoryURL
+		imageRepositoryMap["comment"] = imageRepository.Comment
+		imageRepositoriesList = append(imageRepositoriesList, imageRepositoryMap)
+	}
+	if err := d.Set("image_repositories", imageRepositoriesList); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return nil
+}
@@ -0,0 +1,57 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ImageRepositories(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	imageRepositoryName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: imageRepositories(databaseName, schemaName, imageRepositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_image_repositories.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_image_repositories.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_image_repositories.t", "image_repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_image_repositories.t", "image_repositories.0.name", imageRepositoryName),
+					resource.TestCheckResourceAttrSet("data.snowflake_image_repositories.t", "image_repositories.0.repository_url"),
+				),
+			},
+		},
+	})
+}
+
+func imageRepositories(databaseName string, schemaName string, imageRepositoryName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_image_repository "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+	}
+
+	data snowflake_image_repositories "t" {
+		database   = snowflake_image_repository.test.database
+		schema     = snowflake_image_repository.test.schema
+		depends_on = [snowflake_image_repository.test]
+	}
+	`, databaseName, schemaName, imageRepositoryName)
+}
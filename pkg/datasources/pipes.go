@@ -49,6 +49,21 @@ var pipesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"execution_state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Current execution state of the pipe (e.g. RUNNING, PAUSED, STOPPED_STAGE_DROPPED).",
+				},
+				"pending_file_count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of files queued for loading by the pipe.",
+				},
+				"last_ingested_timestamp": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Timestamp when the most recent file was loaded successfully by the pipe.",
+				},
 			},
 		},
 	},
@@ -89,6 +104,16 @@ func ReadPipes(d *schema.ResourceData, meta interface{}) error {
 		pipeMap["comment"] = pipe.Comment
 		pipeMap["integration"] = pipe.Integration.String
 
+		// the status needs MONITOR or OPERATE on the pipe, the pipes without it are listed without their status
+		status, err := snowflake.ScanPipeStatus(snowflake.QueryRow(db, snowflake.NewPipeBuilder(pipe.Name, pipe.DatabaseName, pipe.SchemaName).Status()))
+		if err != nil {
+			log.Printf("[WARN] could not read status of pipe %v: %v", pipe.Name, err)
+		} else {
+			pipeMap["execution_state"] = status.ExecutionState
+			pipeMap["pending_file_count"] = status.PendingFileCount
+			pipeMap["last_ingested_timestamp"] = status.LastIngestedTimestamp
+		}
+
 		pipes = append(pipes, pipeMap)
 	}
 
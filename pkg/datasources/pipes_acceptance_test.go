@@ -25,6 +25,7 @@ func TestAcc_Pipes(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_pipes.t", "pipes.#"),
 					resource.TestCheckResourceAttr("data.snowflake_pipes.t", "pipes.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_pipes.t", "pipes.0.name", pipeName),
+					resource.TestCheckResourceAttr("data.snowflake_pipes.t", "pipes.0.execution_state", "RUNNING"),
 				),
 			},
 		},
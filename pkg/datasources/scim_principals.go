@@ -0,0 +1,134 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var scimPrincipalsSchema = map[string]*schema.Schema{
+	"provisioner_role": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Only return the principals owned by this SCIM provisioner role, one of OKTA_PROVISIONER, AAD_PROVISIONER or GENERIC_SCIM_PROVISIONER. By default, the principals owned by any of them are returned.",
+		ValidateFunc: validation.StringInSlice(snowflake.SCIMProvisionerRoles, true),
+	},
+	"users": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The users provisioned by an identity provider through SCIM.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"login_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"disabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The SCIM provisioner role owning the user.",
+				},
+			},
+		},
+	},
+	"roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The roles provisioned by an identity provider through SCIM.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The SCIM provisioner role owning the role.",
+				},
+			},
+		},
+	},
+}
+
+// SCIMPrincipals lists the users and roles owned by SCIM provisioner roles.
+func SCIMPrincipals() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSCIMPrincipals,
+		Schema: scimPrincipalsSchema,
+	}
+}
+
+// ReadSCIMPrincipals implements schema.ReadFunc.
+func ReadSCIMPrincipals(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	provisionerRole := d.Get("provisioner_role").(string)
+
+	isOwner := func(owner string) bool {
+		if provisionerRole != "" {
+			return strings.EqualFold(owner, provisionerRole)
+		}
+		return snowflake.IsSCIMProvisionerRole(owner)
+	}
+
+	allUsers, err := client.Users.Show(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("error listing users err = %w", err)
+	}
+	users := []map[string]interface{}{}
+	for _, user := range allUsers {
+		if !isOwner(user.Owner) {
+			continue
+		}
+		users = append(users, map[string]interface{}{
+			"name":       user.Name,
+			"login_name": user.LoginName,
+			"disabled":   user.Disabled,
+			"owner":      user.Owner,
+		})
+	}
+
+	allRoles, err := snowflake.ListRoles(db, "")
+	if err != nil {
+		return fmt.Errorf("error listing roles err = %w", err)
+	}
+	roles := []map[string]interface{}{}
+	for _, role := range allRoles {
+		if !role.Name.Valid || !isOwner(role.Owner.String) {
+			continue
+		}
+		roles = append(roles, map[string]interface{}{
+			"name":    role.Name.String,
+			"comment": role.Comment.String,
+			"owner":   role.Owner.String,
+		})
+	}
+
+	d.SetId("scim_principals")
+	if provisionerRole != "" {
+		d.SetId(fmt.Sprintf("scim_principals_%s", strings.ToUpper(provisionerRole)))
+	}
+	if err := d.Set("users", users); err != nil {
+		return err
+	}
+	return d.Set("roles", roles)
+}
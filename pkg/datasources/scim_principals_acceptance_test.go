@@ -0,0 +1,32 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_SCIMPrincipals(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: scimPrincipals(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_scim_principals.p", "provisioner_role", "GENERIC_SCIM_PROVISIONER"),
+					resource.TestCheckResourceAttrSet("data.snowflake_scim_principals.p", "users.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_scim_principals.p", "roles.#"),
+				),
+			},
+		},
+	})
+}
+
+func scimPrincipals() string {
+	return `
+	data "snowflake_scim_principals" "p" {
+		provisioner_role = "GENERIC_SCIM_PROVISIONER"
+	}
+	`
+}
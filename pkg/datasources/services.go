@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var servicesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the services from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the services from.",
+	},
+	"services": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The services in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"compute_pool": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"dns_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Services() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadServices,
+		Schema: servicesSchema,
+	}
+}
+
+func ReadServices(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	services, err := client.Services.Show(ctx, &sdk.ServiceShowOptions{
+		In: &sdk.In{
+			Schema: sdk.NewSchemaIdentifier(databaseName, schemaName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	servicesList := []map[string]interface{}{}
+	for _, service := range services {
+		serviceMap := map[string]interface{}{}
+		serviceMap["name"] = service.Name
+		serviceMap["database"] = service.DatabaseName
+		serviceMap["schema"] = service.SchemaName
+		serviceMap["compute_pool"] = service.ComputePool
+		serviceMap["status"] = string(service.Status)
+		serviceMap["dns_name"] = service.DNSName
+		serviceMap["min_instances"] = service.MinInstances
+		serviceMap["max_instances"] = service.MaxInstances
+		serviceMap["comment"] = service.Comment
+		servicesList = append(servicesList, serviceMap)
+	}
+	if err := d.Set("services", servicesList); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return nil
+}
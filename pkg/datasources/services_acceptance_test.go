@@ -0,0 +1,47 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Services(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: services(databaseName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_services.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_services.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_services.t", "services.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func services(databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	data snowflake_services "t" {
+		database   = snowflake_schema.test.database
+		schema     = snowflake_schema.test.name
+	}
+	`, databaseName, schemaName)
+}
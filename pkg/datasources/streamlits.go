@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the streamlits from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the streamlits from.",
+	},
+	"streamlits": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The streamlits in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"query_warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"url_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func Streamlits() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadStreamlits,
+		Schema: streamlitsSchema,
+	}
+}
+
+func ReadStreamlits(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	streamlits, err := client.Streamlits.Show(ctx, &sdk.StreamlitShowOptions{
+		In: &sdk.In{
+			Schema: sdk.NewSchemaIdentifier(databaseName, schemaName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	streamlitsList := []map[string]interface{}{}
+	for _, streamlit := range streamlits {
+		streamlitMap := map[string]interface{}{}
+		streamlitMap["name"] = streamlit.Name
+		streamlitMap["database"] = streamlit.DatabaseName
+		streamlitMap["schema"] = streamlit.SchemaName
+		streamlitMap["title"] = streamlit.Title
+		streamlitMap["query_warehouse"] = streamlit.QueryWarehouse
+		streamlitMap["url_id"] = streamlit.URLID
+		streamlitMap["comment"] = streamlit.Comment
+		streamlitsList = append(streamlitsList, streamlitMap)
+	}
+	if err := d.Set("streamlits", streamlitsList); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return nil
+}
@@ -0,0 +1,64 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Streamlits(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	streamlitName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: streamlits(databaseName, schemaName, streamlitName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.t", "streamlits.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_streamlits.t", "streamlits.0.name", streamlitName),
+				),
+			},
+		},
+	})
+}
+
+func streamlits(databaseName string, schemaName string, streamlitName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_stage "test" {
+		name     = "STREAMLIT_STAGE"
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+	}
+
+	resource snowflake_streamlit "test" {
+		name          = "%v"
+		database      = snowflake_database.test.name
+		schema        = snowflake_schema.test.name
+		root_location = "@${snowflake_database.test.name}.${snowflake_schema.test.name}.${snowflake_stage.test.name}"
+		main_file     = "streamlit_app.py"
+	}
+
+	data snowflake_streamlits "t" {
+		database   = snowflake_streamlit.test.database
+		schema     = snowflake_streamlit.test.schema
+		depends_on = [snowflake_streamlit.test]
+	}
+	`, databaseName, schemaName, streamlitName)
+}
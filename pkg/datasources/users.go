@@ -1,14 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"log"
-	"strings"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -90,6 +89,59 @@ var usersSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the user, one of SERVICE, LEGACY_SERVICE or PERSON, empty when not set.",
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"must_change_password": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"snowflake_lock": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"has_password": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"has_mfa": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"mins_to_unlock": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"days_to_expiry": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"mins_to_bypass_mfa": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"last_success_login": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"expires_at_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"locked_until_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -107,6 +159,7 @@ func Users() *schema.Resource {
 
 func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
 	userPattern := d.Get("pattern").(string)
 
 	account, err := snowflake.ReadCurrentAccount(db)
@@ -118,13 +171,10 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentUsers, err := snowflake.ListUsers(userPattern, db)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] no users found in account (%s)", d.Id())
-		d.SetId("")
-		return nil
-	} else if err != nil {
+	currentUsers, err := client.Users.Show(context.Background(), &sdk.UserShowOptions{
+		Like: &sdk.Like{Pattern: sdk.String(userPattern)},
+	})
+	if err != nil {
 		log.Printf("[DEBUG] unable to parse users in account (%s)", d.Id())
 		d.SetId("")
 		return nil
@@ -134,23 +184,43 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 
 	for _, user := range currentUsers {
 		userMap := map[string]interface{}{}
-		userMap["name"] = user.Name.String
-		userMap["login_name"] = user.LoginName.String
-		userMap["comment"] = user.Comment.String
+		userMap["name"] = user.Name
+		userMap["login_name"] = user.LoginName
+		userMap["comment"] = user.Comment
 		userMap["disabled"] = user.Disabled
-		userMap["default_warehouse"] = user.DefaultWarehouse.String
-		userMap["default_namespace"] = user.DefaultNamespace.String
-		userMap["default_role"] = user.DefaultRole.String
-		userMap["default_secondary_roles"] = strings.Split(
-			helpers.ListContentToString(user.DefaultSecondaryRoles.String), ",")
-		userMap["has_rsa_public_key"] = user.HasRsaPublicKey
-		userMap["email"] = user.Email.String
-		userMap["display_name"] = user.DisplayName.String
-		userMap["first_name"] = user.FirstName.String
-		userMap["last_name"] = user.LastName.String
+		userMap["default_warehouse"] = user.DefaultWarehouse
+		userMap["default_namespace"] = user.DefaultNamespace
+		userMap["default_role"] = user.DefaultRole
+		userMap["default_secondary_roles"] = user.DefaultSecondaryRoles
+		userMap["has_rsa_public_key"] = user.HasRSAPublicKey
+		userMap["email"] = user.Email
+		userMap["display_name"] = user.DisplayName
+		userMap["first_name"] = user.FirstName
+		userMap["last_name"] = user.LastName
+		userMap["type"] = string(user.Type)
+		userMap["owner"] = user.Owner
+		userMap["created_on"] = formatUserTime(user.CreatedOn)
+		userMap["must_change_password"] = user.MustChangePassword
+		userMap["snowflake_lock"] = user.SnowflakeLock
+		userMap["has_password"] = user.HasPassword
+		userMap["has_mfa"] = user.HasMFA
+		userMap["mins_to_unlock"] = user.MinsToUnlock
+		userMap["days_to_expiry"] = user.DaysToExpiry
+		userMap["mins_to_bypass_mfa"] = user.MinsToBypassMFA
+		userMap["last_success_login"] = formatUserTime(user.LastSuccessLogin)
+		userMap["expires_at_time"] = formatUserTime(user.ExpiresAtTime)
+		userMap["locked_until_time"] = formatUserTime(user.LockedUntilTime)
 
 		users = append(users, userMap)
 	}
 
 	return d.Set("users", users)
 }
+
+// formatUserTime formats the timestamps of users, which are empty when not set.
+func formatUserTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
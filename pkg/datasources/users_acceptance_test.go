@@ -21,6 +21,8 @@ func TestAccUsers(t *testing.T) {
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.name", userName),
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.disabled", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.type", "PERSON"),
+					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.has_password", "false"),
 				),
 			},
 		},
@@ -42,6 +44,7 @@ func users(userName string) string {
 		default_role="foo"
 		default_secondary_roles = ["ALL"]
 		default_namespace="foo"
+		type = "PERSON"
 	}
 
 	data snowflake_users "u" {
@@ -3,14 +3,18 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 
 	"github.com/luna-duclos/instrumentedsql"
 	"github.com/snowflakedb/gosnowflake"
 )
 
+var instrumentedDriver driver.Driver
+
 func init() {
 	re := regexp.MustCompile(`\r?\n`)
 
@@ -19,9 +23,88 @@ func init() {
 		log.Println(re.ReplaceAllString(s, " "))
 	})
 
-	sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+	instrumentedDriver = instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger))
+	sql.Register("snowflake-instrumented", instrumentedDriver)
 }
 
 func Open(dsn string) (*sql.DB, error) {
 	return sql.Open("snowflake-instrumented", dsn)
 }
+
+// OpenWithDSNFunc opens a database whose connections are each opened with a DSN built by dsnFunc, so that short-lived
+// credentials, e.g. a token file rotated by the CI system, are read again whenever a connection is (re)established.
+func OpenWithDSNFunc(dsnFunc func() (string, error)) *sql.DB {
+	return sql.OpenDB(&dsnFuncConnector{dsnFunc: dsnFunc})
+}
+
+type dsnFuncConnector struct {
+	dsnFunc func() (string, error)
+}
+
+func (c *dsnFuncConnector) Connect(_ context.Context) (driver.Conn, error) {
+	dsn, err := c.dsnFunc()
+	if err != nil {
+		return nil, err
+	}
+	return instrumentedDriver.Open(dsn)
+}
+
+func (c *dsnFuncConnector) Driver() driver.Driver {
+	return instrumentedDriver
+}
+
+// Connection is the meta of a provider configuration: the connection configured by its arguments, the connections to
+// the additional accounts of its accounts argument, and the limit of concurrent operations.
+type Connection struct {
+	DB         *sql.DB
+	accounts   map[string]*sql.DB
+	operations chan struct{}
+}
+
+// NewConnection returns the meta of a provider configuration connected with db, with the given connections to
+// additional accounts by alias, and running at most maxOperations operations concurrently. A limit of 0 or less does
+// not limit the operations.
+func NewConnection(db *sql.DB, accounts map[string]*sql.DB, maxOperations int) *Connection {
+	c := &Connection{DB: db, accounts: accounts}
+	if maxOperations > 0 {
+		c.operations = make(chan struct{}, maxOperations)
+	}
+	return c
+}
+
+// Account returns the connection to the additional account with the given alias.
+func (c *Connection) Account(alias string) (*sql.DB, error) {
+	if conn, ok := c.accounts[alias]; ok {
+		return conn, nil
+	}
+	known := make([]string, 0, len(c.accounts))
+	for k := range c.accounts {
+		known = append(known, k)
+	}
+	sort.Strings(known)
+	return nil, fmt.Errorf("account alias %q is not configured in the provider accounts, known aliases: %v", alias, known)
+}
+
+// AcquireOperation waits until an operation can run, and returns the function releasing it once the operation is done.
+func (c *Connection) AcquireOperation(ctx context.Context) (func(), error) {
+	if c.operations == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.operations <- struct{}{}:
+		return func() { <-c.operations }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SupportsMultiStatements reports whether db connects through the Snowflake driver, which runs a batch of statements
+// in a single request when the context of the request allows it with gosnowflake.WithMultiStatement.
+func SupportsMultiStatements(db *sql.DB) bool {
+	switch db.Driver().(type) {
+	case instrumentedsql.WrappedDriver, *instrumentedsql.WrappedDriver:
+		return true
+	default:
+		return false
+	}
+}
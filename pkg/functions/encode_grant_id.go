@@ -0,0 +1,87 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &encodeGrantIDFunction{}
+
+// NewEncodeGrantIDFunction returns the encode_grant_id function, which builds the ID of a grant resource the way the
+// resource does, e.g. encode_grant_id("db", "USAGE", false, ["role"], []) returns db|USAGE|false|role|.
+func NewEncodeGrantIDFunction() function.Function {
+	return &encodeGrantIDFunction{}
+}
+
+type encodeGrantIDFunction struct{}
+
+func (f *encodeGrantIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_grant_id"
+}
+
+func (f *encodeGrantIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the ID of a grant resource.",
+		Description: "Joins the attributes of a grant with pipes, in the order the grant resource uses for its ID. Lists of roles or shares are joined with commas and a null attribute is empty, e.g. encode_grant_id(\"db\", \"USAGE\", false, [\"role\"], []) returns db|USAGE|false|role|.",
+		VariadicParameter: function.DynamicParameter{
+			Name:           "attributes",
+			Description:    "The attributes making up the ID: strings, bools or lists of strings.",
+			AllowNullValue: true,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *encodeGrantIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arguments []types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &arguments)
+	if resp.Error != nil {
+		return
+	}
+	attributes := make([]interface{}, 0, len(arguments))
+	for i, argument := range arguments {
+		attribute, err := grantIDAttribute(argument)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), err.Error())
+			return
+		}
+		attributes = append(attributes, attribute)
+	}
+	resp.Error = resp.Result.Set(ctx, helpers.EncodeSnowflakeID(attributes...))
+}
+
+// grantIDAttribute converts the value of an attribute to the types helpers.EncodeSnowflakeID encodes.
+func grantIDAttribute(argument types.Dynamic) (interface{}, error) {
+	if argument.IsNull() || argument.IsUnderlyingValueNull() {
+		return "", nil
+	}
+	var elements []attr.Value
+	switch v := argument.UnderlyingValue().(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("attributes must be strings, bools or lists of strings")
+	}
+	list := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return nil, fmt.Errorf("lists must contain known strings only")
+		}
+		list = append(list, s.ValueString())
+	}
+	return list, nil
+}
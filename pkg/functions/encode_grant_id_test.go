@@ -0,0 +1,80 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeGrantIDFunction(t *testing.T) {
+	roles := types.TupleValueMust(
+		[]attr.Type{types.StringType, types.StringType},
+		[]attr.Value{types.StringValue("role1"), types.StringValue("role2")},
+	)
+	shares := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("share1")})
+	testCases := []struct {
+		name       string
+		attributes []attr.Value
+		expected   string
+		err        string
+	}{
+		{
+			name:       "database grant",
+			attributes: []attr.Value{types.StringValue("db"), types.StringValue("USAGE"), types.BoolValue(false), roles, shares},
+			expected:   "db|USAGE|false|role1,role2|share1",
+		},
+		{
+			name:       "account grant",
+			attributes: []attr.Value{types.StringValue("CREATE DATABASE"), types.BoolValue(true), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("role1")})},
+			expected:   "CREATE DATABASE|true|role1",
+		},
+		{
+			name:       "empty list and null",
+			attributes: []attr.Value{types.StringValue("db"), types.TupleValueMust([]attr.Type{}, []attr.Value{}), types.DynamicNull()},
+			expected:   "db||",
+		},
+		{
+			name:       "number",
+			attributes: []attr.Value{types.StringValue("db"), types.Int64Value(1)},
+			err:        "attributes must be strings, bools or lists of strings",
+		},
+		{
+			name:       "list of bools",
+			attributes: []attr.Value{types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)})},
+			err:        "lists must contain known strings only",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			values := make([]attr.Value, 0, len(tc.attributes))
+			elementTypes := make([]attr.Type, 0, len(tc.attributes))
+			for _, attribute := range tc.attributes {
+				if d, ok := attribute.(types.Dynamic); ok {
+					values = append(values, d)
+				} else {
+					values = append(values, types.DynamicValue(attribute))
+				}
+				elementTypes = append(elementTypes, types.DynamicType)
+			}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.TupleValueMust(elementTypes, values)}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			functions.NewEncodeGrantIDFunction().Run(context.Background(), req, resp)
+			if tc.err != "" {
+				r.NotNil(resp.Error)
+				r.Equal(tc.err, resp.Error.Text)
+				return
+			}
+			r.Nil(resp.Error)
+			r.Equal(types.StringValue(tc.expected), resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &fullyQualifiedNameFunction{}
+
+// NewFullyQualifiedNameFunction returns the fully_qualified_name function, which quotes and joins the names of an
+// object, e.g. fully_qualified_name("db", "schema", "name") returns "db"."schema"."name".
+func NewFullyQualifiedNameFunction() function.Function {
+	return &fullyQualifiedNameFunction{}
+}
+
+type fullyQualifiedNameFunction struct{}
+
+func (f *fullyQualifiedNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fully_qualified_name"
+}
+
+func (f *fullyQualifiedNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the fully qualified name of an object.",
+		Description: "Quotes and joins the database, schema, object and column names of an object, as many as are given, e.g. fully_qualified_name(\"db\", \"schema\", \"name\") returns \"db\".\"schema\".\"name\". Quotes within the names are doubled.",
+		VariadicParameter: function.StringParameter{
+			Name:        "names",
+			Description: "The 1 to 4 names making up the identifier, starting with the database name.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *fullyQualifiedNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var names []string
+	resp.Error = req.Arguments.Get(ctx, &names)
+	if resp.Error != nil {
+		return
+	}
+	id, err := sdk.NewObjectIdentifierFromParts(names...)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, id.FullyQualifiedName())
+}
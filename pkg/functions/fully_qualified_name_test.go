@@ -0,0 +1,56 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullyQualifiedNameFunction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		names    []string
+		expected string
+		err      string
+	}{
+		{name: "database", names: []string{"db"}, expected: `"db"`},
+		{name: "schema", names: []string{"db", "schema"}, expected: `"db"."schema"`},
+		{name: "schema object", names: []string{"db", "schema", "name"}, expected: `"db"."schema"."name"`},
+		{name: "column", names: []string{"db", "schema", "table", "column"}, expected: `"db"."schema"."table"."column"`},
+		{name: "dotted name", names: []string{"db", "schema", "a.b"}, expected: `"db"."schema"."a.b"`},
+		{name: "quote within name", names: []string{"db", "schema", `a"b`}, expected: `"db"."schema"."a""b"`},
+		{name: "trailing quote", names: []string{"db", `schema"`}, expected: `"db"."schema"""`},
+		{name: "no names", names: []string{}, err: "an identifier has 1 to 4 parts, got 0"},
+		{name: "too many names", names: []string{"a", "b", "c", "d", "e"}, err: "an identifier has 1 to 4 parts, got 5"},
+		{name: "empty name", names: []string{"db", ""}, err: "part 2 of the identifier is empty"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			values := make([]attr.Value, 0, len(tc.names))
+			elementTypes := make([]attr.Type, 0, len(tc.names))
+			for _, name := range tc.names {
+				values = append(values, types.StringValue(name))
+				elementTypes = append(elementTypes, types.StringType)
+			}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.TupleValueMust(elementTypes, values)}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			functions.NewFullyQualifiedNameFunction().Run(context.Background(), req, resp)
+			if tc.err != "" {
+				r.NotNil(resp.Error)
+				r.Equal(tc.err, resp.Error.Text)
+				return
+			}
+			r.Nil(resp.Error)
+			r.Equal(types.StringValue(tc.expected), resp.Result.Value())
+		})
+	}
+}
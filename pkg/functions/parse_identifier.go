@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseIdentifierFunction{}
+
+// NewParseIdentifierFunction returns the parse_identifier function, which splits a fully qualified name into its
+// names, e.g. parse_identifier("\"db\".\"schema\".\"name\"") returns ["db", "schema", "name"].
+func NewParseIdentifierFunction() function.Function {
+	return &parseIdentifierFunction{}
+}
+
+type parseIdentifierFunction struct{}
+
+func (f *parseIdentifierFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_identifier"
+}
+
+func (f *parseIdentifierFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Splits a fully qualified name into its names.",
+		Description: "Splits a fully qualified name, quoted or not, into its database, schema, object and column names. Dots within quoted names belong to the name, and doubled quotes stand for a quote.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "identifier",
+				Description: "The fully qualified name, e.g. \"db\".\"schema\".\"name\" or db.schema.name.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+	resp.Error = req.Arguments.Get(ctx, &identifier)
+	if resp.Error != nil {
+		return
+	}
+	id, err := sdk.ParseObjectIdentifier(identifier)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, sdk.ObjectIdentifierParts(id))
+}
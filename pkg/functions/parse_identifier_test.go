@@ -0,0 +1,72 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdentifierFunction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		identifier string
+		expected   []string
+		err        string
+	}{
+		{name: "unquoted", identifier: "db.schema.name", expected: []string{"db", "schema", "name"}},
+		{name: "quoted", identifier: `"db"."schema"."name"`, expected: []string{"db", "schema", "name"}},
+		{name: "database", identifier: `"db"`, expected: []string{"db"}},
+		{name: "column", identifier: `"db"."schema"."table"."column"`, expected: []string{"db", "schema", "table", "column"}},
+		{name: "dot within quotes", identifier: `"db"."schema"."a.b"`, expected: []string{"db", "schema", "a.b"}},
+		{name: "doubled quotes", identifier: `"db"."schema"."a""b"`, expected: []string{"db", "schema", `a"b`}},
+		{name: "quoted quotes", identifier: `"db"."schema"."""a"""`, expected: []string{"db", "schema", `"a"`}},
+		{name: "unterminated quote", identifier: `"db"."schema`, err: `unterminated quoted part in identifier "db"."schema`},
+		{name: "empty part", identifier: "db..name", err: "invalid identifier db..name: part 2 of the identifier is empty"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.identifier)}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+			functions.NewParseIdentifierFunction().Run(context.Background(), req, resp)
+			if tc.err != "" {
+				r.NotNil(resp.Error)
+				r.Equal(tc.err, resp.Error.Text)
+				return
+			}
+			r.Nil(resp.Error)
+			expected, diags := types.ListValueFrom(context.Background(), types.StringType, tc.expected)
+			r.False(diags.HasError())
+			r.Equal(expected, resp.Result.Value())
+
+			// the names build back the identifier they were parsed from
+			values := make([]attr.Value, 0, len(tc.expected))
+			elementTypes := make([]attr.Type, 0, len(tc.expected))
+			for _, name := range tc.expected {
+				values = append(values, types.StringValue(name))
+				elementTypes = append(elementTypes, types.StringType)
+			}
+			fqnReq := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.TupleValueMust(elementTypes, values)}),
+			}
+			fqnResp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			functions.NewFullyQualifiedNameFunction().Run(context.Background(), fqnReq, fqnResp)
+			r.Nil(fqnResp.Error)
+			reparseReq := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{fqnResp.Result.Value()}),
+			}
+			reparseResp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+			functions.NewParseIdentifierFunction().Run(context.Background(), reparseReq, reparseResp)
+			r.Nil(reparseResp.Error)
+			r.Equal(expected, reparseResp.Result.Value())
+		})
+	}
+}
@@ -82,6 +82,49 @@ func EncodeSnowflakeID(attributes ...interface{}) string {
 	return strings.Join(parts, "|")
 }
 
+// storageURLSchemes lists the schemes of the external stage URLs and of the storage integration locations.
+var storageURLSchemes = []string{"s3://", "s3gov://", "s3china://", "gcs://", "azure://"}
+
+// IsStorageURL tells whether the URL points to external cloud storage (S3, GCS or Azure).
+func IsStorageURL(url string) bool {
+	for _, scheme := range storageURLSchemes {
+		if len(url) >= len(scheme) && strings.EqualFold(url[:len(scheme)], scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesURLPrefix tells whether the storage URL is under the given location, like the STORAGE_ALLOWED_LOCATIONS and
+// STORAGE_BLOCKED_LOCATIONS of a storage integration. The scheme is compared case-insensitively, the location matches
+// whole path segments (s3://bucket/path matches s3://bucket/path/file but not s3://bucket/pathology) and * matches
+// any URL.
+func MatchesURLPrefix(url string, location string) bool {
+	location = strings.TrimSpace(location)
+	if location == "*" {
+		return true
+	}
+	if !IsStorageURL(url) || !IsStorageURL(location) {
+		return false
+	}
+	normalize := func(s string) string {
+		i := strings.Index(s, "://")
+		s = strings.ToLower(s[:i]) + s[i:]
+		return strings.TrimSuffix(s, "/") + "/"
+	}
+	return strings.HasPrefix(normalize(url), normalize(location))
+}
+
+// MatchingURLPrefix returns the first of the locations the storage URL is under.
+func MatchingURLPrefix(url string, locations []string) (string, bool) {
+	for _, location := range locations {
+		if MatchesURLPrefix(url, location) {
+			return location, true
+		}
+	}
+	return "", false
+}
+
 func DecodeSnowflakeID(id string) sdk.ObjectIdentifier {
 	parts := strings.Split(id, IDDelimiter)
 	switch len(parts) {
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/functions"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+)
+
+var (
+	_ fwprovider.Provider              = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions = &frameworkProvider{}
+)
+
+// frameworkProvider serves the resources built on the plugin framework. It is muxed with the SDKv2 provider, whose
+// configuration it accepts and whose connection it shares, so that resources can be migrated one at a time.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+// NewFrameworkProvider returns the plugin framework provider sharing the connection of the given SDKv2 provider. The
+// SDKv2 provider must be configured first, which the mux server does by configuring its servers in order.
+func NewFrameworkProvider(sdkProvider *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "snowflake"
+}
+
+// Schema returns the schema of the SDKv2 provider, since muxed providers must accept the same configuration.
+func (p *frameworkProvider) Schema(ctx context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	s, err := frameworkProviderSchema(ctx, p.sdkProvider)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not build the provider schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	conn, ok := p.sdkProvider.Meta().(*snowflakedb.Connection)
+	if !ok {
+		// the configuration is not known yet, e.g. during validation
+		return
+	}
+	resp.ResourceData = conn
+	resp.DataSourceData = conn
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		resources.NewDatabaseRoleResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewFullyQualifiedNameFunction,
+		functions.NewParseIdentifierFunction,
+		functions.NewEncodeGrantIDFunction,
+	}
+}
+
+// frameworkProviderSchema converts the protocol schema of the SDKv2 provider to a plugin framework schema, which
+// serves the same protocol schema again.
+func frameworkProviderSchema(ctx context.Context, sdkProvider *schema.Provider) (fwschema.Schema, error) {
+	resp, err := schema.NewGRPCProviderServer(sdkProvider).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return fwschema.Schema{}, err
+	}
+	block := resp.Provider.Block
+	if len(block.BlockTypes) > 0 {
+		return fwschema.Schema{}, fmt.Errorf("nested blocks are not supported in the provider schema")
+	}
+	attributes := make(map[string]fwschema.Attribute, len(block.Attributes))
+	for _, a := range block.Attributes {
+		deprecationMessage := ""
+		if a.Deprecated {
+			deprecationMessage = fmt.Sprintf("%v is deprecated.", a.Name)
+		}
+		switch {
+		case a.Type.Is(tftypes.String):
+			attributes[a.Name] = fwschema.StringAttribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		case a.Type.Is(tftypes.Bool):
+			attributes[a.Name] = fwschema.BoolAttribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		case a.Type.Is(tftypes.Number):
+			attributes[a.Name] = fwschema.Int64Attribute{
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		case a.Type.Is(tftypes.Map{ElementType: tftypes.String}):
+			attributes[a.Name] = fwschema.MapAttribute{
+				ElementType:        types.StringType,
+				Required:           a.Required,
+				Optional:           a.Optional,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		default:
+			return fwschema.Schema{}, fmt.Errorf("type %v of provider attribute %v is not supported", a.Type, a.Name)
+		}
+	}
+	return fwschema.Schema{
+		Description: block.Description,
+		Attributes:  attributes,
+	}, nil
+}
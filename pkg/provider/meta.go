@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+// accountAliasGetter reads the account_alias argument from a schema.ResourceData or a schema.ResourceDiff.
+type accountAliasGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// accountDB returns the connection to the account selected by the account_alias argument of a resource, or the
+// provider connection if the resource does not have the argument or it is not set.
+func accountDB(d accountAliasGetter, aliased bool, meta interface{}) (*sql.DB, error) {
+	conn := meta.(*snowflakedb.Connection)
+	if !aliased {
+		return conn.DB, nil
+	}
+	alias, ok := d.GetOk("account_alias")
+	if !ok {
+		return conn.DB, nil
+	}
+	return conn.Account(alias.(string))
+}
+
+// withConnection wraps the functions of the resources that take the provider meta, which is a
+// *snowflakedb.Connection, so that they are called with the *sql.DB of the account the resource is managed in.
+func withConnection(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, r := range resources {
+		_, aliased := r.Schema["account_alias"]
+		if r.Create != nil {
+			r.Create = withAccountDB(r.Create, aliased)
+		}
+		if r.Read != nil {
+			r.Read = withAccountDB(r.Read, aliased)
+		}
+		if r.Update != nil {
+			r.Update = withAccountDB(r.Update, aliased)
+		}
+		if r.Delete != nil {
+			r.Delete = withAccountDB(r.Delete, aliased)
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = withContextAccountDB(r.CreateContext, aliased)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = withContextAccountDB(r.ReadContext, aliased)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = withContextAccountDB(r.UpdateContext, aliased)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = withContextAccountDB(r.DeleteContext, aliased)
+		}
+		if r.CustomizeDiff != nil {
+			customizeDiff := r.CustomizeDiff
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				db, err := accountDB(d, aliased, meta)
+				if err != nil {
+					return err
+				}
+				return customizeDiff(ctx, d, db)
+			}
+		}
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			// the account of an imported object is only known once the importer has read it from the identifier
+			stateContext := r.Importer.StateContext
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return stateContext(ctx, d, meta.(*snowflakedb.Connection).DB)
+			}
+		}
+	}
+	return resources
+}
+
+func withAccountDB[F ~func(*schema.ResourceData, interface{}) error](f F, aliased bool) F {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		db, err := accountDB(d, aliased, meta)
+		if err != nil {
+			return err
+		}
+		return f(d, db)
+	}
+}
+
+func withContextAccountDB[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F, aliased bool) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db, err := accountDB(d, aliased, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, db)
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+func TestWithConnection(t *testing.T) {
+	r := require.New(t)
+
+	providerDB, secondaryDB := &sql.DB{}, &sql.DB{}
+	conn := snowflakedb.NewConnection(providerDB, map[string]*sql.DB{"secondary": secondaryDB}, 0)
+
+	var used interface{}
+	read := func(_ *schema.ResourceData, meta interface{}) error {
+		used = meta
+		return nil
+	}
+	customizeDiff := func(_ context.Context, _ *schema.ResourceDiff, meta interface{}) error {
+		used = meta
+		return nil
+	}
+	aliasedSchema := map[string]*schema.Schema{
+		"name":          {Type: schema.TypeString, Required: true},
+		"account_alias": {Type: schema.TypeString, Optional: true},
+	}
+	resources := withConnection(map[string]*schema.Resource{
+		"snowflake_aliased": {Schema: aliasedSchema, Read: read, CustomizeDiff: customizeDiff},
+		"snowflake_other":   {Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true}}, Read: read},
+	})
+
+	d := schema.TestResourceDataRaw(t, aliasedSchema, map[string]interface{}{"name": "fg"})
+	r.NoError(resources["snowflake_aliased"].Read(d, conn))
+	r.Same(providerDB, used)
+
+	d = schema.TestResourceDataRaw(t, aliasedSchema, map[string]interface{}{"name": "fg", "account_alias": "secondary"})
+	r.NoError(resources["snowflake_aliased"].Read(d, conn))
+	r.Same(secondaryDB, used)
+
+	used = nil
+	d = schema.TestResourceDataRaw(t, aliasedSchema, map[string]interface{}{"name": "fg", "account_alias": "unknown"})
+	r.ErrorContains(resources["snowflake_aliased"].Read(d, conn), `account alias "unknown" is not configured`)
+	r.Nil(used)
+
+	d = schema.TestResourceDataRaw(t, aliasedSchema, map[string]interface{}{"name": "fg"})
+	r.NoError(resources["snowflake_other"].Read(d, conn))
+	r.Same(providerDB, used)
+
+	_, err := resources["snowflake_aliased"].Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "fg",
+		"account_alias": "secondary",
+	}), conn)
+	r.NoError(err)
+	r.Same(secondaryDB, used)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+// limitOperations wraps the CRUD functions of the resources, so that no more than max_concurrent_operations of them
+// run at once, whatever the parallelism of Terraform.
+func limitOperations(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, r := range resources {
+		if r.Create != nil {
+			r.Create = limitOperation(r.Create)
+		}
+		if r.Read != nil {
+			r.Read = limitOperation(r.Read)
+		}
+		if r.Update != nil {
+			r.Update = limitOperation(r.Update)
+		}
+		if r.Delete != nil {
+			r.Delete = limitOperation(r.Delete)
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = limitContextOperation(r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = limitContextOperation(r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = limitContextOperation(r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = limitContextOperation(r.DeleteContext)
+		}
+	}
+	return resources
+}
+
+func limitOperation[F ~func(*schema.ResourceData, interface{}) error](f F) F {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		release, err := meta.(*snowflakedb.Connection).AcquireOperation(context.Background())
+		if err != nil {
+			return err
+		}
+		defer release()
+		return f(d, meta)
+	}
+}
+
+func limitContextOperation[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		release, err := meta.(*snowflakedb.Connection).AcquireOperation(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer release()
+		return f(ctx, d, meta)
+	}
+}
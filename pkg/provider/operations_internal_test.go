@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+func TestLimitOperations(t *testing.T) {
+	r := require.New(t)
+
+	conn := snowflakedb.NewConnection(&sql.DB{}, nil, 2)
+
+	var running, maxRunning int32
+	read := func(*schema.ResourceData, interface{}) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+	resources := limitOperations(map[string]*schema.Resource{"snowflake_test": {Read: read}})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- resources["snowflake_test"].Read(nil, conn)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		r.NoError(err)
+	}
+	r.Equal(int32(2), maxRunning)
+}
+
+func TestConnectionPool(t *testing.T) {
+	r := require.New(t)
+
+	pool := connectionPool{clientSessionKeepAlive: true}
+	r.Equal("user:pass@acct.snowflakecomputing.com:443?ocspFailOpen=true&client_session_keep_alive=true", pool.dsn("user:pass@acct.snowflakecomputing.com:443?ocspFailOpen=true"))
+	r.Equal("user:pass@acct.snowflakecomputing.com:443?client_session_keep_alive=true", pool.dsn("user:pass@acct.snowflakecomputing.com:443"))
+	r.Equal("dsn", connectionPool{}.dsn("dsn"))
+
+	db, err := sql.Open("snowflake-instrumented", "user:pass@acct.snowflakecomputing.com:443")
+	r.NoError(err)
+	connectionPool{maxOpenConnections: 5, maxIdleConnections: 3, connectionMaxLifetime: time.Minute}.configure(db)
+	r.Equal(5, db.Stats().MaxOpenConnections)
+}
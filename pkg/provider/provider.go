@@ -5,7 +5,6 @@ import (
 	"crypto/rsa"
 	"database/sql"
 	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -15,19 +14,37 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	homedir "github.com/mitchellh/go-homedir"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/snowflakedb/gosnowflake"
-	"github.com/youmark/pkcs8"
-	"golang.org/x/crypto/ssh"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
+// ProviderServer returns the protocol version 5 server muxing the SDKv2 provider with the plugin framework provider.
+// The SDKv2 provider is listed first, so that it is configured before the framework provider using its connection.
+func ProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
 // Provider is a provider.
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -71,30 +88,30 @@ func Provider() *schema.Provider {
 			},
 			"oauth_client_id": {
 				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_ID` environment variable.",
+				Description:   "Required when `oauth_refresh_token` is used. Without `oauth_refresh_token`, an access token is requested from `oauth_endpoint` with the client credentials flow whenever a connection is opened. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_ID` environment variable.",
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_ID", nil),
 				Sensitive:     true,
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
+				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token", "token_file_path"},
+				RequiredWith:  []string{"oauth_client_secret", "oauth_endpoint"},
 			},
 			"oauth_client_secret": {
 				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_SECRET` environment variable.",
+				Description:   "Required when `oauth_refresh_token` or `oauth_client_id` is used. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_SECRET` environment variable.",
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_SECRET", nil),
 				Sensitive:     true,
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint", "oauth_redirect_url"},
+				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token", "token_file_path"},
+				RequiredWith:  []string{"oauth_client_id", "oauth_endpoint"},
 			},
 			"oauth_endpoint": {
 				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_ENDPOINT` environment variable.",
+				Description:   "Required when `oauth_refresh_token` or `oauth_client_id` is used. Can be sourced from `SNOWFLAKE_OAUTH_ENDPOINT` environment variable.",
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ENDPOINT", nil),
 				Sensitive:     true,
-				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token", "oauth_redirect_url"},
+				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token", "token_file_path"},
+				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret"},
 			},
 			"oauth_redirect_url": {
 				Type:          schema.TypeString,
@@ -105,6 +122,21 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_refresh_token"},
 			},
+			"oauth_scope": {
+				Type:          schema.TypeString,
+				Description:   "Scope requested with the OAuth client credentials flow, e.g. `session:role:SYSADMIN`. Can be sourced from `SNOWFLAKE_OAUTH_SCOPE` environment variable.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_SCOPE", nil),
+				ConflictsWith: []string{"oauth_refresh_token"},
+				RequiredWith:  []string{"oauth_client_id"},
+			},
+			"token_file_path": {
+				Type:          schema.TypeString,
+				Description:   "Path to a file containing an OAuth access token, e.g. an OIDC token issued to a CI job or a Kubernetes service account and accepted by an External OAuth security integration. The file is read again whenever a connection is opened, so the token can be rotated while the provider runs. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token`, `oauth_refresh_token`, `oauth_client_id` or `password`. Can be sourced from `SNOWFLAKE_TOKEN_FILE_PATH` environment variable.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_FILE_PATH", nil),
+				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token", "oauth_refresh_token", "oauth_client_id"},
+			},
 			"browser_auth": {
 				Type:          schema.TypeBool,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_USE_BROWSER_AUTH` environment variable.",
@@ -159,13 +191,13 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeInt,
 				Description: "Support custom port values to snowflake go driver for use with privatelink. Can be sourced from `SNOWFLAKE_PORT` environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PORT", 443),
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PORT", nil),
 			},
 			"protocol": {
 				Type:        schema.TypeString,
 				Description: "Support custom protocols to snowflake go driver. Can be sourced from `SNOWFLAKE_PROTOCOL` environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROTOCOL", "https"),
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROTOCOL", nil),
 			},
 			"insecure_mode": {
 				Type:        schema.TypeBool,
@@ -181,13 +213,59 @@ func Provider() *schema.Provider {
 			},
 			"profile": {
 				Type:        schema.TypeString,
-				Description: "Sets the profile to read from ~/.snowflake/config file.",
+				Description: "Sets the profile to read from ~/.snowflake/config file (or the file in the `SNOWFLAKE_CONFIG_PATH` environment variable). Every connection setting is taken from the provider configuration first, then from its environment variable, and lastly from the profile. The `default` profile is only used when neither `account` nor `username` is set. Besides the connection settings of the provider, a profile supports `authenticator`, `private_key_file`, `private_key_passphrase`, `token`, `token_file_path`, `login_timeout`, `request_timeout`, `client_timeout`, `jwt_expire_timeout` (in seconds), `client_session_keep_alive` and a `params` table of session parameters. Authentication settings are not mixed: when the provider configuration sets an authentication method, the credentials of the profile are ignored. Can be sourced from the `SNOWFLAKE_PROFILE` environment variable.",
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROFILE", "default"),
 			},
+			"max_open_connections": {
+				Type:             schema.TypeInt,
+				Description:      "Maximum number of open connections, i.e. Snowflake sessions, used by the provider. 0 means unlimited. Can be sourced from `SNOWFLAKE_MAX_OPEN_CONNECTIONS` environment variable.",
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("SNOWFLAKE_MAX_OPEN_CONNECTIONS", nil),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"max_idle_connections": {
+				Type:             schema.TypeInt,
+				Description:      "Maximum number of idle connections kept open for reuse, so that operations do not log in again. Defaults to 2 when not set. Can be sourced from `SNOWFLAKE_MAX_IDLE_CONNECTIONS` environment variable.",
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("SNOWFLAKE_MAX_IDLE_CONNECTIONS", nil),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"connection_max_lifetime": {
+				Type:             schema.TypeInt,
+				Description:      "Maximum time in seconds a connection is reused before it is closed. 0 means connections are reused forever. Can be sourced from `SNOWFLAKE_CONNECTION_MAX_LIFETIME` environment variable.",
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("SNOWFLAKE_CONNECTION_MAX_LIFETIME", nil),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"client_session_keep_alive": {
+				Type:        schema.TypeBool,
+				Description: "Keeps the sessions of open connections alive with heartbeats, so that idle connections can be reused without logging in again. Can be sourced from `SNOWFLAKE_CLIENT_SESSION_KEEP_ALIVE` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_CLIENT_SESSION_KEEP_ALIVE", false),
+			},
+			"max_concurrent_operations": {
+				Type:             schema.TypeInt,
+				Description:      "Maximum number of resource and data source operations that run concurrently, whatever the `-parallelism` of Terraform. Together with `max_open_connections` it bounds the number of logins of large configurations. 0 means unlimited. Can be sourced from `SNOWFLAKE_MAX_CONCURRENT_OPERATIONS` environment variable.",
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("SNOWFLAKE_MAX_CONCURRENT_OPERATIONS", nil),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"read_cache": {
+				Type:        schema.TypeBool,
+				Description: "Caches the results of SHOW statements for the duration of a plan, refresh or apply, so that resources reading the same object, e.g. the grants of one object, share a single query. Cached results are dropped on any change to the object made by the provider. Can be sourced from `SNOWFLAKE_READ_CACHE` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_READ_CACHE", false),
+			},
+			"accounts": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional Snowflake accounts that resources can manage by setting their `account_alias` argument, e.g. the secondary account of a failover group. Maps an alias to a profile in the ~/.snowflake/config file. Resources without `account_alias` use the connection configured by the other arguments.",
+				Optional:    true,
+			},
 		},
-		ResourcesMap:   getResources(),
-		DataSourcesMap: getDataSources(),
+		ResourcesMap:   limitOperations(withConnection(getResources())),
+		DataSourcesMap: limitOperations(withConnection(getDataSources())),
 		ConfigureFunc:  ConfigureProvider,
 	}
 }
@@ -227,27 +305,33 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_account_parameter":                       resources.AccountParameter(),
 		"snowflake_alert":                                   resources.Alert(),
 		"snowflake_api_integration":                         resources.APIIntegration(),
+		"snowflake_compute_pool":                            resources.ComputePool(),
 		"snowflake_database":                                resources.Database(),
-		"snowflake_database_role":                           resources.DatabaseRole(),
 		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                             resources.EventTable(),
+		"snowflake_external_access_integration":             resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                       resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                          resources.ExternalTable(),
 		"snowflake_failover_group":                          resources.FailoverGroup(),
 		"snowflake_file_format":                             resources.FileFormat(),
 		"snowflake_function":                                resources.Function(),
+		"snowflake_image_repository":                        resources.ImageRepository(),
 		"snowflake_managed_account":                         resources.ManagedAccount(),
 		"snowflake_masking_policy":                          resources.MaskingPolicy(),
 		"snowflake_materialized_view":                       resources.MaterializedView(),
 		"snowflake_network_policy":                          resources.NetworkPolicy(),
 		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                            resources.NetworkRule(),
 		"snowflake_notification_integration":                resources.NotificationIntegration(),
 		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
 		"snowflake_object_parameter":                        resources.ObjectParameter(),
 		"snowflake_password_policy":                         resources.PasswordPolicy(),
 		"snowflake_pipe":                                    resources.Pipe(),
 		"snowflake_procedure":                               resources.Procedure(),
+		"snowflake_replication_group":                       resources.ReplicationGroup(),
 		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
+		"snowflake_resource_monitor_attachment":             resources.ResourceMonitorAttachment(),
 		"snowflake_role":                                    resources.Role(),
 		"snowflake_role_grants":                             resources.RoleGrants(),
 		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
@@ -255,12 +339,15 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_saml_integration":                        resources.SAMLIntegration(),
 		"snowflake_schema":                                  resources.Schema(),
 		"snowflake_scim_integration":                        resources.SCIMIntegration(),
+		"snowflake_secret":                                  resources.Secret(),
 		"snowflake_sequence":                                resources.Sequence(),
+		"snowflake_service":                                 resources.Service(),
 		"snowflake_session_parameter":                       resources.SessionParameter(),
 		"snowflake_share":                                   resources.Share(),
 		"snowflake_stage":                                   resources.Stage(),
 		"snowflake_storage_integration":                     resources.StorageIntegration(),
 		"snowflake_stream":                                  resources.Stream(),
+		"snowflake_streamlit":                               resources.Streamlit(),
 		"snowflake_table":                                   resources.Table(),
 		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
 		"snowflake_table_constraint":                        resources.TableConstraint(),
@@ -268,11 +355,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_tag_association":                         resources.TagAssociation(),
 		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
 		"snowflake_task":                                    resources.Task(),
+		"snowflake_task_graph":                              resources.TaskGraph(),
 		"snowflake_user":                                    resources.User(),
 		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
 		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
 		"snowflake_view":                                    resources.View(),
 		"snowflake_warehouse":                               resources.Warehouse(),
+		"snowflake_warehouse_resize":                        resources.WarehouseResize(),
 	}
 
 	return mergeSchemas(
@@ -316,7 +405,12 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_role":                               datasources.Role(),
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_users":                              datasources.Users(),
+		"snowflake_scim_principals":                    datasources.SCIMPrincipals(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_compute_pools":                      datasources.ComputePools(),
+		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_services":                           datasources.Services(),
+		"snowflake_streamlits":                         datasources.Streamlits(),
 	}
 
 	return dataSources
@@ -344,46 +438,59 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	warehouse := s.Get("warehouse").(string)
 	insecureMode := s.Get("insecure_mode").(bool)
 	profile := s.Get("profile").(string)
-
-	if oauthRefreshToken != "" {
-		accessToken, err := GetOauthAccessToken(oauthEndpoint, oauthClientID, oauthClientSecret, GetOauthData(oauthRefreshToken, oauthRedirectURL))
+	accounts := s.Get("accounts").(map[string]interface{})
+	oauthScope := s.Get("oauth_scope").(string)
+	tokenFilePath := s.Get("token_file_path").(string)
+	pool := connectionPool{
+		maxOpenConnections:     s.Get("max_open_connections").(int),
+		maxIdleConnections:     s.Get("max_idle_connections").(int),
+		connectionMaxLifetime:  time.Duration(s.Get("connection_max_lifetime").(int)) * time.Second,
+		clientSessionKeepAlive: s.Get("client_session_keep_alive").(bool),
+		readCache:              s.Get("read_cache").(bool),
+	}
+	maxConcurrentOperations := s.Get("max_concurrent_operations").(int)
+
+	accessTokenFunc := OauthAccessTokenFunc(tokenFilePath, oauthEndpoint, oauthClientID, oauthClientSecret, oauthRefreshToken, oauthRedirectURL, oauthScope)
+
+	// the DSN is built again for every new connection, so that short-lived tokens are renewed on reconnect
+	dsnFunc := func() (string, error) {
+		accessToken := oauthAccessToken
+		if accessTokenFunc != nil {
+			var err error
+			if accessToken, err = accessTokenFunc(); err != nil {
+				return "", fmt.Errorf("could not retrieve access token err = %w", err)
+			}
+		}
+		dsn, err := DSN(
+			account,
+			user,
+			password,
+			browserAuth,
+			privateKeyPath,
+			privateKey,
+			privateKeyPassphrase,
+			accessToken,
+			region,
+			role,
+			host,
+			protocol,
+			port,
+			warehouse,
+			insecureMode,
+			profile,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve access token from refresh token")
+			return "", fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
 		}
-		oauthAccessToken = accessToken
+		return pool.dsn(dsn), nil
 	}
 
-	dsn, err := DSN(
-		account,
-		user,
-		password,
-		browserAuth,
-		privateKeyPath,
-		privateKey,
-		privateKeyPassphrase,
-		oauthAccessToken,
-		region,
-		role,
-		host,
-		protocol,
-		port,
-		warehouse,
-		insecureMode,
-		profile,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
-	}
-
-	db, err := db.Open(dsn)
-	if err != nil {
-		return nil, fmt.Errorf("could not open snowflake database err = %w", err)
-	}
+	db := snowflakedb.OpenWithDSNFunc(dsnFunc)
+	pool.configure(db)
 	log.Printf("[INFO] account: %s\n", account)
 	log.Printf("[INFO] user: %s\n", user)
 	log.Printf("[INFO] role: %s\n", role)
 	log.Printf("[INFO] warehouse: %s\n", warehouse)
-	log.Printf("[INFO] dsn: %s\n", dsn)
 	client := sdk.NewClientFromDB(db)
 	sessionID, err := client.ContextFunctions.CurrentSession(context.Background())
 	if err != nil {
@@ -394,7 +501,80 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("could not open snowflake database err = %w", err)
 	}
 
-	return db, nil
+	accountDBs, err := openAccounts(accounts, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return snowflakedb.NewConnection(db, accountDBs, maxConcurrentOperations), nil
+}
+
+// openAccounts opens a connection for every alias of the accounts provider argument. Connections are only
+// established once they are used, so accounts not targeted by any resource are never logged into.
+func openAccounts(accounts map[string]interface{}, pool connectionPool) (map[string]*sql.DB, error) {
+	accountDBs := make(map[string]*sql.DB, len(accounts))
+	for alias, v := range accounts {
+		profile := v.(string)
+		if _, err := ProfileDSN(profile); err != nil {
+			return nil, fmt.Errorf("could not build dsn for account alias %v err = %w", alias, err)
+		}
+		log.Printf("[INFO] account alias %s uses profile %s\n", alias, profile)
+		accountDB := snowflakedb.OpenWithDSNFunc(func() (string, error) {
+			dsn, err := ProfileDSN(profile)
+			if err != nil {
+				return "", err
+			}
+			return pool.dsn(dsn), nil
+		})
+		pool.configure(accountDB)
+		accountDBs[alias] = accountDB
+	}
+	return accountDBs, nil
+}
+
+// connectionPool holds the settings of the connections opened by the provider.
+type connectionPool struct {
+	maxOpenConnections     int
+	maxIdleConnections     int
+	connectionMaxLifetime  time.Duration
+	clientSessionKeepAlive bool
+	readCache              bool
+}
+
+func (p connectionPool) configure(db *sql.DB) {
+	db.SetMaxOpenConns(p.maxOpenConnections)
+	if p.maxIdleConnections > 0 {
+		db.SetMaxIdleConns(p.maxIdleConnections)
+	}
+	db.SetConnMaxLifetime(p.connectionMaxLifetime)
+	if p.readCache {
+		sdk.EnableReadCache(db)
+	}
+}
+
+// dsn adds the session settings of the pool to the DSN. Parameters unknown to the driver are passed to the session.
+func (p connectionPool) dsn(dsn string) string {
+	if !p.clientSessionKeepAlive {
+		return dsn
+	}
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+	return dsn + separator + url.Values{"client_session_keep_alive": {"true"}}.Encode()
+}
+
+// ProfileDSN returns the DSN of the given profile in the ~/.snowflake/config file.
+func ProfileDSN(profile string) (string, error) {
+	config, err := sdk.ProfileConfig(profile)
+	if err != nil {
+		return "", err
+	}
+	if config == nil {
+		return "", fmt.Errorf("profile %v not found in config file", profile)
+	}
+	config.Application = "terraform-provider-snowflake"
+	return gosnowflake.DSN(config)
 }
 
 func DSN(
@@ -415,33 +595,18 @@ func DSN(
 	insecureMode bool,
 	profile string,
 ) (string, error) {
-	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
-	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
-	if region == "us-west-2" {
-		region = ""
-	}
-
 	config := &gosnowflake.Config{
 		Account:      account,
 		User:         user,
 		Region:       region,
 		Role:         role,
+		Host:         host,
 		Port:         port,
 		Protocol:     protocol,
+		Warehouse:    warehouse,
 		InsecureMode: insecureMode,
 	}
 
-	// If host is set trust it and do not use the region value
-	if host != "" {
-		config.Region = ""
-		config.Host = host
-	}
-
-	// If warehouse is set
-	if warehouse != "" {
-		config.Warehouse = warehouse
-	}
-
 	if privateKeyPath != "" { //nolint:gocritic // todo: please fix this to pass gocritic
 		privateKeyBytes, err := ReadPrivateKeyFile(privateKeyPath)
 		if err != nil {
@@ -467,64 +632,43 @@ func DSN(
 		config.Token = oauthAccessToken
 	} else if password != "" {
 		config.Password = password
-	} else if account == "" && user == "" {
-		// If account and user are empty then we need to fall back on using profile config
-		log.Printf("[DEBUG] No account or user provided, falling back to profile %s\n", profile)
+	}
+
+	// Every setting is resolved from the provider configuration, then from its environment variable (through the
+	// schema defaults), and lastly from the profile in the config file. The default profile is only used when neither
+	// the account nor the user is set, so that it is not mixed into a configuration that does not ask for it.
+	if profile != "default" || (config.Account == "" && config.User == "") {
 		profileConfig, err := sdk.ProfileConfig(profile)
-		if err != nil {
-			return "", errors.New("no authentication method provided")
+		switch {
+		case err != nil && profile != "default":
+			return "", fmt.Errorf("could not read profile %s err = %w", profile, err)
+		case err != nil:
+			log.Printf("[DEBUG] could not read default profile: %v\n", err)
+		case profileConfig == nil && profile != "default":
+			return "", fmt.Errorf("profile %s not found in config file", profile)
 		}
-		config = sdk.MergeConfig(config, profileConfig)
+		config = sdk.MergeConfig(profileConfig, config)
+	}
+
+	if config.Account == "" && config.User == "" {
+		return "", errors.New("no authentication method provided")
+	}
+	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
+	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
+	// If host is set trust it and do not use the region value
+	if config.Region == "us-west-2" || config.Host != "" {
+		config.Region = ""
 	}
 	config.Application = "terraform-provider-snowflake"
 	return gosnowflake.DSN(config)
 }
 
 func ReadPrivateKeyFile(privateKeyPath string) ([]byte, error) {
-	expandedPrivateKeyPath, err := homedir.Expand(privateKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("invalid Path to private key err = %w", err)
-	}
-
-	privateKeyBytes, err := os.ReadFile(expandedPrivateKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("could not read private key err = %w", err)
-	}
-
-	if len(privateKeyBytes) == 0 {
-		return nil, errors.New("private key is empty")
-	}
-
-	return privateKeyBytes, nil
+	return sdk.ReadPrivateKeyFile(privateKeyPath)
 }
 
 func ParsePrivateKey(privateKeyBytes []byte, passhrase []byte) (*rsa.PrivateKey, error) {
-	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
-	if privateKeyBlock == nil {
-		return nil, fmt.Errorf("could not parse private key, key is not in PEM format")
-	}
-
-	if privateKeyBlock.Type == "ENCRYPTED PRIVATE KEY" {
-		if len(passhrase) == 0 {
-			return nil, fmt.Errorf("private key requires a passphrase, but private_key_passphrase was not supplied")
-		}
-		privateKey, err := pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes, passhrase)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse encrypted private key with passphrase, only ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc are supported err = %w", err)
-		}
-		return privateKey, nil
-	}
-
-	privateKey, err := ssh.ParseRawPrivateKey(privateKeyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse private key err = %w", err)
-	}
-
-	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
-	if !ok {
-		return nil, errors.New("privateKey not of type RSA")
-	}
-	return rsaPrivateKey, nil
+	return sdk.ParsePrivateKey(privateKeyBytes, passhrase)
 }
 
 type Result struct {
@@ -541,6 +685,49 @@ func GetOauthData(refreshToken, redirectURL string) url.Values {
 	return data
 }
 
+// GetOauthClientCredentialsData returns the form of an OAuth client credentials token request.
+func GetOauthClientCredentialsData(scope string) url.Values {
+	data := url.Values{}
+	data.Set("grant_type", "client_credentials")
+	if scope != "" {
+		data.Set("scope", scope)
+	}
+	return data
+}
+
+// OauthAccessTokenFunc returns a function retrieving an OAuth access token, or nil if no flow is configured. The token
+// file is read and the client credentials flow requests a fresh token on every call, while the refresh token is only
+// exchanged once, on the first call, since identity providers may rotate refresh tokens on use.
+func OauthAccessTokenFunc(tokenFilePath, endPoint, clientID, clientSecret, refreshToken, redirectURL, scope string) func() (string, error) {
+	switch {
+	case tokenFilePath != "":
+		return func() (string, error) {
+			return sdk.ReadTokenFile(tokenFilePath)
+		}
+	case refreshToken != "":
+		var mu sync.Mutex
+		var accessToken string
+		return func() (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if accessToken == "" {
+				token, err := GetOauthAccessToken(endPoint, clientID, clientSecret, GetOauthData(refreshToken, redirectURL))
+				if err != nil {
+					return "", fmt.Errorf("could not retrieve access token from refresh token err = %w", err)
+				}
+				accessToken = token
+			}
+			return accessToken, nil
+		}
+	case clientID != "":
+		return func() (string, error) {
+			return GetOauthAccessToken(endPoint, clientID, clientSecret, GetOauthClientCredentialsData(scope))
+		}
+	default:
+		return nil
+	}
+}
+
 func GetOauthRequest(dataContent io.Reader, endPoint, clientID, clientSecret string) (*http.Request, error) {
 	request, err := http.NewRequest("POST", endPoint, dataContent)
 	if err != nil {
@@ -2,9 +2,12 @@ package provider_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -14,6 +17,8 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +28,50 @@ func TestProvider(t *testing.T) {
 	r.NoError(err)
 }
 
+func TestProviderServer(t *testing.T) {
+	r := require.New(t)
+	providerServer, err := provider.ProviderServer(context.Background())
+	r.NoError(err)
+
+	// the mux server rejects providers with differing provider schemas
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	r.NoError(err)
+	r.Empty(resp.Diagnostics)
+	r.Contains(resp.ResourceSchemas, "snowflake_database")
+	r.Contains(resp.ResourceSchemas, "snowflake_database_role")
+	r.Contains(resp.DataSourceSchemas, "snowflake_database_roles")
+
+	attributes := map[string]bool{}
+	for _, a := range resp.ResourceSchemas["snowflake_database_role"].Block.Attributes {
+		attributes[a.Name] = a.Required
+	}
+	r.Equal(map[string]bool{"id": false, "name": true, "database": true, "comment": false}, attributes)
+
+	r.Contains(resp.Functions, "fully_qualified_name")
+	r.Contains(resp.Functions, "parse_identifier")
+	r.Contains(resp.Functions, "encode_grant_id")
+
+	// functions are served by the plugin framework provider
+	arguments := make([]*tfprotov5.DynamicValue, 0, 3)
+	for _, v := range []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "db"),
+		tftypes.NewValue(tftypes.Bool, false),
+		tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "role")}),
+	} {
+		argument, err := tfprotov5.NewDynamicValue(tftypes.DynamicPseudoType, v)
+		r.NoError(err)
+		arguments = append(arguments, &argument)
+	}
+	functionServer, ok := providerServer().(tfprotov5.FunctionServer)
+	r.True(ok)
+	callResp, err := functionServer.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{Name: "encode_grant_id", Arguments: arguments})
+	r.NoError(err)
+	r.Nil(callResp.Error)
+	result, err := callResp.Result.Unmarshal(tftypes.String)
+	r.NoError(err)
+	r.Equal(tftypes.NewValue(tftypes.String, "db|false|role"), result)
+}
+
 func TestDSN(t *testing.T) {
 	dat := []byte(`
 	[default]
@@ -98,6 +147,83 @@ func TestDSN(t *testing.T) {
 }
 
 // nolint: gosec
+func TestDSNPrecedence(t *testing.T) {
+	dat := []byte(`
+	[default]
+	account='PROFILE_ACCOUNT'
+	user='PROFILE_USER'
+	password='profile'
+	warehouse='PROFILE_WAREHOUSE'
+	login_timeout=30
+
+	[explicit]
+	account='EXPLICIT_ACCOUNT'
+	user='EXPLICIT_USER'
+	password='explicit'
+	warehouse='EXPLICIT_WAREHOUSE'
+	login_timeout=30
+	`)
+	path := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(path, dat, 0o600)
+	require.NoError(t, err)
+	os.Setenv("SNOWFLAKE_CONFIG_PATH", path)
+
+	t.Run("provider settings override the profile", func(t *testing.T) {
+		dsn, err := provider.DSN("acct", "", "pass", false, "", "", "", "", "", "role", "", "", 0, "", false, "explicit")
+		require.NoError(t, err)
+		require.Equal(t, "EXPLICIT_USER:pass@acct.snowflakecomputing.com:443?application=terraform-provider-snowflake&loginTimeout=30&ocspFailOpen=true&role=role&validateDefaultParameters=true&warehouse=EXPLICIT_WAREHOUSE", dsn)
+	})
+
+	t.Run("provider settings without a profile ignore the default profile", func(t *testing.T) {
+		dsn, err := provider.DSN("acct", "user", "pass", false, "", "", "", "", "", "role", "", "", 0, "", false, "default")
+		require.NoError(t, err)
+		require.Equal(t, "user:pass@acct.snowflakecomputing.com:443?application=terraform-provider-snowflake&ocspFailOpen=true&role=role&validateDefaultParameters=true", dsn)
+	})
+
+	t.Run("no connection settings use the default profile", func(t *testing.T) {
+		dsn, err := provider.DSN("", "", "", false, "", "", "", "", "", "", "", "", 0, "", false, "default")
+		require.NoError(t, err)
+		require.Equal(t, "PROFILE_USER:profile@PROFILE_ACCOUNT.snowflakecomputing.com:443?application=terraform-provider-snowflake&loginTimeout=30&ocspFailOpen=true&validateDefaultParameters=true&warehouse=PROFILE_WAREHOUSE", dsn)
+	})
+
+	t.Run("unknown explicit profile", func(t *testing.T) {
+		_, err := provider.DSN("acct", "user", "pass", false, "", "", "", "", "", "", "", "", 0, "", false, "unknown")
+		require.ErrorContains(t, err, "profile unknown not found")
+	})
+
+	t.Run("no account or user", func(t *testing.T) {
+		os.Setenv("SNOWFLAKE_CONFIG_PATH", filepath.Join(t.TempDir(), "missing"))
+		_, err := provider.DSN("", "", "", false, "", "", "", "", "", "", "", "", 0, "", false, "default")
+		require.ErrorContains(t, err, "no authentication method provided")
+	})
+}
+
+func TestProfileDSN(t *testing.T) {
+	dat := []byte(`
+	[default]
+	account='TEST_ACCOUNT'
+	user='TEST_USER'
+	password='abcd1234'
+
+	[secondary]
+	account='SECONDARY_ACCOUNT'
+	user='SECONDARY_USER'
+	password='abcd1234'
+	role='ACCOUNTADMIN'
+	`)
+	path := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(path, dat, 0o600)
+	require.NoError(t, err)
+	os.Setenv("SNOWFLAKE_CONFIG_PATH", path)
+
+	dsn, err := provider.ProfileDSN("secondary")
+	require.NoError(t, err)
+	require.Equal(t, "SECONDARY_USER:abcd1234@SECONDARY_ACCOUNT.snowflakecomputing.com:443?application=terraform-provider-snowflake&ocspFailOpen=true&role=ACCOUNTADMIN&validateDefaultParameters=true", dsn)
+
+	_, err = provider.ProfileDSN("unknown")
+	require.ErrorContains(t, err, "profile unknown not found")
+}
+
 func TestOAuthDSN(t *testing.T) {
 	type args struct {
 		account          string
@@ -316,3 +442,82 @@ func TestGetOauthAccessToken(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOauthClientCredentialsData(t *testing.T) {
+	require.Equal(t, url.Values{"grant_type": {"client_credentials"}}, provider.GetOauthClientCredentialsData(""))
+	require.Equal(t, url.Values{"grant_type": {"client_credentials"}, "scope": {"session:role:SYSADMIN"}}, provider.GetOauthClientCredentialsData("session:role:SYSADMIN"))
+}
+
+func TestOauthAccessTokenFunc(t *testing.T) {
+	t.Run("token file is read on every call", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+		accessTokenFunc := provider.OauthAccessTokenFunc(path, "", "", "", "", "", "")
+
+		token, err := accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "first", token)
+
+		require.NoError(t, os.WriteFile(path, []byte("second\n"), 0o600))
+		token, err = accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "second", token)
+	})
+
+	t.Run("client credentials against a fake token endpoint", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			clientID, clientSecret, ok := r.BasicAuth()
+			if !ok || clientID != "client" || clientSecret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			require.Equal(t, "session:role:SYSADMIN", r.PostForm.Get("scope"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 600}`, requests)
+		}))
+		t.Cleanup(server.Close)
+
+		accessTokenFunc := provider.OauthAccessTokenFunc("", server.URL, "client", "secret", "", "", "session:role:SYSADMIN")
+		token, err := accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		token, err = accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token)
+
+		_, err = provider.OauthAccessTokenFunc("", server.URL, "client", "wrong", "", "", "")()
+		require.ErrorContains(t, err, "401")
+	})
+
+	t.Run("refresh token against a fake token endpoint", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			require.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+			require.Equal(t, "https://localhost.com", r.PostForm.Get("redirect_uri"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 600}`, requests)
+		}))
+		t.Cleanup(server.Close)
+
+		// the client id is required with a refresh token, which must not switch to the client credentials flow
+		accessTokenFunc := provider.OauthAccessTokenFunc("", server.URL, "client", "secret", "refresh", "https://localhost.com", "")
+		token, err := accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		token, err = accessTokenFunc()
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		require.Equal(t, 1, requests)
+	})
+
+	t.Run("nothing configured", func(t *testing.T) {
+		require.Nil(t, provider.OauthAccessTokenFunc("", "", "", "", "", "", ""))
+	})
+}
@@ -47,7 +47,7 @@ var accountSchema = map[string]*schema.Schema{
 		Optional:     true,
 		Sensitive:    true,
 		Description:  "Password for the initial administrative user of the account. Optional if the `ADMIN_RSA_PUBLIC_KEY` parameter is specified. For more information about passwords in Snowflake, see [Snowflake-provided Password Policy](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=Snowflake%2Dprovided%20Password%20Policy).",
-		AtLeastOneOf: []string{"admin_password", "admin_rsa_public_key"},
+		AtLeastOneOf: []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"},
 		// We have no way of assuming a role into this account to change the password so this has to be ForceNew even though it's not ideal
 		ForceNew:              true,
 		DiffSuppressOnRefresh: true,
@@ -60,12 +60,17 @@ var accountSchema = map[string]*schema.Schema{
 			return old == ""
 		},
 	},
+	"admin_password_wo": func() *schema.Schema {
+		s := writeOnlySchema("Password for the initial administrative user of the account, never stored in the state. It is only used when the account is created, since the provider has no way of changing it afterwards. Optional if the `ADMIN_RSA_PUBLIC_KEY` parameter is specified.", "admin_password")
+		s.AtLeastOneOf = []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"}
+		return s
+	}(),
 	"admin_rsa_public_key": {
 		Type:         schema.TypeString,
 		Optional:     true,
 		Sensitive:    true,
 		Description:  "Assigns a public key to the initial administrative user of the account in order to implement [key pair authentication](https://docs.snowflake.com/en/sql-reference/sql/create-account.html#:~:text=key%20pair%20authentication) for the user. Optional if the `ADMIN_PASSWORD` parameter is specified.",
-		AtLeastOneOf: []string{"admin_password", "admin_rsa_public_key"},
+		AtLeastOneOf: []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"},
 		// We have no way of assuming a role into this account to change the admin rsa public key so this has to be ForceNew even though it's not ideal
 		ForceNew:              true,
 		DiffSuppressOnRefresh: true,
@@ -227,6 +232,9 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("admin_password"); ok {
 		builder.WithAdminPassword(v.(string))
 	}
+	if v, ok := getWriteOnly(d, "admin_password_wo"); ok {
+		builder.WithAdminPassword(v)
+	}
 	if v, ok := d.GetOk("admin_rsa_public_key"); ok {
 		builder.WithAdminRSAPublicKey(v.(string))
 	}
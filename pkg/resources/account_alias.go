@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+)
+
+// accountAliasSchema selects the account in which an object is managed. The provider calls the functions of resources
+// with this argument with the connection to the selected account.
+var accountAliasSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	ForceNew:    true,
+	Description: "Alias, from the `accounts` provider argument, of the account in which the object is managed. Defaults to the account of the provider connection. Objects in an aliased account are imported with the `<account_alias>|<id>` identifier.",
+}
+
+// importWithAccountAlias imports objects from the provider account by their identifier, and objects from an aliased
+// account by the <account_alias>|<id> identifier. An unknown alias fails the read that follows the import.
+func importWithAccountAlias(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	alias, id, ok := strings.Cut(d.Id(), helpers.IDDelimiter)
+	if !ok {
+		return []*schema.ResourceData{d}, nil
+	}
+	if err := d.Set("account_alias", alias); err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportWithAccountAlias(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, databaseSchema, map[string]interface{}{})
+	d.SetId("db")
+	_, err := importWithAccountAlias(context.Background(), d, nil)
+	r.NoError(err)
+	r.Equal("db", d.Id())
+	r.Equal("", d.Get("account_alias"))
+
+	d.SetId("secondary|db")
+	_, err = importWithAccountAlias(context.Background(), d, nil)
+	r.NoError(err)
+	r.Equal("db", d.Id())
+	r.Equal("secondary", d.Get("account_alias"))
+}
@@ -112,7 +112,13 @@ func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
 		value = fmt.Sprintf("'%s'", value)
 	}
 	builder := snowflake.NewAccountParameter(key, value, db)
-	err := builder.SetParameter()
+	var err error
+	// parameters without a default value (e.g. EVENT_TABLE) are unset instead
+	if defaultValue == nil {
+		err = builder.UnsetParameter()
+	} else {
+		err = builder.SetParameter()
+	}
 	if err != nil {
 		return fmt.Errorf("error creating account parameter err = %w", err)
 	}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -52,7 +53,7 @@ var alertSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Optional:    true,
 		MaxItems:    1,
-		Description: "The schedule for periodically running an alert.",
+		Description: "The schedule for periodically running an alert. Omit it to create an alert on new data, which is evaluated whenever new rows are added to the tables referenced in the condition. Switching between a scheduled alert and an alert on new data recreates the alert.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"cron": {
@@ -94,10 +95,45 @@ var alertSchema = map[string]*schema.Schema{
 	},
 	"action": {
 		Type:             schema.TypeString,
-		Required:         true,
+		Optional:         true,
+		Computed:         true,
 		Description:      "The SQL statement that should be executed if the condition returns one or more rows.",
 		ForceNew:         false,
 		DiffSuppressFunc: DiffSuppressStatement,
+		ExactlyOneOf:     []string{"action", "email_action"},
+	},
+	"email_action": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Sends an email through an email notification integration (SYSTEM$SEND_EMAIL) if the condition returns one or more rows. It is read back from the action of the alert, so changes made outside of Terraform are detected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"integration": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the email notification integration used to send the email.",
+				},
+				"recipients": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Email addresses of the recipients. Each address must be one of the allowed recipients of the integration.",
+				},
+				"subject": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Subject of the email.",
+				},
+				"body": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Body of the email.",
+				},
+			},
+		},
+		ExactlyOneOf: []string{"action", "email_action"},
 	},
 	"enabled": {
 		Type:        schema.TypeBool,
@@ -105,6 +141,26 @@ var alertSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies if an alert should be 'started' (enabled) after creation or should remain 'suspended' (default).",
 	},
+	"last_evaluation_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the most recent evaluation of the alert in the alert history, e.g. TRIGGERED, CONDITION_FALSE or ACTION_FAILED.",
+	},
+	"last_evaluation_scheduled_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the most recent evaluation of the alert was scheduled to start.",
+	},
+	"last_evaluation_completed_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the most recent evaluation of the alert completed.",
+	},
+	"last_evaluation_error_message": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Error message of the most recent evaluation of the alert, if its condition or action failed.",
+	},
 }
 
 type alertID struct {
@@ -160,6 +216,8 @@ func Alert() *schema.Resource {
 		Update: UpdateAlert,
 		Delete: DeleteAlert,
 
+		CustomizeDiff: customizeAlertDiff,
+
 		Schema: alertSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -214,7 +272,12 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	alertSchedule := alert.Schedule
-	if alertSchedule != "" {
+	if alertSchedule == "" {
+		// alerts on new data do not have a schedule
+		if err := d.Set("alert_schedule", nil); err != nil {
+			return err
+		}
+	} else {
 		if strings.Contains(alertSchedule, "MINUTE") {
 			interval, err := strconv.Atoi(strings.TrimSuffix(alertSchedule, " MINUTE"))
 			if err != nil {
@@ -256,12 +319,98 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := setAlertEmailAction(d, alert.Action); err != nil {
+		return err
+	}
+
 	if err := d.Set("action", alert.Action); err != nil {
 		return err
 	}
+
+	return setAlertLastEvaluation(d, db, builder)
+}
+
+// setAlertEmailAction refreshes the email_action block from the action of the alert. The block is only read back when
+// it is managed (or nothing is managed yet, as on import), so that an alert whose action is managed as a raw statement
+// keeps its configuration. It must be called before the action itself is refreshed.
+func setAlertEmailAction(d *schema.ResourceData, action string) error {
+	managed := len(d.Get("email_action").([]interface{})) > 0
+	if !managed && d.Get("action").(string) != "" {
+		return nil
+	}
+	emailAction, ok := snowflake.ParseSendEmailAction(action)
+	if !ok {
+		if managed {
+			return d.Set("email_action", nil)
+		}
+		return nil
+	}
+	recipients := make([]interface{}, len(emailAction.Recipients))
+	for i, r := range emailAction.Recipients {
+		recipients[i] = r
+	}
+	return d.Set("email_action", []interface{}{
+		map[string]interface{}{
+			"integration": emailAction.Integration,
+			"recipients":  recipients,
+			"subject":     emailAction.Subject,
+			"body":        emailAction.Body,
+		},
+	})
+}
+
+// setAlertLastEvaluation sets the last evaluation of the alert. The alert history is informational, so it is left
+// empty when it cannot be read rather than failing the read of the alert.
+func setAlertLastEvaluation(d *schema.ResourceData, db *sql.DB, builder *snowflake.AlertBuilder) error {
+	evaluation, err := snowflake.ScanAlertEvaluation(snowflake.QueryRow(db, builder.LastEvaluation()))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		evaluation = &snowflake.AlertEvaluation{}
+	case err != nil:
+		log.Printf("[WARN] could not read last evaluation of alert %v: %v", d.Id(), err)
+		evaluation = &snowflake.AlertEvaluation{}
+	}
+	values := map[string]interface{}{
+		"last_evaluation_state":          evaluation.State,
+		"last_evaluation_scheduled_time": evaluation.ScheduledTime.String,
+		"last_evaluation_completed_time": evaluation.CompletedTime.String,
+		"last_evaluation_error_message":  evaluation.ErrorMessage.String,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// customizeAlertDiff recreates the alert when it switches between a scheduled alert and an alert on new data,
+// since the type of an existing alert cannot be altered.
+func customizeAlertDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("alert_schedule") {
+		return nil
+	}
+	o, n := d.GetChange("alert_schedule")
+	if (len(o.([]interface{})) == 0) != (len(n.([]interface{})) == 0) {
+		return d.ForceNew("alert_schedule")
+	}
+	return nil
+}
+
+// expandAlertAction returns the action statement of the alert, rendering the email_action block if it is set.
+func expandAlertAction(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("email_action"); ok {
+		emailAction := v.([]interface{})[0].(map[string]interface{})
+		return snowflake.SendEmailAction(
+			emailAction["integration"].(string),
+			expandStringList(emailAction["recipients"].([]interface{})),
+			emailAction["subject"].(string),
+			emailAction["body"].(string),
+		)
+	}
+	return d.Get("action").(string)
+}
+
 // CreateAlert implements schema.CreateFunc.
 func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 	var err error
@@ -307,8 +456,7 @@ func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 	condition := d.Get("condition").(string)
 	builder.WithCondition(condition)
 
-	action := d.Get("action").(string)
-	builder.WithAction(action)
+	builder.WithAction(expandAlertAction(d))
 
 	q := builder.Create()
 	if err := snowflake.Exec(db, q); err != nil {
@@ -335,26 +483,9 @@ func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 	return ReadAlert(d, meta)
 }
 
-// UpdateAlert implements schema.UpdateFunc.
-func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
-	alertID, err := alertIDFromString(d.Id())
-	if err != nil {
-		return err
-	}
-
-	db := meta.(*sql.DB)
-	database := alertID.DatabaseName
-	schemaName := alertID.SchemaName
-	name := alertID.AlertName
-	builder := snowflake.NewAlertBuilder(name, database, schemaName)
-
-	enabled := d.Get("enabled").(bool)
-	if d.HasChanges("enabled", "warehouse", "alert_schedule", "condition", "action", "comment") {
-		if err := snowflake.WaitSuspendAlert(db, name, database, schemaName); err != nil {
-			log.Printf("[WARN] failed to suspend alert %s", name)
-		}
-	}
-
+// applyAlertChanges alters the changed properties of the alert. The alert must be suspended.
+func applyAlertChanges(d *schema.ResourceData, db *sql.DB, builder *snowflake.AlertBuilder) error {
+	name := builder.Name()
 	if d.HasChange("warehouse") {
 		var q string
 		newWarehouse := d.Get("warehouse")
@@ -367,11 +498,12 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("alert_schedule") {
 		_, n := d.GetChange("alert_schedule")
-		alertSchedule := n.([]interface{})[0].(map[string]interface{})
-		log.Printf("[DEBUG] alertSchedule: %v", alertSchedule)
-		log.Printf("[DEBUG] alertSchedule[cron]: %v", alertSchedule["cron"])
-		c := alertSchedule["cron"].([]interface{})
-		if len(c) > 0 {
+		// switching to an alert on new data recreates the alert, see customizeAlertDiff
+		if len(n.([]interface{})) > 0 {
+			alertSchedule := n.([]interface{})[0].(map[string]interface{})
+			log.Printf("[DEBUG] alertSchedule: %v", alertSchedule)
+			log.Printf("[DEBUG] alertSchedule[cron]: %v", alertSchedule["cron"])
+			c := alertSchedule["cron"].([]interface{})
 			if len(c) > 0 {
 				cron := c[0].(map[string]interface{})
 				cronExpression := cron["expression"].(string)
@@ -384,13 +516,13 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 				if err := snowflake.Exec(db, stmt); err != nil {
 					return fmt.Errorf("error updating alert cron schedule %v err = %w", name, err)
 				}
-			}
-		} else {
-			log.Printf("[DEBUG] alertSchedule[interval]: %v", alertSchedule["interval"])
-			interval := alertSchedule["interval"].(int)
-			stmt := builder.ChangeAlertIntervalSchedule(interval)
-			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error updating alert interval schedule %v err = %w", name, err)
+			} else {
+				log.Printf("[DEBUG] alertSchedule[interval]: %v", alertSchedule["interval"])
+				interval := alertSchedule["interval"].(int)
+				stmt := builder.ChangeAlertIntervalSchedule(interval)
+				if err := snowflake.Exec(db, stmt); err != nil {
+					return fmt.Errorf("error updating alert interval schedule %v err = %w", name, err)
+				}
 			}
 		}
 	}
@@ -412,19 +544,61 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("action") {
-		newVal := d.Get("action")
-		q := builder.ChangeAction(newVal.(string))
+	if d.HasChanges("action", "email_action") {
+		q := builder.ChangeAction(expandAlertAction(d))
 		if err := snowflake.Exec(db, q); err != nil {
 			return fmt.Errorf("error updating action on alert %v", d.Id())
 		}
 	}
+	return nil
+}
+
+// UpdateAlert implements schema.UpdateFunc.
+func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
+	alertID, err := alertIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	db := meta.(*sql.DB)
+	database := alertID.DatabaseName
+	schemaName := alertID.SchemaName
+	name := alertID.AlertName
+	builder := snowflake.NewAlertBuilder(name, database, schemaName)
+
+	alert, err := snowflake.ScanAlert(snowflake.QueryRow(db, builder.Show()))
+	if err != nil {
+		return err
+	}
+	wasEnabled := alert.IsEnabled()
+
+	changed := d.HasChanges("warehouse", "alert_schedule", "condition", "action", "email_action", "comment")
+	if changed {
+		// an alert can only be altered while it is suspended
+		if wasEnabled {
+			if err := snowflake.WaitSuspendAlert(db, name, database, schemaName); err != nil {
+				return err
+			}
+		}
+		if err := applyAlertChanges(d, db, builder); err != nil {
+			// restore the state of the alert from before the update
+			if wasEnabled {
+				if rerr := snowflake.WaitResumeAlert(db, name, database, schemaName); rerr != nil {
+					log.Printf("[WARN] failed to resume alert %s: %v", name, rerr)
+				}
+			}
+			return err
+		}
+	}
 
+	enabled := d.Get("enabled").(bool)
 	if enabled {
-		if err := snowflake.WaitResumeAlert(db, name, database, schemaName); err != nil {
-			log.Printf("[WARN] failed to resume alert %s", name)
+		if !wasEnabled || changed {
+			if err := snowflake.WaitResumeAlert(db, name, database, schemaName); err != nil {
+				log.Printf("[WARN] failed to resume alert %s", name)
+			}
 		}
-	} else {
+	} else if wasEnabled {
 		if err := snowflake.WaitSuspendAlert(db, name, database, schemaName); err != nil {
 			log.Printf("[WARN] failed to suspend alert %s", name)
 		}
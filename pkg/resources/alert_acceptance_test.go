@@ -206,3 +206,81 @@ resource "snowflake_alert" "test_alert" {
 	}
 	return result.String()
 }
+
+func TestAcc_Alert_OnNewDataWithEmailAction(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: alertOnNewDataConfig(accName, "Errors found"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "email_action.0.subject", "Errors found"),
+					resource.TestCheckResourceAttrSet("snowflake_alert.test_alert", "action"),
+				),
+			},
+			{
+				Config: alertOnNewDataConfig(accName, "New errors found"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "email_action.0.subject", "New errors found"),
+				),
+			},
+		},
+	})
+}
+
+func alertOnNewDataConfig(name string, subject string) string {
+	s := `
+resource "snowflake_warehouse" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+}
+
+resource "snowflake_table" "test" {
+	database = snowflake_database.test.name
+	schema   = snowflake_schema.test.name
+	name     = "ERRORS"
+
+	column {
+		name = "message"
+		type = "VARCHAR(256)"
+	}
+}
+
+resource "snowflake_email_notification_integration" "test" {
+	name               = "%[1]v"
+	enabled            = true
+	allowed_recipients = ["alerts@example.com"]
+}
+
+resource "snowflake_alert" "test_alert" {
+	database  = snowflake_database.test.name
+	schema    = snowflake_schema.test.name
+	name      = "%[1]v"
+	warehouse = snowflake_warehouse.test.name
+	condition = "select * from \"${snowflake_table.test.database}\".\"${snowflake_table.test.schema}\".\"${snowflake_table.test.name}\""
+	enabled   = true
+
+	email_action {
+		integration = snowflake_email_notification_integration.test.name
+		recipients  = ["alerts@example.com"]
+		subject     = "%[2]v"
+		body        = "New rows were added to the errors table."
+	}
+}
+`
+	return fmt.Sprintf(s, name, subject)
+}
@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAlertLastEvaluation(t *testing.T) {
+	builder := snowflake.NewAlertBuilder("test_alert", "test_db", "test_schema")
+	state := &terraform.InstanceState{
+		ID: "test_db|test_schema|test_alert",
+		Attributes: map[string]string{
+			"last_evaluation_state":         "FAILED",
+			"last_evaluation_error_message": "previous error",
+		},
+	}
+
+	t.Run("last evaluation", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"state", "scheduled_time", "completed_time", "error_message"}).
+			AddRow("CONDITION_FALSE", "2023-10-01 10:00:00", "2023-10-01 10:00:05", nil)
+		mock.ExpectQuery(`ALERT_HISTORY`).WillReturnRows(rows)
+
+		d := Alert().Data(state)
+		r.NoError(setAlertLastEvaluation(d, db, builder))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("CONDITION_FALSE", d.Get("last_evaluation_state"))
+		r.Equal("2023-10-01 10:00:05", d.Get("last_evaluation_completed_time"))
+		r.Equal("", d.Get("last_evaluation_error_message"))
+	})
+
+	t.Run("history not readable", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		mock.ExpectQuery(`ALERT_HISTORY`).WillReturnError(errors.New("No active warehouse selected in the current session"))
+
+		d := Alert().Data(state)
+		r.NoError(setAlertLastEvaluation(d, db, builder))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("", d.Get("last_evaluation_state"))
+		r.Equal("", d.Get("last_evaluation_error_message"))
+	})
+}
+
+func TestSetAlertEmailAction(t *testing.T) {
+	emailAction := snowflake.SendEmailAction("my_integration", []string{"a@example.com", "b@example.com"}, "Alert", "It's broken")
+
+	testCases := []struct {
+		name       string
+		attributes map[string]string
+		action     string
+		expected   []interface{}
+	}{
+		{
+			name:       "import",
+			attributes: map[string]string{},
+			action:     emailAction,
+			expected: []interface{}{map[string]interface{}{
+				"integration": "my_integration",
+				"recipients":  []interface{}{"a@example.com", "b@example.com"},
+				"subject":     "Alert",
+				"body":        "It's broken",
+			}},
+		},
+		{
+			name: "drift of the email",
+			attributes: map[string]string{
+				"action":                      snowflake.SendEmailAction("my_integration", []string{"a@example.com"}, "Alert", "body"),
+				"email_action.#":              "1",
+				"email_action.0.integration":  "my_integration",
+				"email_action.0.recipients.#": "1",
+				"email_action.0.recipients.0": "a@example.com",
+				"email_action.0.subject":      "Alert",
+				"email_action.0.body":         "body",
+			},
+			action: emailAction,
+			expected: []interface{}{map[string]interface{}{
+				"integration": "my_integration",
+				"recipients":  []interface{}{"a@example.com", "b@example.com"},
+				"subject":     "Alert",
+				"body":        "It's broken",
+			}},
+		},
+		{
+			name: "action replaced outside of terraform",
+			attributes: map[string]string{
+				"action":                      emailAction,
+				"email_action.#":              "1",
+				"email_action.0.integration":  "my_integration",
+				"email_action.0.recipients.#": "1",
+				"email_action.0.recipients.0": "a@example.com",
+				"email_action.0.subject":      "Alert",
+				"email_action.0.body":         "body",
+			},
+			action:   "SELECT 1",
+			expected: []interface{}{},
+		},
+		{
+			name:       "raw action managed",
+			attributes: map[string]string{"action": "SELECT 1"},
+			action:     emailAction,
+			expected:   []interface{}{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			d := Alert().Data(&terraform.InstanceState{ID: "test_db|test_schema|test_alert", Attributes: tc.attributes})
+			r.NoError(setAlertEmailAction(d, tc.action))
+			r.Equal(tc.expected, d.Get("email_action"))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var computePoolSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the compute pool; must be unique for your account.",
+	},
+	"min_nodes": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "Specifies the minimum number of nodes for the compute pool.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"max_nodes": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "Specifies the maximum number of nodes for the compute pool.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"instance_family": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Identifies the type of machine to provision for the nodes in the compute pool.",
+		ValidateFunc: validation.StringInSlice(computePoolInstanceFamilies(), false),
+	},
+	"auto_resume": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to automatically resume the compute pool when a service or job is submitted to it.",
+	},
+	"auto_suspend_secs": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      3600,
+		Description:  "Number of seconds of inactivity after which the compute pool is automatically suspended.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the compute pool should be suspended. The compute pool is created in the suspended state when set to true, and is suspended or resumed when the value changes.",
+	},
+	"for_application": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the Snowflake Native App name when creating a compute pool exclusively for an application.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the compute pool.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current state of the compute pool (e.g. IDLE, ACTIVE, SUSPENDED).",
+	},
+}
+
+func ComputePool() *schema.Resource {
+	return &schema.Resource{
+		Description: "A compute pool is a collection of virtual machine nodes on which Snowpark Container Services run services and jobs.",
+		Create:      CreateComputePool,
+		Read:        ReadComputePool,
+		Update:      UpdateComputePool,
+		Delete:      DeleteComputePool,
+
+		Schema: computePoolSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func computePoolInstanceFamilies() []string {
+	families := make([]string, 0, len(sdk.AllComputePoolInstanceFamilies))
+	for _, family := range sdk.AllComputePoolInstanceFamilies {
+		families = append(families, string(family))
+	}
+	return families
+}
+
+// CreateComputePool implements schema.CreateFunc.
+func CreateComputePool(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
+
+	createOptions := &sdk.ComputePoolCreateOptions{
+		MinNodes:        d.Get("min_nodes").(int),
+		MaxNodes:        d.Get("max_nodes").(int),
+		InstanceFamily:  sdk.ComputePoolInstanceFamily(d.Get("instance_family").(string)),
+		AutoResume:      sdk.Bool(d.Get("auto_resume").(bool)),
+		AutoSuspendSecs: sdk.Int(d.Get("auto_suspend_secs").(int)),
+	}
+	if d.Get("suspended").(bool) {
+		createOptions.InitiallySuspended = sdk.Bool(true)
+	}
+	if v, ok := d.GetOk("for_application"); ok {
+		createOptions.ForApplication = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.ComputePools.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating compute pool %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadComputePool(d, meta)
+}
+
+// ReadComputePool implements schema.ReadFunc.
+func ReadComputePool(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	computePool, err := client.ComputePools.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name", computePool.Name); err != nil {
+		return err
+	}
+	if err := d.Set("min_nodes", computePool.MinNodes); err != nil {
+		return err
+	}
+	if err := d.Set("max_nodes", computePool.MaxNodes); err != nil {
+		return err
+	}
+	if err := d.Set("instance_family", string(computePool.InstanceFamily)); err != nil {
+		return err
+	}
+	if err := d.Set("auto_resume", computePool.AutoResume); err != nil {
+		return err
+	}
+	if err := d.Set("auto_suspend_secs", computePool.AutoSuspendSecs); err != nil {
+		return err
+	}
+	if err := d.Set("for_application", computePool.Application); err != nil {
+		return err
+	}
+	if err := d.Set("comment", computePool.Comment); err != nil {
+		return err
+	}
+	// suspended is not derived from the reported state, since an idle compute pool may be
+	// suspended by Snowflake on its own through auto_suspend_secs.
+	if err := d.Set("state", string(computePool.State)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateComputePool implements schema.UpdateFunc.
+func UpdateComputePool(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set := &sdk.ComputePoolSet{}
+	runSet := false
+	if d.HasChange("min_nodes") {
+		set.MinNodes = sdk.Int(d.Get("min_nodes").(int))
+		runSet = true
+	}
+	if d.HasChange("max_nodes") {
+		set.MaxNodes = sdk.Int(d.Get("max_nodes").(int))
+		runSet = true
+	}
+	if d.HasChange("auto_resume") {
+		set.AutoResume = sdk.Bool(d.Get("auto_resume").(bool))
+		runSet = true
+	}
+	if d.HasChange("auto_suspend_secs") {
+		set.AutoSuspendSecs = sdk.Int(d.Get("auto_suspend_secs").(int))
+		runSet = true
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset := &sdk.ComputePoolUnset{Comment: sdk.Bool(true)}
+			if err := client.ComputePools.Alter(ctx, objectIdentifier, &sdk.ComputePoolAlterOptions{Unset: unset}); err != nil {
+				return fmt.Errorf("error updating compute pool %v err = %w", objectIdentifier.Name(), err)
+			}
+		}
+	}
+	if runSet {
+		if err := client.ComputePools.Alter(ctx, objectIdentifier, &sdk.ComputePoolAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating compute pool %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if d.HasChange("suspended") {
+		alterOptions := &sdk.ComputePoolAlterOptions{}
+		if d.Get("suspended").(bool) {
+			alterOptions.Suspend = sdk.Bool(true)
+		} else {
+			alterOptions.Resume = sdk.Bool(true)
+		}
+		if err := client.ComputePools.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating compute pool %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadComputePool(d, meta)
+}
+
+// DeleteComputePool implements schema.DeleteFunc.
+func DeleteComputePool(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	// a compute pool can only be dropped once all the services running on it are stopped
+	if err := client.ComputePools.Alter(ctx, objectIdentifier, &sdk.ComputePoolAlterOptions{StopAll: sdk.Bool(true)}); err != nil {
+		return err
+	}
+	if err := client.ComputePools.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ComputePool(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: computePoolConfig(accName, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "instance_family", "CPU_X64_XS"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "max_nodes", "1"),
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "state", "SUSPENDED"),
+				),
+			},
+			{
+				Config: computePoolConfig(accName, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_compute_pool.test", "max_nodes", "2"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_compute_pool.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"suspended"},
+			},
+		},
+	})
+}
+
+func computePoolConfig(name string, maxNodes int, suspended bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_compute_pool" "test" {
+	name              = "%v"
+	min_nodes         = 1
+	max_nodes         = %v
+	instance_family   = "CPU_X64_XS"
+	auto_resume       = false
+	auto_suspend_secs = 300
+	suspended         = %v
+	comment           = "Terraform acceptance test"
+}
+`, name, maxNodes, suspended)
+}
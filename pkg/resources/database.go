@@ -80,7 +80,8 @@ var databaseSchema = map[string]*schema.Schema{
 			},
 		},
 	},
-	"tag": tagReferenceSchema,
+	"tag":           tagReferenceSchema,
+	"account_alias": accountAliasSchema,
 }
 
 // Database returns a pointer to the resource representing a database.
@@ -93,7 +94,7 @@ func Database() *schema.Resource {
 
 		Schema: databaseSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithAccountAlias,
 		},
 	}
 }
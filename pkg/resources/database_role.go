@@ -2,40 +2,29 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
 	"strings"
 
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const (
 	databaseRoleIDDelimiter = '|'
 )
 
-var databaseRoleSchema = map[string]*schema.Schema{
-	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier for the database role.",
-		ForceNew:    true,
-	},
-	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The database in which to create the database role.",
-		ForceNew:    true,
-	},
-	"comment": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies a comment for the database role.",
-	},
-}
-
 type databaseRoleID struct {
 	DatabaseName string
 	RoleName     string
@@ -59,7 +48,7 @@ func (id *databaseRoleID) String() (string, error) {
 // and returns a databaseRoleID object.
 func databaseRoleIDFromString(stringID string) (*databaseRoleID, error) {
 	reader := csv.NewReader(strings.NewReader(stringID))
-	reader.Comma = pipeIDDelimiter
+	reader.Comma = databaseRoleIDDelimiter
 	lines, err := reader.ReadAll()
 	if err != nil {
 		return nil, fmt.Errorf("not CSV compatible")
@@ -78,137 +67,238 @@ func databaseRoleIDFromString(stringID string) (*databaseRoleID, error) {
 	return dbRoleResult, nil
 }
 
-// DatabaseRole returns a pointer to the resource representing a database role.
-func DatabaseRole() *schema.Resource {
-	return &schema.Resource{
-		Create: CreateDatabaseRole,
-		Read:   ReadDatabaseRole,
-		Update: UpdateDatabaseRole,
-		Delete: DeleteDatabaseRole,
+var (
+	_ resource.Resource                = &databaseRoleResource{}
+	_ resource.ResourceWithConfigure   = &databaseRoleResource{}
+	_ resource.ResourceWithImportState = &databaseRoleResource{}
+)
 
-		Schema: databaseRoleSchema,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
+// NewDatabaseRoleResource returns the resource representing a database role. It is built on the plugin framework and
+// keeps the ID and attributes of its SDKv2 version, so existing state is read as it is.
+func NewDatabaseRoleResource() resource.Resource {
+	return &databaseRoleResource{}
 }
 
-// ReadDatabaseRole implements schema.ReadFunc.
-func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	dbRoleID, err := databaseRoleIDFromString(d.Id())
-	if err != nil {
-		return err
-	}
+type databaseRoleResource struct {
+	conn *snowflakedb.Connection
+}
 
-	databaseName := dbRoleID.DatabaseName
-	roleName := dbRoleID.RoleName
+type databaseRoleModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Database types.String `tfsdk:"database"`
+	Comment  types.String `tfsdk:"comment"`
+}
 
-	roles, err := snowflake.ListDatabaseRoles(databaseName, db)
-	if err != nil {
-		return fmt.Errorf("error listing database roles err = %w", err)
+func (r *databaseRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_role"
+}
+
+func (r *databaseRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Specifies the identifier for the database role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The database in which to create the database role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Specifies a comment for the database role.",
+			},
+		},
 	}
+}
 
-	var databaseRole snowflake.DatabaseRole
-	// find the db role we are looking for by matching the name and db name
-	for _, dbRole := range roles {
-		if strings.EqualFold(dbRole.Name, roleName) {
-			databaseRole = dbRole
-			databaseRole.DatabaseName = databaseName // needs to be set as it's not included in the SHOW stmt results
-			break
-		}
+func (r *databaseRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
+	conn, ok := req.ProviderData.(*snowflakedb.Connection)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *db.Connection, got %T", req.ProviderData))
+		return
+	}
+	r.conn = conn
+}
 
-	if databaseRole.Name == "" {
-		log.Printf("[DEBUG] database role (%v) not found when listing all database roles in database (%v)", roleName, databaseName)
-		d.SetId("")
+// acquireOperation waits until the operation can run within the max_concurrent_operations of the provider, and
+// returns the function releasing it. It returns nil, with an error diagnostic, when the wait is cancelled.
+func (r *databaseRoleResource) acquireOperation(ctx context.Context, diags *diag.Diagnostics) func() {
+	release, err := r.conn.AcquireOperation(ctx)
+	if err != nil {
+		diags.AddError("Could not start the operation", err.Error())
 		return nil
 	}
+	return release
+}
 
-	if err := d.Set("name", databaseRole.Name); err != nil {
-		return err
-	}
+func (r *databaseRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	if err := d.Set("database", databaseRole.DatabaseName); err != nil {
-		return err
+func (r *databaseRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	release := r.acquireOperation(ctx, &resp.Diagnostics)
+	if release == nil {
+		return
 	}
+	defer release()
 
-	if err := d.Set("comment", databaseRole.Comment); err != nil {
-		return err
+	var plan databaseRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return nil
-}
-
-// CreateDatabaseRole implements schema.CreateFunc.
-func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	var err error
-	db := meta.(*sql.DB)
-	databaseName := d.Get("database").(string)
-	roleName := d.Get("name").(string)
 
+	databaseName := plan.Database.ValueString()
+	roleName := plan.Name.ValueString()
 	builder := snowflake.NewDatabaseRoleBuilder(roleName, databaseName)
-	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+	if comment := plan.Comment.ValueString(); comment != "" {
+		builder.WithComment(comment)
 	}
-
-	qry := builder.Create()
-	if err := snowflake.Exec(db, qry); err != nil {
-		return fmt.Errorf("error creating database role %v err = %w", roleName, err)
+	if err := snowflake.Exec(r.conn.DB, builder.Create()); err != nil {
+		resp.Diagnostics.AddError("Could not create database role", fmt.Sprintf("error creating database role %v err = %v", roleName, err))
+		return
 	}
 
 	dbRoleID := &databaseRoleID{
 		DatabaseName: databaseName,
 		RoleName:     roleName,
 	}
-	dataIDInput, err := dbRoleID.String()
+	id, err := dbRoleID.String()
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Could not create database role", err.Error())
+		return
 	}
-	d.SetId(dataIDInput)
-
-	return ReadDatabaseRole(d, meta)
+	plan.ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-// UpdateDatabaseRole implements schema.UpdateFunc.
-func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	dbRoleID, err := databaseRoleIDFromString(d.Id())
+func (r *databaseRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	release := r.acquireOperation(ctx, &resp.Diagnostics)
+	if release == nil {
+		return
+	}
+	defer release()
+
+	var state databaseRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := readDatabaseRole(r.conn.DB, &state)
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Could not read database role", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	db := meta.(*sql.DB)
-	databaseName := dbRoleID.DatabaseName
-	roleName := dbRoleID.RoleName
-	builder := snowflake.NewDatabaseRoleBuilder(roleName, databaseName)
+func (r *databaseRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	release := r.acquireOperation(ctx, &resp.Diagnostics)
+	if release == nil {
+		return
+	}
+	defer release()
 
-	if d.HasChange("comment") {
-		var q string
-		_, newVal := d.GetChange("comment")
-		q = builder.ChangeComment(newVal.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating comment on database role %v", d.Id())
-		}
+	var plan, state databaseRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbRoleID, err := databaseRoleIDFromString(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not update database role", err.Error())
+		return
 	}
+	builder := snowflake.NewDatabaseRoleBuilder(dbRoleID.RoleName, dbRoleID.DatabaseName)
 
-	return ReadDatabaseRole(d, meta)
+	if !plan.Comment.Equal(state.Comment) {
+		if err := snowflake.Exec(r.conn.DB, builder.ChangeComment(plan.Comment.ValueString())); err != nil {
+			resp.Diagnostics.AddError("Could not update database role", fmt.Sprintf("error updating comment on database role %v err = %v", state.ID.ValueString(), err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-// DeleteDatabaseRole implements schema.DeleteFunc.
-func DeleteDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	dbRoleID, err := databaseRoleIDFromString(d.Id())
+func (r *databaseRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	release := r.acquireOperation(ctx, &resp.Diagnostics)
+	if release == nil {
+		return
+	}
+	defer release()
+
+	var state databaseRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbRoleID, err := databaseRoleIDFromString(state.ID.ValueString())
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Could not delete database role", err.Error())
+		return
+	}
+	q := snowflake.NewDatabaseRoleBuilder(dbRoleID.RoleName, dbRoleID.DatabaseName).Drop()
+	if err := snowflake.Exec(r.conn.DB, q); err != nil {
+		resp.Diagnostics.AddError("Could not delete database role", fmt.Sprintf("error deleting database role %v err = %v", state.ID.ValueString(), err))
 	}
+}
 
-	roleName := dbRoleID.RoleName
+// readDatabaseRole refreshes the model of the database role identified by its ID, and reports whether the database
+// role still exists.
+func readDatabaseRole(db *sql.DB, model *databaseRoleModel) (bool, error) {
+	dbRoleID, err := databaseRoleIDFromString(model.ID.ValueString())
+	if err != nil {
+		return false, err
+	}
 	databaseName := dbRoleID.DatabaseName
+	roleName := dbRoleID.RoleName
 
-	q := snowflake.NewDatabaseRoleBuilder(roleName, databaseName).Drop()
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error deleting database role %v err = %w", d.Id(), err)
+	roles, err := snowflake.ListDatabaseRoles(databaseName, db)
+	if err != nil {
+		return false, fmt.Errorf("error listing database roles err = %w", err)
 	}
 
-	d.SetId("")
-	return nil
+	// find the db role we are looking for by matching the name, the database name is not included in the SHOW results
+	for _, dbRole := range roles {
+		if strings.EqualFold(dbRole.Name, roleName) {
+			model.Name = types.StringValue(dbRole.Name)
+			model.Database = types.StringValue(databaseName)
+			comment := ""
+			if dbRole.Comment != nil {
+				comment = *dbRole.Comment
+			}
+			model.Comment = types.StringValue(comment)
+			return true, nil
+		}
+	}
+	log.Printf("[DEBUG] database role (%v) not found when listing all database roles in database (%v)", roleName, databaseName)
+	return false, nil
 }
@@ -19,8 +19,8 @@ var (
 
 func TestAcc_DatabaseRole(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		Providers:    providers(),
-		CheckDestroy: nil,
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		CheckDestroy:             nil,
 		Steps: []resource.TestStep{
 			{
 				Config: databaseRoleConfig(dbName, dbRoleName, comment),
@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+func TestDatabaseRoleIDFromString(t *testing.T) {
+	r := require.New(t)
+
+	id, err := databaseRoleIDFromString("test_db|test_role")
+	r.NoError(err)
+	r.Equal(&databaseRoleID{DatabaseName: "test_db", RoleName: "test_role"}, id)
+
+	_, err = databaseRoleIDFromString("test_db")
+	r.EqualError(err, "2 fields allowed")
+}
+
+func TestReadDatabaseRole(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		found    bool
+		expected databaseRoleModel
+	}{
+		{
+			name: "with comment",
+			rows: sqlmock.NewRows([]string{"created_on", "name", "owner", "comment"}).
+				AddRow("2023-10-01", "test_role", "ACCOUNTADMIN", "a comment"),
+			found: true,
+			expected: databaseRoleModel{
+				ID:       types.StringValue("test_db|test_role"),
+				Name:     types.StringValue("test_role"),
+				Database: types.StringValue("test_db"),
+				Comment:  types.StringValue("a comment"),
+			},
+		},
+		{
+			name: "without comment",
+			rows: sqlmock.NewRows([]string{"created_on", "name", "owner", "comment"}).
+				AddRow("2023-10-01", "test_role", "ACCOUNTADMIN", nil),
+			found: true,
+			expected: databaseRoleModel{
+				ID:       types.StringValue("test_db|test_role"),
+				Name:     types.StringValue("test_role"),
+				Database: types.StringValue("test_db"),
+				Comment:  types.StringValue(""),
+			},
+		},
+		{
+			name: "dropped outside of terraform",
+			rows: sqlmock.NewRows([]string{"created_on", "name", "owner", "comment"}).
+				AddRow("2023-10-01", "other_role", "ACCOUNTADMIN", nil),
+			expected: databaseRoleModel{
+				ID: types.StringValue("test_db|test_role"),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			db, mock, err := sqlmock.New()
+			r.NoError(err)
+			defer db.Close()
+
+			mock.ExpectQuery(`^SHOW DATABASE ROLES IN DATABASE "test_db"$`).WillReturnRows(tc.rows)
+
+			model := databaseRoleModel{ID: types.StringValue("test_db|test_role")}
+			found, err := readDatabaseRole(db, &model)
+			r.NoError(err)
+			r.NoError(mock.ExpectationsWereMet())
+			r.Equal(tc.found, found)
+			r.Equal(tc.expected, model)
+		})
+	}
+}
+
+func TestDatabaseRoleResourceLimitsOperations(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	conn := snowflakedb.NewConnection(db, nil, 1)
+	res := &databaseRoleResource{conn: conn}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	r.False(state.Set(ctx, &databaseRoleModel{
+		ID:       types.StringValue("test_db|test_role"),
+		Name:     types.StringValue("test_role"),
+		Database: types.StringValue("test_db"),
+		Comment:  types.StringValue(""),
+	}).HasError())
+
+	// the only operation is taken, so the delete waits until its context is cancelled without running
+	release, err := conn.AcquireOperation(ctx)
+	r.NoError(err)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	resp := &resource.DeleteResponse{State: state}
+	res.Delete(cancelled, resource.DeleteRequest{State: state}, resp)
+	r.True(resp.Diagnostics.HasError())
+	release()
+
+	mock.ExpectExec(`^DROP DATABASE ROLE "test_db"."test_role"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	resp = &resource.DeleteResponse{State: state}
+	res.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	r.False(resp.Diagnostics.HasError())
+	r.NoError(mock.ExpectationsWereMet())
+
+	// the delete releases its operation once it is done
+	timeout, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	release, err = conn.AcquireOperation(timeout)
+	r.NoError(err)
+	release()
+}
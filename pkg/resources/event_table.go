@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the event table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the event table.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the event table; must be unique for the schema in which the event table is created.",
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more columns/expressions to be used as clustering key(s) for the event table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		Description:  "Specifies the retention period for the event table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the event table.",
+		ValidateFunc: validation.IntBetween(0, 90),
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the event table.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the event table.",
+	},
+}
+
+func EventTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "An event table is a special kind of table with a predefined set of columns, used to collect the logs and trace events emitted by functions and procedures. Set it as the active event table of the account with the `EVENT_TABLE` parameter of `snowflake_account_parameter`.",
+		Create:      CreateEventTable,
+		Read:        ReadEventTable,
+		Update:      UpdateEventTable,
+		Delete:      DeleteEventTable,
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func eventTableBuilderFromID(id string) *snowflake.TableBuilder {
+	objectIdentifier := helpers.DecodeSnowflakeID(id).(sdk.SchemaObjectIdentifier)
+	return snowflake.NewEventTableBuilder(objectIdentifier.Name(), objectIdentifier.DatabaseName(), objectIdentifier.SchemaName())
+}
+
+// CreateEventTable implements schema.CreateFunc.
+func CreateEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+
+	builder := snowflake.NewEventTableBuilder(name, database, schema)
+	if v, ok := d.GetOk("cluster_by"); ok {
+		builder.WithClustering(expandStringList(v.([]interface{})))
+	}
+	builder.WithDataRetentionTimeInDays(d.Get("data_retention_time_in_days").(int))
+	builder.WithChangeTracking(d.Get("change_tracking").(bool))
+	if v, ok := d.GetOk("comment"); ok {
+		builder.WithComment(v.(string))
+	}
+
+	if err := snowflake.Exec(db, builder.CreateEventTable()); err != nil {
+		return fmt.Errorf("error creating event table %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(database, schema, name))
+	return ReadEventTable(d, meta)
+}
+
+// ReadEventTable implements schema.ReadFunc.
+func ReadEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder := eventTableBuilderFromID(d.Id())
+
+	row := snowflake.QueryRow(db, builder.ShowEventTable())
+	eventTable, err := snowflake.ScanTable(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] event table (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", eventTable.DatabaseName.String); err != nil {
+		return err
+	}
+	if err := d.Set("schema", eventTable.SchemaName.String); err != nil {
+		return err
+	}
+	if err := d.Set("name", eventTable.TableName.String); err != nil {
+		return err
+	}
+	if err := d.Set("comment", eventTable.Comment.String); err != nil {
+		return err
+	}
+	if err := d.Set("owner", eventTable.Owner.String); err != nil {
+		return err
+	}
+
+	// SHOW EVENT TABLES does not report the retention time, so it is read from the table parameters.
+	// Clustering and change tracking are not reported at all and are not refreshed.
+	p, err := snowflake.ShowObjectParameter(db, "DATA_RETENTION_TIME_IN_DAYS", snowflake.ObjectTypeTable, builder.QualifiedName())
+	if err != nil {
+		return err
+	}
+	if p != nil {
+		retention, err := strconv.Atoi(p.Value.String)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("data_retention_time_in_days", retention); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("qualified_name", builder.QualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateEventTable implements schema.UpdateFunc.
+func UpdateEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder := eventTableBuilderFromID(d.Id())
+
+	if d.HasChange("cluster_by") {
+		var q string
+		if v, ok := d.GetOk("cluster_by"); ok {
+			q = builder.ChangeClusterBy(snowflake.JoinStringList(expandStringList(v.([]interface{})), ", "))
+		} else {
+			q = builder.DropClustering()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating event table clustering on %v err = %w", d.Id(), err)
+		}
+	}
+	if d.HasChange("data_retention_time_in_days") {
+		q := builder.ChangeDataRetention(d.Get("data_retention_time_in_days").(int))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating event table data retention on %v err = %w", d.Id(), err)
+		}
+	}
+	if d.HasChange("change_tracking") {
+		q := builder.ChangeChangeTracking(d.Get("change_tracking").(bool))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating event table change tracking on %v err = %w", d.Id(), err)
+		}
+	}
+	if d.HasChange("comment") {
+		var q string
+		if v, ok := d.GetOk("comment"); ok {
+			q = builder.ChangeComment(v.(string))
+		} else {
+			q = builder.RemoveComment()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating event table comment on %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadEventTable(d, meta)
+}
+
+// DeleteEventTable implements schema.DeleteFunc.
+func DeleteEventTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder := eventTableBuilderFromID(d.Id())
+	if err := snowflake.Exec(db, builder.Drop()); err != nil {
+		return fmt.Errorf("error deleting event table %v err = %w", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,70 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_EventTable(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTableConfig(accName, 1, false, "Terraform acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "data_retention_time_in_days", "1"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "change_tracking", "false"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "qualified_name", fmt.Sprintf(`"%[1]v"."%[1]v"."%[1]v"`, accName)),
+				),
+			},
+			{
+				Config: eventTableConfig(accName, 5, true, "Terraform acceptance test - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "data_retention_time_in_days", "5"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_event_table.test", "comment", "Terraform acceptance test - updated"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_event_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// clustering and change tracking are not reported by SHOW EVENT TABLES
+				ImportStateVerifyIgnore: []string{"cluster_by", "change_tracking"},
+			},
+		},
+	})
+}
+
+func eventTableConfig(name string, retention int, changeTracking bool, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_event_table" "test" {
+	name                        = "%[1]v"
+	database                    = snowflake_database.test.name
+	schema                      = snowflake_schema.test.name
+	data_retention_time_in_days = %[2]v
+	change_tracking             = %[3]v
+	comment                     = "%[4]v"
+}
+`, name, retention, changeTracking, comment)
+}
@@ -0,0 +1,247 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the external access integration; must be unique in your account.",
+	},
+	"allowed_network_rules": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+		},
+		Required:    true,
+		Description: "Specifies the fully qualified names of the network rules that define the external network locations allowed by the integration (e.g. the `qualified_name` of a `snowflake_network_rule`).",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the security integrations whose OAuth authorization server issued the secrets used by UDFs and procedures using this integration.",
+	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+		},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the secrets that UDFs and procedures using this integration are allowed to use (e.g. the `qualified_name` of a `snowflake_secret`).",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether this integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the external access integration was created.",
+	},
+}
+
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "An external access integration allows UDFs and procedures to access external network locations and use the configured secrets.",
+		Create:      CreateExternalAccessIntegration,
+		Read:        ReadExternalAccessIntegration,
+		Update:      UpdateExternalAccessIntegration,
+		Delete:      DeleteExternalAccessIntegration,
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandSchemaObjectIdentifierSet(v interface{}) []sdk.SchemaObjectIdentifier {
+	ids := make([]sdk.SchemaObjectIdentifier, 0)
+	for _, s := range expandStringList(v.(*schema.Set).List()) {
+		dbName, schemaName, objectName := snowflakeValidation.ParseFullyQualifiedObjectID(s)
+		ids = append(ids, sdk.NewSchemaObjectIdentifier(dbName, schemaName, objectName))
+	}
+	return ids
+}
+
+func expandAccountObjectIdentifierSet(v interface{}) []sdk.AccountObjectIdentifier {
+	ids := make([]sdk.AccountObjectIdentifier, 0)
+	for _, s := range expandStringList(v.(*schema.Set).List()) {
+		ids = append(ids, sdk.NewAccountObjectIdentifier(s))
+	}
+	return ids
+}
+
+// CreateExternalAccessIntegration implements schema.CreateFunc.
+func CreateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
+
+	createOptions := &sdk.ExternalAccessIntegrationCreateOptions{
+		AllowedNetworkRules: expandSchemaObjectIdentifierSet(d.Get("allowed_network_rules")),
+		Enabled:             d.Get("enabled").(bool),
+	}
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		createOptions.AllowedAPIAuthenticationIntegrations = expandAccountObjectIdentifierSet(v)
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		createOptions.AllowedAuthenticationSecrets = expandSchemaObjectIdentifierSet(v)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.ExternalAccessIntegrations.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating external access integration %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// ReadExternalAccessIntegration implements schema.ReadFunc.
+func ReadExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name", integration.Name); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return err
+	}
+	if err := d.Set("comment", integration.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", integration.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	details, err := client.ExternalAccessIntegrations.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	networkRules := make([]string, 0, len(details.AllowedNetworkRules))
+	for _, id := range details.AllowedNetworkRules {
+		networkRules = append(networkRules, id.FullyQualifiedName())
+	}
+	if err := d.Set("allowed_network_rules", networkRules); err != nil {
+		return err
+	}
+	apiIntegrations := make([]string, 0, len(details.AllowedAPIAuthenticationIntegrations))
+	for _, id := range details.AllowedAPIAuthenticationIntegrations {
+		apiIntegrations = append(apiIntegrations, id.Name())
+	}
+	if err := d.Set("allowed_api_authentication_integrations", apiIntegrations); err != nil {
+		return err
+	}
+	secrets := make([]string, 0, len(details.AllowedAuthenticationSecrets))
+	for _, id := range details.AllowedAuthenticationSecrets {
+		secrets = append(secrets, id.FullyQualifiedName())
+	}
+	if err := d.Set("allowed_authentication_secrets", secrets); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateExternalAccessIntegration implements schema.UpdateFunc.
+func UpdateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	set := &sdk.ExternalAccessIntegrationSet{}
+	runSet := false
+	if d.HasChange("allowed_network_rules") {
+		set.AllowedNetworkRules = expandSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+		runSet = true
+	}
+	if d.HasChange("enabled") {
+		set.Enabled = sdk.Bool(d.Get("enabled").(bool))
+		runSet = true
+	}
+	if d.HasChange("allowed_api_authentication_integrations") {
+		if ids := expandAccountObjectIdentifierSet(d.Get("allowed_api_authentication_integrations")); len(ids) > 0 {
+			set.AllowedAPIAuthenticationIntegrations = ids
+			runSet = true
+		} else {
+			unset := &sdk.ExternalAccessIntegrationUnset{AllowedAPIAuthenticationIntegrations: sdk.Bool(true)}
+			if err := client.ExternalAccessIntegrations.Alter(ctx, objectIdentifier, &sdk.ExternalAccessIntegrationAlterOptions{Unset: unset}); err != nil {
+				return fmt.Errorf("error updating external access integration %v err = %w", objectIdentifier.Name(), err)
+			}
+		}
+	}
+	if d.HasChange("allowed_authentication_secrets") {
+		if ids := expandSchemaObjectIdentifierSet(d.Get("allowed_authentication_secrets")); len(ids) > 0 {
+			set.AllowedAuthenticationSecrets = ids
+			runSet = true
+		} else {
+			unset := &sdk.ExternalAccessIntegrationUnset{AllowedAuthenticationSecrets: sdk.Bool(true)}
+			if err := client.ExternalAccessIntegrations.Alter(ctx, objectIdentifier, &sdk.ExternalAccessIntegrationAlterOptions{Unset: unset}); err != nil {
+				return fmt.Errorf("error updating external access integration %v err = %w", objectIdentifier.Name(), err)
+			}
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset := &sdk.ExternalAccessIntegrationUnset{Comment: sdk.Bool(true)}
+			if err := client.ExternalAccessIntegrations.Alter(ctx, objectIdentifier, &sdk.ExternalAccessIntegrationAlterOptions{Unset: unset}); err != nil {
+				return fmt.Errorf("error updating external access integration %v err = %w", objectIdentifier.Name(), err)
+			}
+		}
+	}
+	if runSet {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, objectIdentifier, &sdk.ExternalAccessIntegrationAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating external access integration %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// DeleteExternalAccessIntegration implements schema.DeleteFunc.
+func DeleteExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	if err := client.ExternalAccessIntegrations.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
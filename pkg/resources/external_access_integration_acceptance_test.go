@@ -0,0 +1,81 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ExternalAccessIntegration(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: externalAccessIntegrationConfig(accName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "1"),
+				),
+			},
+			{
+				Config: externalAccessIntegrationConfig(accName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_external_access_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func externalAccessIntegrationConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_network_rule" "test" {
+	name       = "%[1]v"
+	database   = snowflake_database.test.name
+	schema     = snowflake_schema.test.name
+	type       = "HOST_PORT"
+	mode       = "EGRESS"
+	value_list = ["example.com"]
+}
+
+resource "snowflake_secret" "test" {
+	name          = "%[1]v"
+	database      = snowflake_database.test.name
+	schema        = snowflake_schema.test.name
+	type          = "GENERIC_STRING"
+	secret_string = "secret"
+}
+
+resource "snowflake_external_access_integration" "test" {
+	name                           = "%[1]v"
+	allowed_network_rules          = [snowflake_network_rule.test.qualified_name]
+	allowed_authentication_secrets = [snowflake_secret.test.qualified_name]
+	enabled                        = %[2]t
+	comment                        = "Terraform acceptance test"
+}
+`, name, enabled)
+}
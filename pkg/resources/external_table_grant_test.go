@@ -35,6 +35,7 @@ func TestExternalTableGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW GRANTS ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table"`)
 		mock.ExpectExec(`^GRANT SELECT ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON EXTERNAL TABLE "test-db"."PUBLIC"."test-external-table" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -107,6 +108,7 @@ func TestFutureExternalTableGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW FUTURE GRANTS IN SCHEMA "test-db"."PUBLIC"`)
 		mock.ExpectExec(
 			`^GRANT SELECT ON FUTURE EXTERNAL TABLES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-1" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -131,6 +133,7 @@ func TestFutureExternalTableGrantCreate(t *testing.T) {
 	b.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW FUTURE GRANTS IN DATABASE "test-db"`)
 		mock.ExpectExec(
 			`^GRANT SELECT ON FUTURE EXTERNAL TABLES IN DATABASE "test-db" TO ROLE "test-role-1"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -133,6 +133,134 @@ var failoverGroupSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"refresh_triggers": {
+		Type:         schema.TypeMap,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Arbitrary map of values that, when changed, refresh the secondary group from its primary. The group is also refreshed when it is created with this set.",
+	},
+	"promote_to_primary": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		RequiredWith: []string{"from_replica"},
+		Description:  "Promotes the secondary failover group to serve as the primary group when changed to true, as part of a failover. The promotion is refused while a refresh of the group is in progress. Setting it back to false does not demote the group; the group becomes a secondary again only when another group is promoted.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the group is the primary group.",
+	},
+	"secondary_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of scheduled refreshes of a secondary group: STARTED or SUSPENDED.",
+	},
+	"refresh_phase": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The current phase of the current or most recent refresh of a secondary group, e.g. PRIMARY_UPLOADING_DATA or COMPLETED.",
+	},
+	"last_refresh_start_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the current or most recent refresh of a secondary group started.",
+	},
+	"last_refresh_end_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the most recent refresh of a secondary group ended. Empty while a refresh is in progress.",
+	},
+	"replication_lag_seconds": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Seconds elapsed since the start of the most recent completed refresh of a secondary group, i.e. how far the secondary group lags behind its primary. 0 when no completed refresh is known.",
+	},
+	"account_alias": accountAliasSchema,
+}
+
+// refreshTerminalPhases are the refresh phases that end a refresh.
+var refreshTerminalPhases = []string{"COMPLETED", "FAILED", "CANCELED"}
+
+// groupRefreshStatus summarizes the phases of the current or most recent refresh of a secondary group.
+type groupRefreshStatus struct {
+	Phase      string
+	StartTime  string
+	EndTime    string
+	LagSeconds int
+}
+
+func newGroupRefreshStatus(phases []snowflake.RefreshPhase) groupRefreshStatus {
+	status := groupRefreshStatus{}
+	if len(phases) == 0 {
+		return status
+	}
+	first, last := phases[0], phases[len(phases)-1]
+	status.Phase = last.PhaseName
+	status.StartTime = first.StartTime.String
+	if status.Done() {
+		status.EndTime = last.EndTime.String
+		if last.EndTime.String == "" {
+			status.EndTime = last.StartTime.String
+		}
+	}
+	if status.Phase == "COMPLETED" && first.AgeSeconds.Valid {
+		status.LagSeconds = int(first.AgeSeconds.Int64)
+	}
+	return status
+}
+
+// Done reports whether no refresh is in progress.
+func (s groupRefreshStatus) Done() bool {
+	return s.Phase == "" || slices.Contains(refreshTerminalPhases, s.Phase)
+}
+
+// setGroupRefreshStatus reads the refresh progress of a secondary group. The progress is informational, so failing
+// to read it, e.g. for lack of privileges, does not fail the read of the group.
+// A primary group is never refreshed, so its refresh status is left empty.
+func setGroupRefreshStatus(d *schema.ResourceData, db *sql.DB, builder *snowflake.FailoverGroupBuilder, isPrimary bool) error {
+	status := groupRefreshStatus{}
+	if !isPrimary {
+		phases, err := builder.ListRefreshPhases(db)
+		if err != nil {
+			log.Printf("[WARN] could not read refresh progress of %v %v: %v", strings.ToLower(builder.GroupType()), d.Id(), err)
+		} else {
+			status = newGroupRefreshStatus(phases)
+		}
+	}
+	values := map[string]interface{}{
+		"refresh_phase":           status.Phase,
+		"last_refresh_start_time": status.StartTime,
+		"last_refresh_end_time":   status.EndTime,
+		"replication_lag_seconds": status.LagSeconds,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// promoteGroup promotes the secondary group to serve as the primary group. It refuses to do so while a refresh is
+// in progress, since promoting would cancel the refresh and leave the group with partially refreshed objects.
+func promoteGroup(db *sql.DB, builder *snowflake.FailoverGroupBuilder, isPrimary bool, name string) error {
+	if isPrimary {
+		log.Printf("[DEBUG] failover group %v is already the primary group", name)
+		return nil
+	}
+	phases, err := builder.ListRefreshPhases(db)
+	if err != nil {
+		return fmt.Errorf("error reading refresh progress of failover group %v err = %w", name, err)
+	}
+	if status := newGroupRefreshStatus(phases); !status.Done() {
+		return fmt.Errorf("refusing to promote failover group %v to primary while a refresh is in progress (phase %v); retry once the refresh has finished", name, status.Phase)
+	}
+	if err := snowflake.Exec(db, builder.Primary()); err != nil {
+		return fmt.Errorf("error promoting failover group %v to primary err = %w", name, err)
+	}
+	return nil
 }
 
 // FailoverGroup returns a pointer to the resource representing a failover group.
@@ -145,20 +273,26 @@ func FailoverGroup() *schema.Resource {
 
 		Schema: failoverGroupSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithAccountAlias,
 		},
 	}
 }
 
 // CreateFailoverGroup implements schema.CreateFunc.
 func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+	return createGroup(d, meta, snowflake.NewFailoverGroupBuilder)
+}
+
+// createGroup creates a failover or replication group, depending on the given builder.
+func createGroup(d *schema.ResourceData, meta interface{}, newBuilder func(string) *snowflake.FailoverGroupBuilder) error {
 	db := meta.(*sql.DB)
 
 	// getting required attributes
 	name := d.Get("name").(string)
-	builder := snowflake.NewFailoverGroupBuilder(name)
+	builder := newBuilder(name)
+	kind := strings.ToLower(builder.GroupType())
 
-	// if from_replica is set, then we are creating a failover group from an existing replica
+	// if from_replica is set, then we are creating a group from an existing replica
 	if v, ok := d.GetOk("from_replica"); ok {
 		fromReplica := v.([]interface{})[0].(map[string]interface{})
 		organizationName := fromReplica["organization_name"].(string)
@@ -167,10 +301,15 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		fullyQualifiedFailoverGroupIdentifier := fmt.Sprintf("%s.%s.%s", organizationName, sourceAccountName, sourceFailoverGroupName)
 		stmt := builder.CreateFromReplica(fullyQualifiedFailoverGroupIdentifier)
 		if err := snowflake.Exec(db, stmt); err != nil {
-			return fmt.Errorf("error creating failover group %v err = %w", name, err)
+			return fmt.Errorf("error creating %v %v err = %w", kind, name, err)
 		}
 		d.SetId(name)
-		return ReadFailoverGroup(d, meta)
+		if _, ok := d.GetOk("refresh_triggers"); ok {
+			if err := snowflake.Exec(db, builder.Refresh()); err != nil {
+				return fmt.Errorf("error refreshing %v %v err = %w", kind, name, err)
+			}
+		}
+		return readGroup(d, meta, newBuilder)
 	}
 
 	// these two are required attributes if from_replica is not set
@@ -256,7 +395,7 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error creating failover group %v err = %w", name, err)
+		return fmt.Errorf("error creating %v %v err = %w", kind, name, err)
 	}
 
 	d.SetId(name)
@@ -265,8 +404,15 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 // ReadFailoverGroup implements schema.ReadFunc.
 func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+	return readGroup(d, meta, snowflake.NewFailoverGroupBuilder)
+}
+
+// readGroup reads a failover or replication group, depending on the given builder.
+func readGroup(d *schema.ResourceData, meta interface{}, newBuilder func(string) *snowflake.FailoverGroupBuilder) error {
 	db := meta.(*sql.DB)
 	name := d.Id()
+	builder := newBuilder(name)
+	kind := strings.ToLower(builder.GroupType())
 
 	stmt := "select current_account()"
 	row := db.QueryRow(stmt)
@@ -275,13 +421,13 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting current account err = %w", err)
 	}
 
-	failoverGroups, err := snowflake.ListFailoverGroups(db, accountLocator)
+	failoverGroups, err := builder.List(db, accountLocator)
 	if err != nil {
-		return fmt.Errorf("error listing failover groups err = %w", err)
+		return fmt.Errorf("error listing %vs err = %w", kind, err)
 	}
 
 	var failoverGroup snowflake.FailoverGroup
-	// find the failover group we are looking for by matching the name
+	// find the group we are looking for by matching the name
 	for _, fg := range failoverGroups {
 		if strings.EqualFold(fg.Name.String, name) && strings.EqualFold(fg.AccountLocator.String, accountLocator) {
 			failoverGroup = fg
@@ -289,7 +435,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if failoverGroup.Name.String == "" {
-		log.Printf("[DEBUG] failover group (%v) not found when listing all failover groups in account", name)
+		log.Printf("[DEBUG] %v (%v) not found when listing all %vs in account", kind, name, kind)
 		d.SetId("")
 		return nil
 	}
@@ -297,9 +443,19 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("name", failoverGroup.Name.String); err != nil {
 		return err
 	}
-	// if the failover group is created from a replica, then we do not want to get the other values
+	isPrimary := helpers.StringToBool(failoverGroup.IsPrimary.String)
+	if err := d.Set("is_primary", isPrimary); err != nil {
+		return err
+	}
+	if err := d.Set("secondary_state", failoverGroup.SecondaryState.String); err != nil {
+		return err
+	}
+	if err := setGroupRefreshStatus(d, db, builder, isPrimary); err != nil {
+		return err
+	}
+	// if the group is created from a replica, then we do not want to get the other values
 	if _, ok := d.GetOk("from_replica"); ok {
-		log.Printf("[DEBUG] failover group %v is created from a replica, rest of values are computed\n", name)
+		log.Printf("[DEBUG] %v %v is created from a replica, rest of values are computed\n", kind, name)
 		return nil
 	}
 
@@ -397,9 +553,9 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	allowedDatabases, err := snowflake.ShowDatabasesInFailoverGroup(name, db)
+	allowedDatabases, err := builder.ListDatabases(db)
 	if err != nil {
-		return fmt.Errorf("error listing databases in failover group %v err = %w", name, err)
+		return fmt.Errorf("error listing databases in %v %v err = %w", kind, name, err)
 	}
 	if len(allowedDatabases) > 0 {
 		allowedDatabasesInterface := make([]interface{}, len(allowedDatabases))
@@ -416,9 +572,9 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	shares, err := snowflake.ShowSharesInFailoverGroup(name, db)
+	shares, err := builder.ListShares(db)
 	if err != nil {
-		return fmt.Errorf("error listing shares in failover group %v err = %w", name, err)
+		return fmt.Errorf("error listing shares in %v %v err = %w", kind, name, err)
 	}
 	if len(shares) > 0 {
 		sharesInterface := make([]interface{}, len(shares))
@@ -438,9 +594,15 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateFailoverGroup implements schema.UpdateFunc.
 func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+	return updateGroup(d, meta, snowflake.NewFailoverGroupBuilder)
+}
+
+// updateGroup updates a failover or replication group, depending on the given builder.
+func updateGroup(d *schema.ResourceData, meta interface{}, newBuilder func(string) *snowflake.FailoverGroupBuilder) error {
 	db := meta.(*sql.DB)
 	name := d.Id()
-	builder := snowflake.NewFailoverGroupBuilder(name)
+	builder := newBuilder(name)
+	kind := strings.ToLower(builder.GroupType())
 
 	if d.HasChange("object_types") {
 		_, n := d.GetChange("object_types")
@@ -452,7 +614,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		}
 		stmt := builder.ChangeObjectTypes(objectTypes)
 		if err := snowflake.Exec(db, stmt); err != nil {
-			return fmt.Errorf("error updating object types for failover group %v err = %w", name, err)
+			return fmt.Errorf("error updating object types for %v %v err = %w", kind, name, err)
 		}
 	}
 
@@ -478,7 +640,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if len(removedDatabases) > 0 {
 			stmt := builder.RemoveAllowedDatabases(removedDatabases)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error removing allowed databases for failover group %v err = %w", name, err)
+				return fmt.Errorf("error removing allowed databases for %v %v err = %w", kind, name, err)
 			}
 		}
 
@@ -492,7 +654,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if len(addedDatabases) > 0 {
 			stmt := builder.AddAllowedDatabases(addedDatabases)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error adding allowed databases for failover group %v err = %w", name, err)
+				return fmt.Errorf("error adding allowed databases for %v %v err = %w", kind, name, err)
 			}
 		}
 	}
@@ -519,7 +681,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if len(removedShares) > 0 {
 			stmt := builder.RemoveAllowedShares(removedShares)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error removing allowed shares for failover group %v err = %w", name, err)
+				return fmt.Errorf("error removing allowed shares for %v %v err = %w", kind, name, err)
 			}
 		}
 
@@ -533,7 +695,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if len(addedShares) > 0 {
 			stmt := builder.AddAllowedShares(addedShares)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error adding allowed shares for failover group %v err = %w", name, err)
+				return fmt.Errorf("error adding allowed shares for %v %v err = %w", kind, name, err)
 			}
 		}
 	}
@@ -546,7 +708,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		}
 		stmt := builder.ChangeAllowedIntegrationTypes(allowedIntegrationTypes)
 		if err := snowflake.Exec(db, stmt); err != nil {
-			return fmt.Errorf("error updating allowed integration types for failover group %v err = %w", name, err)
+			return fmt.Errorf("error updating allowed integration types for %v %v err = %w", kind, name, err)
 		}
 	}
 
@@ -572,7 +734,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 		if len(removedAccounts) > 0 {
 			stmt := builder.RemoveAllowedAccounts(removedAccounts)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error removing allowed accounts for failover group %v err = %w", name, err)
+				return fmt.Errorf("error removing allowed accounts for %v %v err = %w", kind, name, err)
 			}
 		}
 
@@ -587,7 +749,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 			stmt := builder.AddAllowedAccounts(addedAccounts)
 			if err := snowflake.Exec(db, stmt); err != nil {
 				if !strings.Contains(err.Error(), "Replication already enabled for account") {
-					return fmt.Errorf("error adding allowed accounts for failover group %v err = %w", name, err)
+					return fmt.Errorf("error adding allowed accounts for %v %v err = %w", kind, name, err)
 				}
 			}
 		}
@@ -610,7 +772,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 				}
 				stmt := builder.ChangeReplicationCronSchedule(cronExpression, timeZone)
 				if err := snowflake.Exec(db, stmt); err != nil {
-					return fmt.Errorf("error updating replication cron schedule for failover group %v err = %w", name, err)
+					return fmt.Errorf("error updating replication cron schedule for %v %v err = %w", kind, name, err)
 				}
 			}
 		} else {
@@ -618,22 +780,42 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 			interval := replicationSchedule["interval"].(int)
 			stmt := builder.ChangeReplicationIntervalSchedule(interval)
 			if err := snowflake.Exec(db, stmt); err != nil {
-				return fmt.Errorf("error updating replication interval schedule for failover group %v err = %w", name, err)
+				return fmt.Errorf("error updating replication interval schedule for %v %v err = %w", kind, name, err)
+			}
+		}
+	}
+
+	if d.HasChange("refresh_triggers") {
+		if _, ok := d.GetOk("refresh_triggers"); ok {
+			if err := snowflake.Exec(db, builder.Refresh()); err != nil {
+				return fmt.Errorf("error refreshing %v %v err = %w", kind, name, err)
 			}
 		}
 	}
 
-	return ReadFailoverGroup(d, meta)
+	if builder.SupportsFailover() && d.HasChange("promote_to_primary") && d.Get("promote_to_primary").(bool) {
+		if err := promoteGroup(db, builder, d.Get("is_primary").(bool), name); err != nil {
+			return err
+		}
+	}
+
+	return readGroup(d, meta, newBuilder)
 }
 
 // DeleteFailoverGroup implements schema.DeleteFunc.
 func DeleteFailoverGroup(d *schema.ResourceData, meta interface{}) error {
+	return deleteGroup(d, meta, snowflake.NewFailoverGroupBuilder)
+}
+
+// deleteGroup deletes a failover or replication group, depending on the given builder.
+func deleteGroup(d *schema.ResourceData, meta interface{}, newBuilder func(string) *snowflake.FailoverGroupBuilder) error {
 	db := meta.(*sql.DB)
 	name := d.Id()
-	builder := snowflake.NewFailoverGroupBuilder(name)
+	builder := newBuilder(name)
+	kind := strings.ToLower(builder.GroupType())
 	stmt := builder.Drop()
 	if err := snowflake.Exec(db, stmt); err != nil {
-		return fmt.Errorf("error deleting file format %v err = %w", d.Id(), err)
+		return fmt.Errorf("error deleting %v %v err = %w", kind, d.Id(), err)
 	}
 
 	d.SetId("")
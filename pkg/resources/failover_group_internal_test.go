@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGroupRefreshStatus(t *testing.T) {
+	r := require.New(t)
+
+	status := newGroupRefreshStatus(nil)
+	r.Equal(groupRefreshStatus{}, status)
+	r.True(status.Done())
+
+	phases := []snowflake.RefreshPhase{
+		{PhaseName: "SECONDARY_SYNCHRONIZING_MEMBERSHIP", StartTime: sql.NullString{String: "2023-10-01 10:00:00", Valid: true}, EndTime: sql.NullString{String: "2023-10-01 10:00:05", Valid: true}, AgeSeconds: sql.NullInt64{Int64: 120, Valid: true}},
+		{PhaseName: "PRIMARY_UPLOADING_DATA", StartTime: sql.NullString{String: "2023-10-01 10:00:05", Valid: true}, AgeSeconds: sql.NullInt64{Int64: 115, Valid: true}},
+	}
+	status = newGroupRefreshStatus(phases)
+	r.False(status.Done())
+	r.Equal("PRIMARY_UPLOADING_DATA", status.Phase)
+	r.Equal("2023-10-01 10:00:00", status.StartTime)
+	r.Equal("", status.EndTime)
+	r.Equal(0, status.LagSeconds)
+
+	phases = append(phases, snowflake.RefreshPhase{PhaseName: "COMPLETED", StartTime: sql.NullString{String: "2023-10-01 10:01:00", Valid: true}, EndTime: sql.NullString{String: "2023-10-01 10:01:00", Valid: true}, AgeSeconds: sql.NullInt64{Int64: 60, Valid: true}})
+	status = newGroupRefreshStatus(phases)
+	r.True(status.Done())
+	r.Equal("COMPLETED", status.Phase)
+	r.Equal("2023-10-01 10:01:00", status.EndTime)
+	r.Equal(120, status.LagSeconds)
+}
+
+func TestReplicationGroupSchema(t *testing.T) {
+	r := require.New(t)
+
+	r.NotContains(replicationGroupSchema, "promote_to_primary")
+	r.Contains(failoverGroupSchema, "promote_to_primary")
+	r.Contains(replicationGroupSchema["name"].Description, "replication group")
+	r.Contains(failoverGroupSchema["name"].Description, "failover group")
+	fromReplica := replicationGroupSchema["from_replica"].Elem.(*schema.Resource)
+	r.Contains(fromReplica.Schema["name"].Description, "replication group")
+}
@@ -2,13 +2,16 @@ package resources
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -144,6 +147,64 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of external access integrations needed in order for this function's handler code to access external networks.",
+	},
+	"secrets": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Assigns the names of secrets to variables so that the handler code can retrieve them. Keys are the variable names and values are the fully qualified secret names (e.g. the `qualified_name` of a `snowflake_secret`). Requires `external_access_integrations` that allow the secrets.",
+		DiffSuppressFunc: suppressSecretNameDiff,
+		RequiredWith:     []string{"external_access_integrations"},
+	},
+}
+
+// suppressSecretNameDiff ignores differences in quoting and delimiters of the fully qualified secret names.
+func suppressSecretNameDiff(k, old, new string, _ *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") || old == "" || new == "" {
+		return false
+	}
+	return snowflakeValidation.ParseAndFormatFullyQualifiedObectID(old) == snowflakeValidation.ParseAndFormatFullyQualifiedObectID(new)
+}
+
+// expandExternalAccessIntegrations returns the names of the configured external access integrations.
+func expandExternalAccessIntegrations(v interface{}) []string {
+	return expandStringList(v.(*schema.Set).List())
+}
+
+// expandSecrets returns the secrets map with the secret names formatted as quoted fully qualified names.
+func expandSecrets(v interface{}) map[string]string {
+	secrets := map[string]string{}
+	for variable, secret := range v.(map[string]interface{}) {
+		secrets[variable] = snowflakeValidation.ParseAndFormatFullyQualifiedObectID(secret.(string))
+	}
+	return secrets
+}
+
+// parseSecrets parses the secrets property returned by DESCRIBE FUNCTION / PROCEDURE, e.g. {"cred":"\"DB\".\"SCHEMA\".\"SECRET\""}.
+func parseSecrets(s string) (map[string]string, error) {
+	secrets := map[string]string{}
+	if s == "" || s == "null" {
+		return secrets, nil
+	}
+	raw := map[string]string{}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse secrets %v err = %w", s, err)
+	}
+	for variable, secret := range raw {
+		secrets[variable] = snowflakeValidation.ParseAndFormatFullyQualifiedObectID(secret)
+	}
+	return secrets, nil
 }
 
 // Function returns a pointer to the resource representing a stored function.
@@ -242,6 +303,14 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTargetPath(v.(string))
 	}
 
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandExternalAccessIntegrations(v))
+	}
+
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandSecrets(v))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -369,6 +438,18 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("target_path", desc.Value.String); err != nil {
 				return err
 			}
+		case "external_access_integrations":
+			if err := d.Set("external_access_integrations", helpers.StringListToList(helpers.ListContentToString(desc.Value.String))); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseSecrets(desc.Value.String)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
 		case "runtime_version":
 			if err := d.Set("runtime_version", desc.Value.String); err != nil {
 				return err
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecrets(t *testing.T) {
+	r := require.New(t)
+
+	secrets, err := parseSecrets(`{"cred":"\"DB\".\"SCHEMA\".\"SECRET\"","token":"DB.SCHEMA.TOKEN"}`)
+	r.NoError(err)
+	r.Equal(map[string]string{
+		"cred":  `"DB"."SCHEMA"."SECRET"`,
+		"token": `"DB"."SCHEMA"."TOKEN"`,
+	}, secrets)
+
+	secrets, err = parseSecrets("")
+	r.NoError(err)
+	r.Empty(secrets)
+
+	_, err = parseSecrets("[not a map]")
+	r.Error(err)
+}
+
+func TestSuppressSecretNameDiff(t *testing.T) {
+	r := require.New(t)
+	r.True(suppressSecretNameDiff("secrets.cred", `"DB"."SCHEMA"."SECRET"`, "DB|SCHEMA|SECRET", nil))
+	r.True(suppressSecretNameDiff("secrets.cred", `"DB"."SCHEMA"."SECRET"`, "DB.SCHEMA.SECRET", nil))
+	r.False(suppressSecretNameDiff("secrets.cred", `"DB"."SCHEMA"."SECRET"`, "DB.SCHEMA.OTHER", nil))
+	r.False(suppressSecretNameDiff("secrets.%", "1", "1", nil))
+}
@@ -7,9 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jmoiron/sqlx"
 	"github.com/snowflakedb/gosnowflake"
 )
 
@@ -77,18 +77,72 @@ func createGenericGrantRolesAndShares(
 	shares []string,
 ) error {
 	db := meta.(*sql.DB)
+	existingRoles, existingShares := readExistingGrantees(db, builder, priv)
+	// The grants are applied as one batch, so that granting to many roles takes a single request, and are revoked
+	// again if any of them fails. Grants that existed before the batch are left in place.
+	batch := make([]snowflake.BatchStatement, 0, len(roles)+len(shares))
 	for _, role := range roles {
-		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
-			return err
+		executable := builder.Role(role)
+		statement := snowflake.BatchStatement{Statement: executable.Grant(priv, grantOption)}
+		if existingRoles != nil && !existingRoles[role] {
+			statement.Undo = executable.Revoke(priv)
 		}
+		batch = append(batch, statement)
 	}
 
 	for _, share := range shares {
-		if err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption)); err != nil {
-			return err
+		executable := builder.Share(share)
+		statement := snowflake.BatchStatement{Statement: executable.Grant(priv, grantOption)}
+		if existingShares != nil && !existingShares[share] {
+			statement.Undo = executable.Revoke(priv)
 		}
+		batch = append(batch, statement)
 	}
-	return nil
+	return snowflake.ExecBatch(db, batch)
+}
+
+// readExistingGrantees returns the roles and shares that already hold priv on the object of builder, from a single
+// read of the grants on the object. Both are nil when the existing grants cannot be told apart, so that no grant is
+// revoked when rolling back a failed batch.
+func readExistingGrantees(db *sql.DB, builder snowflake.GrantBuilder, priv string) (map[string]bool, map[string]bool) {
+	if priv == "ALL PRIVILEGES" {
+		// the individual privileges granted before cannot be attributed to ALL PRIVILEGES
+		return nil, nil
+	}
+	// a grant missed by the cached rows would be revoked on rollback without having been made by this batch, so the
+	// grants are read from Snowflake, and the cached rows are replaced with them
+	sdk.InvalidateReadCache(db, builder.Show())
+	var grants []*grant
+	var err error
+	switch builder.(type) {
+	case *snowflake.AllGrantBuilder:
+		// grants on all objects are shown per object, like the grants made before
+		return nil, nil
+	case *snowflake.FutureGrantBuilder:
+		grants, err = readGenericFutureGrants(db, builder)
+	default:
+		grants, err = readGenericCurrentGrants(db, builder)
+	}
+	if err != nil {
+		log.Printf("[WARN] could not read existing grants on %v, failed grants will not be revoked: %v", builder.Name(), err)
+		return nil, nil
+	}
+
+	roles, shares := map[string]bool{}, map[string]bool{}
+	grantType := strings.ReplaceAll(builder.GrantType(), " ", "_")
+	for _, grant := range grants {
+		if grant.Privilege != priv || grant.GrantType != grantType {
+			continue
+		}
+		switch grant.GranteeType {
+		case "ROLE":
+			roles[grant.GranteeName] = true
+		case "SHARE":
+			// strip the account name from the grantee name
+			shares[grant.GranteeName[strings.LastIndex(grant.GranteeName, ".")+1:]] = true
+		}
+	}
+	return roles, shares
 }
 
 func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
@@ -249,19 +303,14 @@ func readGenericGrant(
 }
 
 func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
-	stmt := builder.Show()
-	rows, err := snowflake.Query(db, stmt)
-	if err != nil {
+	// The grants of an object are shown together, so grant resources for each privilege share the cached rows.
+	var currentGrants []currentGrant
+	if err := snowflake.QueryAll(db, &currentGrants, builder.Show()); err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
 	var grants []*grant
-	for rows.Next() {
-		currentGrant := &currentGrant{}
-		if err := rows.StructScan(currentGrant); err != nil {
-			return nil, err
-		}
+	for _, currentGrant := range currentGrants {
 		if currentGrant.GrantedBy == "" {
 			// If GrantedBy is empty string, terraform can't
 			// manage the grant because the grant is a default
@@ -285,21 +334,13 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 }
 
 func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
-	conn := sqlx.NewDb(db, "snowflake")
-
-	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
-	if err != nil {
+	var futureGrants []futureGrant
+	if err := snowflake.QueryAll(db, &futureGrants, builder.Show()); err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
 	var grants []*grant
-	for rows.Next() {
-		futureGrant := &futureGrant{}
-		if err := rows.StructScan(futureGrant); err != nil {
-			return nil, err
-		}
+	for _, futureGrant := range futureGrants {
 		grant := &grant{
 			CreatedOn:   futureGrant.CreatedOn,
 			Privilege:   futureGrant.Privilege,
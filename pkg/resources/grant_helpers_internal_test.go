@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+)
+
+func TestCreateGenericGrantRolesAndSharesReadsExistingGrantees(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	r.NoError(err)
+	defer db.Close()
+	sdk.EnableReadCache(db)
+
+	columns := []string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}
+	createdOn := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	show := snowflake.AccountGrant().Show()
+
+	// the grants on the account are cached before another resource grants the privilege to test-role-1
+	mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows(columns))
+	var cached []currentGrant
+	r.NoError(snowflake.QueryAll(db, &cached, show))
+
+	mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow(createdOn, "CREATE DATABASE", "ACCOUNT", "", "ROLE", "test-role-1", false, "bob"))
+	mock.ExpectExec(`GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-1"`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-2"`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-3"`).WillReturnError(errors.New("grant failed"))
+	// test-role-1 held the privilege before the batch, so only the grant to test-role-2 is undone
+	mock.ExpectExec(`REVOKE CREATE DATABASE ON ACCOUNT FROM ROLE "test-role-2"`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = createGenericGrantRolesAndShares(db, snowflake.AccountGrant(), "CREATE DATABASE", false, []string{"test-role-1", "test-role-2", "test-role-3"}, nil)
+	r.ErrorContains(err, "grant failed")
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -1,8 +1,12 @@
 package resources_test
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 
@@ -196,6 +200,29 @@ func providers() map[string]*schema.Provider {
 	}
 }
 
+// sdkV2ProviderFactories serves the SDKv2 provider alone, without the resources migrated to the plugin framework.
+func sdkV2ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"snowflake": func() (*schema.Provider, error) {
+			return provider.Provider(), nil
+		},
+	}
+}
+
+// protoV5ProviderFactories serves the provider the way main does, muxing the SDKv2 provider with the plugin framework
+// provider. Configurations using resources built on the plugin framework need it.
+func protoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"snowflake": func() (tfprotov5.ProviderServer, error) {
+			providerServer, err := provider.ProviderServer(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
+	}
+}
+
 func roleGrants(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -447,3 +474,8 @@ func tagGrant(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	d.SetId(id)
 	return d
 }
+
+// expectNoExistingGrants expects the grants on an object to be read before granting, none of them existing yet.
+func expectNoExistingGrants(mock sqlmock.Sqlmock, show string) {
+	mock.ExpectQuery(regexp.QuoteMeta(show)).WillReturnRows(sqlmock.NewRows([]string{"privilege"}))
+}
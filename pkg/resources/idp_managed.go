@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ignoreIdpManagedAttributesSchema returns the schema of the switch that leaves the attributes owned by the identity
+// provider to the IdP when the object is provisioned by SCIM.
+func ignoreIdpManagedAttributesSchema(attributes string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When the object is owned by a SCIM provisioner role (GENERIC_SCIM_PROVISIONER, AAD_PROVISIONER or OKTA_PROVISIONER), ignore the differences of the attributes managed by the identity provider: " + attributes + ".",
+	}
+}
+
+var idpManagedSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Computed:    true,
+	Description: "Whether the object is owned by a SCIM provisioner role, i.e. provisioned by an identity provider. Only detected when `ignore_idp_managed_attributes` is set.",
+}
+
+// suppressIdpManaged suppresses the differences of an attribute managed by the identity provider when the object is
+// provisioned by SCIM and ignore_idp_managed_attributes is set, falling back to the given function otherwise.
+func suppressIdpManaged(f schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Get("ignore_idp_managed_attributes").(bool) && d.Get("idp_managed").(bool) {
+			return true
+		}
+		return f != nil && f(k, old, new, d)
+	}
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuppressIdpManaged(t *testing.T) {
+	state := func(idpManaged string) *terraform.InstanceState {
+		return &terraform.InstanceState{ID: "user", Attributes: map[string]string{
+			"name":                          "user",
+			"email":                         "idp@example.com",
+			"comment":                       "from terraform",
+			"ignore_idp_managed_attributes": "true",
+			"idp_managed":                   idpManaged,
+		}}
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                          "user",
+		"email":                         "terraform@example.com",
+		"comment":                       "changed",
+		"ignore_idp_managed_attributes": true,
+	})
+
+	t.Run("ignores the attributes of the identity provider", func(t *testing.T) {
+		diff, err := User().Diff(context.Background(), state("true"), config, nil)
+		require.NoError(t, err)
+		require.NotContains(t, diff.Attributes, "email")
+		require.Contains(t, diff.Attributes, "comment")
+	})
+
+	t.Run("applies the attributes of users not provisioned by SCIM", func(t *testing.T) {
+		diff, err := User().Diff(context.Background(), state("false"), config, nil)
+		require.NoError(t, err)
+		require.Contains(t, diff.Attributes, "email")
+	})
+}
+
+func TestReadRoleIdpManaged(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	d := Role().Data(&terraform.InstanceState{ID: "role", Attributes: map[string]string{
+		"name":                          "role",
+		"ignore_idp_managed_attributes": "true",
+	}})
+	rows := sqlmock.NewRows([]string{"name", "comment", "owner"}).AddRow("role", "", "AAD_PROVISIONER")
+	mock.ExpectQuery(`^SHOW ROLES LIKE 'role'$`).WillReturnRows(rows)
+	r.NoError(ReadRole(d, db))
+	r.True(d.Get("idp_managed").(bool))
+}
@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var imageRepositorySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the image repository.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the image repository.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the image repository; must be unique for the schema in which the image repository is created.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the image repository.",
+	},
+	"repository_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the image repository, used to push and pull images with docker.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the image repository.",
+	},
+}
+
+func ImageRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: "An image repository is an OCIv2-compliant storage unit for the container images used by Snowpark Container Services.",
+		Create:      CreateImageRepository,
+		Read:        ReadImageRepository,
+		Update:      UpdateImageRepository,
+		Delete:      DeleteImageRepository,
+
+		Schema: imageRepositorySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateImageRepository implements schema.CreateFunc.
+func CreateImageRepository(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.ImageRepositoryCreateOptions{}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.ImageRepositories.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating image repository %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadImageRepository(d, meta)
+}
+
+// ReadImageRepository implements schema.ReadFunc.
+func ReadImageRepository(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	imageRepository, err := client.ImageRepositories.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", imageRepository.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", imageRepository.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", imageRepository.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", imageRepository.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("repository_url", imageRepository.RepositoryURL); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateImageRepository implements schema.UpdateFunc.
+func UpdateImageRepository(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("comment") {
+		alterOptions := &sdk.ImageRepositoryAlterOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			alterOptions.Set = &sdk.ImageRepositorySet{
+				Comment: sdk.String(v.(string)),
+			}
+		} else {
+			alterOptions.Unset = &sdk.ImageRepositoryUnset{
+				Comment: sdk.Bool(true),
+			}
+		}
+		if err := client.ImageRepositories.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating image repository %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadImageRepository(d, meta)
+}
+
+// DeleteImageRepository implements schema.DeleteFunc.
+func DeleteImageRepository(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.ImageRepositories.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ImageRepository(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: imageRepositoryConfig(accName, "Terraform acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttrSet("snowflake_image_repository.test", "repository_url"),
+				),
+			},
+			{
+				Config: imageRepositoryConfig(accName, "Terraform acceptance test - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_image_repository.test", "comment", "Terraform acceptance test - updated"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_image_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func imageRepositoryConfig(name string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_image_repository" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	schema   = snowflake_schema.test.name
+	comment  = "%[2]v"
+}
+`, name, comment)
+}
@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the network rule.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the network rule.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the schema in which the network rule is created.",
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the type of network identifiers being allowed or blocked. Valid values are IPV4, AWSVPCEID, AZURELINKID and HOST_PORT.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.NetworkRuleTypeIPv4), string(sdk.NetworkRuleTypeAWSVPCEID), string(sdk.NetworkRuleTypeAzureLinkID), string(sdk.NetworkRuleTypeHostPort)}, false),
+	},
+	"mode": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies what is restricted by the network rule. Valid values are INGRESS, INTERNAL_STAGE and EGRESS.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.NetworkRuleModeIngress), string(sdk.NetworkRuleModeInternalStage), string(sdk.NetworkRuleModeEgress)}, false),
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies the network identifiers that will be allowed or blocked. The valid values depend on the type of the rule.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the network rule.",
+	},
+}
+
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "A network rule groups network identifiers into a logical unit that can be used by external access integrations and network policies.",
+		Create:      CreateNetworkRule,
+		Read:        ReadNetworkRule,
+		Update:      UpdateNetworkRule,
+		Delete:      DeleteNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandNetworkRuleValues(v interface{}) []sdk.NetworkRuleValue {
+	values := make([]sdk.NetworkRuleValue, 0)
+	for _, value := range expandStringList(v.(*schema.Set).List()) {
+		values = append(values, sdk.NetworkRuleValue{Value: value})
+	}
+	return values
+}
+
+// CreateNetworkRule implements schema.CreateFunc.
+func CreateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.NetworkRuleCreateOptions{
+		Type:      sdk.NetworkRuleType(d.Get("type").(string)),
+		Mode:      sdk.NetworkRuleMode(d.Get("mode").(string)),
+		ValueList: expandNetworkRuleValues(d.Get("value_list")),
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.NetworkRules.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating network rule %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadNetworkRule(d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadFunc.
+func ReadNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	networkRule, err := client.NetworkRules.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", networkRule.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", networkRule.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", networkRule.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", string(networkRule.Type)); err != nil {
+		return err
+	}
+	if err := d.Set("mode", string(networkRule.Mode)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", networkRule.Comment); err != nil {
+		return err
+	}
+
+	networkRuleDetails, err := client.NetworkRules.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("value_list", networkRuleDetails.ValueList); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateFunc.
+func UpdateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("value_list") {
+		alterOptions := &sdk.NetworkRuleAlterOptions{}
+		values := expandNetworkRuleValues(d.Get("value_list"))
+		if len(values) > 0 {
+			alterOptions.Set = &sdk.NetworkRuleSet{
+				ValueList: values,
+			}
+		} else {
+			alterOptions.Unset = &sdk.NetworkRuleUnset{
+				ValueList: sdk.Bool(true),
+			}
+		}
+		if err := client.NetworkRules.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating network rule %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		alterOptions := &sdk.NetworkRuleAlterOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			alterOptions.Set = &sdk.NetworkRuleSet{
+				Comment: sdk.String(v.(string)),
+			}
+		} else {
+			alterOptions.Unset = &sdk.NetworkRuleUnset{
+				Comment: sdk.Bool(true),
+			}
+		}
+		if err := client.NetworkRules.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating network rule %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadNetworkRule(d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteFunc.
+func DeleteNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.NetworkRules.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
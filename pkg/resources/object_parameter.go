@@ -67,6 +67,13 @@ var objectParameterSchema = map[string]*schema.Schema{
 					ForceNew:    true,
 					Description: "Name of the schema that the object was created in.",
 				},
+				"arguments": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					ForceNew:    true,
+					Description: "List of the argument data types of the function or procedure (e.g. `[\"VARCHAR\", \"NUMBER\"]`). Only used when `object_type` is FUNCTION or PROCEDURE, since those objects are identified by their signature.",
+				},
 			},
 		},
 	},
@@ -86,6 +93,26 @@ func ObjectParameter() *schema.Resource {
 	}
 }
 
+// expandObjectParameterIdentifier returns the fully qualified identifier of the object the parameter is set on.
+// Functions and procedures are overloadable, so their identifier also includes the argument data types.
+func expandObjectParameterIdentifier(d *schema.ResourceData) string {
+	v, ok := d.GetOk("object_identifier")
+	if !ok {
+		return ""
+	}
+	objectDatabase, objectSchema, objectName := expandObjectIdentifier(v.([]interface{}))
+	identifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	objectType := snowflake.ObjectType(d.Get("object_type").(string))
+	if objectType == snowflake.ObjectTypeFunction || objectType == snowflake.ObjectTypeProcedure {
+		var arguments []string
+		if a, ok := v.([]interface{})[0].(map[string]interface{})["arguments"]; ok {
+			arguments = expandStringList(a.([]interface{}))
+		}
+		identifier = fmt.Sprintf("%v(%v)", identifier, strings.Join(arguments, ", "))
+	}
+	return identifier
+}
+
 // CreateObjectParameter implements schema.CreateFunc.
 func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -112,10 +139,8 @@ func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		builder.SetOnAccount(onAccount)
 	}
 
-	var fullyQualifierObjectIdentifier string
-	if v, ok := d.GetOk("object_identifier"); ok {
-		objectDatabase, objectSchema, objectName := expandObjectIdentifier(v.([]interface{}))
-		fullyQualifierObjectIdentifier = snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	fullyQualifierObjectIdentifier := expandObjectParameterIdentifier(d)
+	if fullyQualifierObjectIdentifier != "" {
 		builder.WithObjectIdentifier(fullyQualifierObjectIdentifier)
 	}
 
@@ -205,10 +230,8 @@ func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		builder.SetOnAccount(onAccount)
 	}
 
-	var fullyQualifierObjectIdentifier string
-	if v, ok := d.GetOk("object_identifier"); ok {
-		objectDatabase, objectSchema, objectName := expandObjectIdentifier(v.([]interface{}))
-		fullyQualifierObjectIdentifier = snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	fullyQualifierObjectIdentifier := expandObjectParameterIdentifier(d)
+	if fullyQualifierObjectIdentifier != "" {
 		builder.WithObjectIdentifier(fullyQualifierObjectIdentifier)
 	}
 
@@ -221,7 +244,13 @@ func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		builder.WithObjectType(objectType)
 	}
 
-	err := builder.SetParameter()
+	var err error
+	// parameters without a default value (e.g. EVENT_TABLE) are unset instead
+	if defaultValue == nil {
+		err = builder.UnsetParameter()
+	} else {
+		err = builder.SetParameter()
+	}
 	if err != nil {
 		return fmt.Errorf("error deleting object parameter err = %w", err)
 	}
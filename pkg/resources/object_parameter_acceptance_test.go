@@ -44,6 +44,30 @@ func TestAcc_ObjectParameterAccount(t *testing.T) {
 	})
 }
 
+func TestAcc_ObjectParameterLogLevel(t *testing.T) {
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: objectParameterConfigBasic(prefix, "LOG_LEVEL", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_object_parameter.p", "key", "LOG_LEVEL"),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.p", "value", "INFO"),
+				),
+			},
+			{
+				Config: objectParameterConfigBasic(prefix, "TRACE_LEVEL", "ON_EVENT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_object_parameter.p", "key", "TRACE_LEVEL"),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.p", "value", "ON_EVENT"),
+				),
+			},
+		},
+	})
+}
+
 func objectParameterConfigOnAccount(key, value string) string {
 	s := `
 resource "snowflake_object_parameter" "p" {
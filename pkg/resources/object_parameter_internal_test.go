@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandObjectParameterIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":         "LOG_LEVEL",
+		"value":       "INFO",
+		"object_type": "DATABASE",
+		"object_identifier": []interface{}{map[string]interface{}{
+			"name": "test_db",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, objectParameterSchema, in)
+	r.Equal(`"test_db"`, expandObjectParameterIdentifier(d))
+}
+
+func TestExpandObjectParameterIdentifierWithArguments(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":         "LOG_LEVEL",
+		"value":       "INFO",
+		"object_type": "FUNCTION",
+		"object_identifier": []interface{}{map[string]interface{}{
+			"name":      "test_function",
+			"database":  "test_db",
+			"schema":    "test_schema",
+			"arguments": []interface{}{"VARCHAR", "NUMBER"},
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, objectParameterSchema, in)
+	r.Equal(`"test_db"."test_schema"."test_function"(VARCHAR, NUMBER)`, expandObjectParameterIdentifier(d))
+}
+
+func TestExpandObjectParameterIdentifierWithoutArguments(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":         "TRACE_LEVEL",
+		"value":       "ALWAYS",
+		"object_type": "PROCEDURE",
+		"object_identifier": []interface{}{map[string]interface{}{
+			"name":     "test_procedure",
+			"database": "test_db",
+			"schema":   "test_schema",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, objectParameterSchema, in)
+	r.Equal(`"test_db"."test_schema"."test_procedure"()`, expandObjectParameterIdentifier(d))
+}
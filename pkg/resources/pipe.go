@@ -11,6 +11,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -81,6 +82,48 @@ var pipeSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies the name of the notification integration used for error notifications.",
 	},
+	"paused": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the pipe execution is paused (PIPE_EXECUTION_PAUSED). A paused pipe does not load new files but keeps queueing event notifications.",
+	},
+	"refresh_on_create": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Queues the files already staged in the pipe's stage for loading once the pipe is created. Changing this block after the pipe has been created has no effect.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path (or prefix) appended to the stage reference in the pipe definition; only files matching it are queued.",
+				},
+				"modified_after": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Timestamp (in ISO-8601 format) of the oldest data files to queue, based on their LAST_MODIFIED date. Snowflake limits it to the last 7 days.",
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	},
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current execution state of the pipe as reported by SYSTEM$PIPE_STATUS (e.g. RUNNING, PAUSED, STOPPED_STAGE_DROPPED).",
+	},
+	"pending_file_count": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of files queued for loading by the pipe.",
+	},
+	"last_ingested_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp when the most recent file was loaded successfully by the pipe into the destination table.",
+	},
 }
 
 func Pipe() *schema.Resource {
@@ -191,6 +234,24 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating pipe %v err = %w", name, err)
 	}
 
+	if v, ok := d.GetOk("refresh_on_create"); ok {
+		var prefix, modifiedAfter string
+		// an empty block is read as a nil element
+		if refresh, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			prefix = refresh["prefix"].(string)
+			modifiedAfter = refresh["modified_after"].(string)
+		}
+		if err := snowflake.Exec(db, builder.Refresh(prefix, modifiedAfter)); err != nil {
+			return fmt.Errorf("error refreshing pipe %v err = %w", name, err)
+		}
+	}
+
+	if d.Get("paused").(bool) {
+		if err := snowflake.Exec(db, builder.ChangePipeExecutionPaused(true)); err != nil {
+			return fmt.Errorf("error pausing pipe %v err = %w", name, err)
+		}
+	}
+
 	pipeID := &pipeID{
 		DatabaseName: database,
 		SchemaName:   schema,
@@ -262,6 +323,10 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := setPipeStatus(d, db, snowflake.NewPipeBuilder(name, dbName, schema)); err != nil {
+		return err
+	}
+
 	if pipe.NotificationChannel != nil && strings.Contains(*pipe.NotificationChannel, "arn:aws:sns:") {
 		err = d.Set("aws_sns_topic_arn", pipe.NotificationChannel)
 		return err
@@ -276,6 +341,28 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+// setPipeStatus reads the status of the pipe. SYSTEM$PIPE_STATUS needs the MONITOR or OPERATE privilege on the pipe,
+// so failing to read it does not fail the read of the pipe, and the status attributes are left as they are.
+func setPipeStatus(d *schema.ResourceData, db *sql.DB, builder *snowflake.PipeBuilder) error {
+	status, err := snowflake.ScanPipeStatus(snowflake.QueryRow(db, builder.Status()))
+	if err != nil {
+		log.Printf("[WARN] could not read status of pipe %v: %v", d.Id(), err)
+		return nil
+	}
+	values := map[string]interface{}{
+		"paused":                  status.IsPaused(),
+		"execution_state":         status.ExecutionState,
+		"pending_file_count":      status.PendingFileCount,
+		"last_ingested_timestamp": status.LastIngestedTimestamp,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdatePipe implements schema.UpdateFunc.
 func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 	pipeID, err := pipeIDFromString(d.Id())
@@ -310,6 +397,13 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("paused") {
+		q := builder.ChangePipeExecutionPaused(d.Get("paused").(bool))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating pipe paused on %v err = %w", d.Id(), err)
+		}
+	}
+
 	return ReadPipe(d, meta)
 }
 
@@ -21,7 +21,7 @@ func TestAcc_Pipe(t *testing.T) {
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
 			{
-				Config: pipeConfig(accName),
+				Config: pipeConfig(accName, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", accName),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "database", accName),
@@ -29,13 +29,23 @@ func TestAcc_Pipe(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "auto_ingest", "false"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "notification_channel", ""),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "RUNNING"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pending_file_count", "0"),
+				),
+			},
+			{
+				Config: pipeConfig(accName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "paused", "true"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "PAUSED"),
 				),
 			},
 		},
 	})
 }
 
-func pipeConfig(name string) string {
+func pipeConfig(name string, paused bool) string {
 	s := `
 resource "snowflake_database" "test" {
 	name = "%v"
@@ -83,7 +93,8 @@ COPY INTO "${snowflake_table.test.database}"."${snowflake_table.test.schema}"."$
   FILE_FORMAT = (TYPE = CSV)
 CMD
   auto_ingest    = false
+  paused         = %t
 }
 `
-	return fmt.Sprintf(s, name)
+	return fmt.Sprintf(s, name, paused)
 }
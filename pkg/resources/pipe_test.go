@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"database/sql"
+	"errors"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -44,6 +45,44 @@ func TestPipeCreate(t *testing.T) {
 	})
 }
 
+func TestPipeCreatePausedWithRefresh(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "test_pipe",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"comment":  "great comment",
+		"paused":   true,
+		"refresh_on_create": []interface{}{map[string]interface{}{
+			"prefix":         "d1/",
+			"modified_after": "2023-01-01T00:00:00Z",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Pipe().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE PIPE "test_db"."test_schema"."test_pipe" COMMENT = 'great comment'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^ALTER PIPE "test_db"."test_schema"."test_pipe" REFRESH PREFIX = 'd1/' MODIFIED_AFTER = '2023-01-01T00:00:00Z'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^ALTER PIPE "test_db"."test_schema"."test_pipe" SET PIPE_EXECUTION_PAUSED = true$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadPipe(mock)
+		err := resources.CreatePipe(d, db)
+		r.NoError(err)
+
+		r.Equal("RUNNING", d.Get("execution_state"))
+		r.Equal(2, d.Get("pending_file_count"))
+		r.Equal("2023-01-01T00:00:00.000Z", d.Get("last_ingested_timestamp"))
+	})
+}
+
 func TestPipeRead(t *testing.T) {
 	r := require.New(t)
 
@@ -66,10 +105,40 @@ func TestPipeRead(t *testing.T) {
 	})
 }
 
+func TestPipeReadWithoutStatus(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "test_pipe",
+		"database": "test_db",
+		"schema":   "test_schema",
+		"paused":   true,
+	}
+
+	d := pipe(t, "test_db|test_schema|test_pipe", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "definition", "owner", "notification_channel", "comment", "error_integration",
+		},
+		).AddRow("2019-12-23 17:20:50.088 +0000", "test_pipe", "test_db", "test_schema", "test definition", "N", nil, "great comment", "null")
+		mock.ExpectQuery(`^SHOW PIPES LIKE 'test_pipe' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^SELECT SYSTEM\$PIPE_STATUS`).WillReturnError(errors.New("Insufficient privileges to operate on pipe 'TEST_PIPE'"))
+
+		r.NoError(resources.ReadPipe(d, db))
+		r.Equal("test_pipe", d.Get("name"))
+		r.Equal(true, d.Get("paused"))
+		r.Equal("", d.Get("execution_state"))
+	})
+}
+
 func expectReadPipe(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "name", "database_name", "schema_name", "definition", "owner", "notification_channel", "comment", "error_integration",
 	},
 	).AddRow("2019-12-23 17:20:50.088 +0000", "test_pipe", "test_db", "test_schema", "test definition", "N", "test", "great comment", "null")
 	mock.ExpectQuery(`^SHOW PIPES LIKE 'test_pipe' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	statusRows := sqlmock.NewRows([]string{"status"}).AddRow(`{"executionState":"RUNNING","pendingFileCount":2,"lastIngestedTimestamp":"2023-01-01T00:00:00.000Z"}`)
+	mock.ExpectQuery(`^SELECT SYSTEM\$PIPE_STATUS\('"test_db"."test_schema"."test_pipe"'\) AS "status"$`).WillReturnRows(statusRows)
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -162,6 +163,26 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The handler method for Java / Python procedures.",
 	},
+	"external_access_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of external access integrations needed in order for this procedure's handler code to access external networks.",
+	},
+	"secrets": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:         true,
+		ForceNew:         true,
+		Description:      "Assigns the names of secrets to variables so that the handler code can retrieve them. Keys are the variable names and values are the fully qualified secret names (e.g. the `qualified_name` of a `snowflake_secret`). Requires `external_access_integrations` that allow the secrets.",
+		DiffSuppressFunc: suppressSecretNameDiff,
+		RequiredWith:     []string{"external_access_integrations"},
+	},
 }
 
 func DiffTypes(_, o, n string, _ *schema.ResourceData) bool {
@@ -259,6 +280,14 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 		builder.WithHandler(v.(string))
 	}
 
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandExternalAccessIntegrations(v))
+	}
+
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandSecrets(v))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -379,6 +408,18 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("handler", desc.Value.String); err != nil {
 				return err
 			}
+		case "external_access_integrations":
+			if err := d.Set("external_access_integrations", helpers.StringListToList(helpers.ListContentToString(desc.Value.String))); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseSecrets(desc.Value.String)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
 
 		default:
 			log.Printf("[WARN] unexpected procedure property %v returned from Snowflake", desc.Property.String)
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+)
+
+// replicationGroupSchema shares the failover group schema, since both groups are managed with the same statements.
+// Replication groups cannot be promoted to primary, so promote_to_primary is left out.
+var replicationGroupSchema = func() map[string]*schema.Schema {
+	s := copyGroupSchema(failoverGroupSchema)
+	delete(s, "promote_to_primary")
+	s["allowed_accounts"].Description = "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form <org_name>.<target_account_name>"
+	return s
+}()
+
+// copyGroupSchema copies the failover group schema, rewording the descriptions for replication groups.
+func copyGroupSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
+	replacer := strings.NewReplacer("replication and failover", "replication", "failover group", "replication group")
+	copied := make(map[string]*schema.Schema, len(m))
+	for k, v := range m {
+		c := *v
+		c.Description = replacer.Replace(v.Description)
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			c.Elem = &schema.Resource{Schema: copyGroupSchema(elem.Schema)}
+		}
+		copied[k] = &c
+	}
+	return copied
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group.
+func ReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateReplicationGroup,
+		Read:   ReadReplicationGroup,
+		Update: UpdateReplicationGroup,
+		Delete: DeleteReplicationGroup,
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: importWithAccountAlias,
+		},
+	}
+}
+
+// CreateReplicationGroup implements schema.CreateFunc.
+func CreateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	return createGroup(d, meta, snowflake.NewReplicationGroupBuilder)
+}
+
+// ReadReplicationGroup implements schema.ReadFunc.
+func ReadReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	return readGroup(d, meta, snowflake.NewReplicationGroupBuilder)
+}
+
+// UpdateReplicationGroup implements schema.UpdateFunc.
+func UpdateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	return updateGroup(d, meta, snowflake.NewReplicationGroupBuilder)
+}
+
+// DeleteReplicationGroup implements schema.DeleteFunc.
+func DeleteReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	return deleteGroup(d, meta, snowflake.NewReplicationGroupBuilder)
+}
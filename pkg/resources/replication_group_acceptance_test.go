@@ -0,0 +1,71 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ReplicationGroup(t *testing.T) {
+	randomCharacters := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	if _, ok := os.LookupEnv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT"); !ok {
+		t.Skip("Skipping TestAcc_ReplicationGroup since not a business critical account")
+	}
+	accountName := os.Getenv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT")
+	resource.Test(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupWithInterval(randomCharacters, accountName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_databases.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "10"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "is_primary", "true"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "refresh_phase", ""),
+				),
+			},
+			// Update Interval
+			{
+				Config: replicationGroupWithInterval(randomCharacters, accountName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "20"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_replication_group.rg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+			},
+		},
+	})
+}
+
+func replicationGroupWithInterval(randomCharacters, accountName string, interval int) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "db" {
+	name = "tst-terraform-%s"
+}
+
+resource "snowflake_replication_group" "rg" {
+	name = "%s"
+	object_types = ["DATABASES"]
+	allowed_accounts= ["%s"]
+	allowed_databases = [snowflake_database.db.name]
+	replication_schedule {
+		interval = %d
+	}
+}
+`, randomCharacters, randomCharacters, accountName, interval)
+}
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -93,12 +95,14 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies whether the resource monitor should be applied globally to your Snowflake account (defaults to false).",
 		Default:     false,
+		Deprecated:  "Use snowflake_resource_monitor_attachment instead",
 	},
 	"warehouses": {
 		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "A list of warehouses to apply the resource monitor to.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
+		Deprecated:  "Use snowflake_resource_monitor_attachment instead",
 	},
 }
 
@@ -112,11 +116,23 @@ func ResourceMonitor() *schema.Resource {
 
 		Schema: resourceMonitorSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importResourceMonitor,
 		},
 	}
 }
 
+func importResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	rm, err := client.ResourceMonitors.ShowByID(ctx, sdk.NewAccountObjectIdentifier(d.Id()))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("set_for_account", rm.Level == sdk.ResourceMonitorLevelAccount); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func checkAccountAgainstWarehouses(d *schema.ResourceData, name string) error {
 	account := d.Get("set_for_account").(bool)
 	v := d.Get("warehouses")
@@ -127,6 +143,16 @@ func checkAccountAgainstWarehouses(d *schema.ResourceData, name string) error {
 	return nil
 }
 
+// validateNotifyUsers checks that the users in notify_users exist, so that a typo is reported before the monitor is
+// created or altered.
+func validateNotifyUsers(db *sql.DB, users []string) error {
+	notifyUsers := make([]sdk.NotifyUser, len(users))
+	for i, user := range users {
+		notifyUsers[i] = sdk.NotifyUser{Name: user}
+	}
+	return sdk.NewClientFromDB(db).ResourceMonitors.ValidateNotifyUsers(context.Background(), notifyUsers)
+}
+
 // CreateResourceMonitor implements schema.CreateFunc.
 func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -141,7 +167,11 @@ func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 	cb := snowflake.NewResourceMonitorBuilder(name).Create()
 	// Set optionals.
 	if v, ok := d.GetOk("notify_users"); ok {
-		cb.SetStringList("notify_users", expandStringList(v.(*schema.Set).List()))
+		users := expandStringList(v.(*schema.Set).List())
+		if err := validateNotifyUsers(db, users); err != nil {
+			return fmt.Errorf("error creating resource monitor %v err = %w", name, err)
+		}
+		cb.SetStringList("notify_users", users)
 	}
 	if v, ok := d.GetOk("credit_quota"); ok {
 		cb.SetInt("credit_quota", v.(int))
@@ -336,9 +366,12 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Account level
-	if err := d.Set("set_for_account", rm.Level.Valid && rm.Level.String == "ACCOUNT"); err != nil {
-		return err
+	// Account level. It is only tracked when managed by this resource, so that an attachment to the account made by
+	// snowflake_resource_monitor_attachment is not removed on the next apply.
+	if d.Get("set_for_account").(bool) {
+		if err := d.Set("set_for_account", rm.Level.Valid && rm.Level.String == "ACCOUNT"); err != nil {
+			return err
+		}
 	}
 
 	return err
@@ -395,7 +428,11 @@ func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("notify_users") {
 		runSetStatement = true
-		ub.SetStringList(`NOTIFY_USERS`, expandStringList(d.Get("notify_users").(*schema.Set).List()))
+		users := expandStringList(d.Get("notify_users").(*schema.Set).List())
+		if err := validateNotifyUsers(db, users); err != nil {
+			return fmt.Errorf("error updating resource monitor %v err = %w", id, err)
+		}
+		ub.SetStringList(`NOTIFY_USERS`, users)
 	}
 
 	if d.HasChange("credit_quota") {
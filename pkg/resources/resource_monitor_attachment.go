@@ -0,0 +1,216 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var resourceMonitorAttachmentSchema = map[string]*schema.Schema{
+	"resource_monitor": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the resource monitor to attach.",
+	},
+	"warehouse": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"warehouse", "set_for_account"},
+		Description:  "Name of the warehouse the resource monitor is attached to.",
+	},
+	"set_for_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"warehouse", "set_for_account"},
+		Description:  "Specifies whether the resource monitor is attached to the account.",
+	},
+}
+
+// ResourceMonitorAttachment returns a pointer to the resource representing the attachment of a resource monitor to
+// a warehouse or the account.
+func ResourceMonitorAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a resource monitor to a warehouse or to the account. A warehouse and the account can each have only one resource monitor; attaching a monitor to a warehouse or account that already has a different monitor fails instead of replacing it. Do not manage the same attachment with the `warehouses` or `set_for_account` attributes of `snowflake_resource_monitor`.",
+		Create:      CreateResourceMonitorAttachment,
+		Read:        ReadResourceMonitorAttachment,
+		Delete:      DeleteResourceMonitorAttachment,
+
+		Schema: resourceMonitorAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: importResourceMonitorAttachment,
+		},
+	}
+}
+
+// parseResourceMonitorAttachmentID returns the resource monitor and the warehouse of the attachment. The warehouse
+// is empty for an attachment to the account.
+func parseResourceMonitorAttachmentID(id string) (sdk.AccountObjectIdentifier, *sdk.AccountObjectIdentifier) {
+	parts := strings.SplitN(id, helpers.IDDelimiter, 2)
+	monitor := sdk.NewAccountObjectIdentifier(parts[0])
+	if len(parts) == 1 {
+		return monitor, nil
+	}
+	warehouse := sdk.NewAccountObjectIdentifier(parts[1])
+	return monitor, &warehouse
+}
+
+// warehouseResourceMonitor returns the name of the resource monitor attached to the warehouse, if any.
+func warehouseResourceMonitor(w *sdk.Warehouse) string {
+	if strings.EqualFold(w.ResourceMonitor, "null") {
+		return ""
+	}
+	return w.ResourceMonitor
+}
+
+// accountResourceMonitor returns the name of the resource monitor attached to the account, if any.
+func accountResourceMonitor(ctx context.Context, client *sdk.Client) (string, error) {
+	monitors, err := client.ResourceMonitors.Show(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, monitor := range monitors {
+		if monitor.Level == sdk.ResourceMonitorLevelAccount {
+			return monitor.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func importResourceMonitorAttachment(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	monitor, warehouse := parseResourceMonitorAttachmentID(d.Id())
+	if err := d.Set("resource_monitor", monitor.Name()); err != nil {
+		return nil, err
+	}
+	if warehouse != nil {
+		if err := d.Set("warehouse", warehouse.Name()); err != nil {
+			return nil, err
+		}
+	} else if err := d.Set("set_for_account", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// CreateResourceMonitorAttachment implements schema.CreateFunc.
+func CreateResourceMonitorAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	monitor := sdk.NewAccountObjectIdentifier(d.Get("resource_monitor").(string))
+	if _, err := client.ResourceMonitors.ShowByID(ctx, monitor); err != nil {
+		return fmt.Errorf("error reading resource monitor %v err = %w", monitor.Name(), err)
+	}
+
+	if v, ok := d.GetOk("warehouse"); ok {
+		warehouse := sdk.NewAccountObjectIdentifier(v.(string))
+		w, err := client.Warehouses.ShowByID(ctx, warehouse)
+		if err != nil {
+			return fmt.Errorf("error reading warehouse %v err = %w", warehouse.Name(), err)
+		}
+		if current := warehouseResourceMonitor(w); current != "" && current != monitor.Name() {
+			return fmt.Errorf("warehouse %v is already attached to resource monitor %v; remove that attachment first", warehouse.Name(), current)
+		}
+		err = client.Warehouses.Alter(ctx, warehouse, &sdk.WarehouseAlterOptions{
+			Set: &sdk.WarehouseSet{
+				ResourceMonitor: monitor,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error attaching resource monitor %v to warehouse %v err = %w", monitor.Name(), warehouse.Name(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(monitor.Name(), warehouse.Name()))
+		return ReadResourceMonitorAttachment(d, meta)
+	}
+
+	current, err := accountResourceMonitor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if current != "" && current != monitor.Name() {
+		return fmt.Errorf("the account is already attached to resource monitor %v; remove that attachment first", current)
+	}
+	if err := client.ResourceMonitors.SetOnAccount(ctx, monitor); err != nil {
+		return fmt.Errorf("error attaching resource monitor %v to account err = %w", monitor.Name(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(monitor.Name()))
+	return ReadResourceMonitorAttachment(d, meta)
+}
+
+// ReadResourceMonitorAttachment implements schema.ReadFunc.
+func ReadResourceMonitorAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	monitor, warehouse := parseResourceMonitorAttachmentID(d.Id())
+
+	if warehouse != nil {
+		w, err := client.Warehouses.ShowByID(ctx, *warehouse)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] warehouse (%s) not found", warehouse.Name())
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if warehouseResourceMonitor(w) != monitor.Name() {
+			log.Printf("[DEBUG] resource monitor (%s) is no longer attached to warehouse (%s)", monitor.Name(), warehouse.Name())
+			d.SetId("")
+		}
+		return nil
+	}
+
+	current, err := accountResourceMonitor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if current != monitor.Name() {
+		log.Printf("[DEBUG] resource monitor (%s) is no longer attached to the account", monitor.Name())
+		d.SetId("")
+	}
+	return nil
+}
+
+// DeleteResourceMonitorAttachment implements schema.DeleteFunc.
+func DeleteResourceMonitorAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	// the attachment is only removed if it was not replaced in the meantime, so that another monitor is not detached
+	if err := ReadResourceMonitorAttachment(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	monitor, warehouse := parseResourceMonitorAttachmentID(d.Id())
+	if warehouse != nil {
+		err := client.Warehouses.Alter(ctx, *warehouse, &sdk.WarehouseAlterOptions{
+			Unset: &sdk.WarehouseUnset{
+				ResourceMonitor: sdk.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error detaching resource monitor %v from warehouse %v err = %w", monitor.Name(), warehouse.Name(), err)
+		}
+	} else if err := client.ResourceMonitors.UnsetOnAccount(ctx); err != nil {
+		return fmt.Errorf("error detaching resource monitor %v from account err = %w", monitor.Name(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
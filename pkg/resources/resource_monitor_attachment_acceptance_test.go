@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ResourceMonitorAttachment(t *testing.T) {
+	prefix := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceMonitorAttachmentConfig(prefix, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_resource_monitor_attachment.first", "resource_monitor", prefix+"_FIRST"),
+					resource.TestCheckResourceAttr("snowflake_resource_monitor_attachment.first", "warehouse", prefix),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "resource_monitor", prefix+"_FIRST"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_resource_monitor_attachment.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// a warehouse can only have one resource monitor
+			{
+				Config: resourceMonitorAttachmentConfig(prefix, `
+resource "snowflake_resource_monitor_attachment" "second" {
+	resource_monitor = snowflake_resource_monitor.second.name
+	warehouse        = snowflake_warehouse.w.name
+	depends_on       = [snowflake_resource_monitor_attachment.first]
+}
+`),
+				ExpectError: regexp.MustCompile("is already attached to resource monitor"),
+			},
+		},
+	})
+}
+
+func resourceMonitorAttachmentConfig(prefix string, extra string) string {
+	return fmt.Sprintf(`
+resource "snowflake_warehouse" "w" {
+	name           = "%[1]s"
+	warehouse_size = "XSMALL"
+
+	lifecycle {
+		ignore_changes = [resource_monitor]
+	}
+}
+
+resource "snowflake_resource_monitor" "first" {
+	name         = "%[1]s_FIRST"
+	credit_quota = 100
+}
+
+resource "snowflake_resource_monitor" "second" {
+	name         = "%[1]s_SECOND"
+	credit_quota = 100
+}
+
+resource "snowflake_resource_monitor_attachment" "first" {
+	resource_monitor = snowflake_resource_monitor.first.name
+	warehouse        = snowflake_warehouse.w.name
+}
+%[2]s
+`, prefix, extra)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceMonitorAttachmentID(t *testing.T) {
+	r := require.New(t)
+
+	monitor, warehouse := parseResourceMonitorAttachmentID("monitor|warehouse")
+	r.Equal("monitor", monitor.Name())
+	r.NotNil(warehouse)
+	r.Equal("warehouse", warehouse.Name())
+
+	monitor, warehouse = parseResourceMonitorAttachmentID("monitor")
+	r.Equal("monitor", monitor.Name())
+	r.Nil(warehouse)
+}
+
+func TestWarehouseResourceMonitor(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("", warehouseResourceMonitor(&sdk.Warehouse{ResourceMonitor: "null"}))
+	r.Equal("", warehouseResourceMonitor(&sdk.Warehouse{}))
+	r.Equal("monitor", warehouseResourceMonitor(&sdk.Warehouse{ResourceMonitor: "monitor"}))
+}
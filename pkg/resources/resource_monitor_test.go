@@ -37,6 +37,8 @@ func TestResourceMonitorCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW USERS LIKE 'USERTWO'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("USERTWO"))
+		mock.ExpectQuery(`^SHOW USERS LIKE 'USERONE'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("USERONE"))
 		mock.ExpectExec(
 			`^CREATE RESOURCE MONITOR "good_name" CREDIT_QUOTA=100 NOTIFY_USERS=\('USERTWO', 'USERONE'\) TRIGGERS ON 99 PERCENT DO SUSPEND ON 105 PERCENT DO SUSPEND_IMMEDIATE ON 88 PERCENT DO NOTIFY ON 75 PERCENT DO NOTIFY$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -48,6 +50,25 @@ func TestResourceMonitorCreate(t *testing.T) {
 	})
 }
 
+func TestResourceMonitorCreateUnknownNotifyUser(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"notify_users": []interface{}{"MISSING"},
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.ResourceMonitor().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW USERS LIKE 'MISSING'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		err := resources.CreateResourceMonitor(d, db)
+		r.ErrorContains(err, "MISSING")
+	})
+}
+
 func expectReadResourceMonitor(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"name", "credit_quota", "used_credits", "remaining_credits", "level",
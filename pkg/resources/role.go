@@ -22,8 +22,11 @@ var roleSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
 		// TODO validation
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
-	"tag": tagReferenceSchema,
+	"ignore_idp_managed_attributes": ignoreIdpManagedAttributesSchema("comment"),
+	"idp_managed":                   idpManagedSchema,
+	"tag":                           tagReferenceSchema,
 }
 
 func Role() *schema.Resource {
@@ -83,7 +86,8 @@ func ReadRole(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("comment", role.Comment.String); err != nil {
 		return err
 	}
-	return nil
+	idpManaged := d.Get("ignore_idp_managed_attributes").(bool) && snowflake.IsSCIMProvisionerRole(role.Owner.String)
+	return d.Set("idp_managed", idpManaged)
 }
 
 func UpdateRole(d *schema.ResourceData, meta interface{}) error {
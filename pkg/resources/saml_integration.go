@@ -1,11 +1,13 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,20 +27,29 @@ var samlIntegrationSchema = map[string]*schema.Schema{
 		Default:     true,
 		Description: "Specifies whether this security integration is enabled or disabled.",
 	},
+	"saml2_idp_metadata": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The SAML2 metadata XML document of the IdP, e.g. read with `file()`. The issuer, the SSO URL and the signing certificate are taken from it, and saml2_provider defaults to CUSTOM.",
+		ConflictsWith: []string{"saml2_issuer", "saml2_sso_url", "saml2_x509_cert"},
+	},
 	"saml2_issuer": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The string containing the IdP EntityID / Issuer.",
+		Optional:    true,
+		Computed:    true,
+		Description: "The string containing the IdP EntityID / Issuer. Required unless saml2_idp_metadata is set.",
 	},
 	"saml2_sso_url": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The string containing the IdP SSO URL, where the user should be redirected by Snowflake (the Service Provider) with a SAML AuthnRequest message.",
+		Optional:    true,
+		Computed:    true,
+		Description: "The string containing the IdP SSO URL, where the user should be redirected by Snowflake (the Service Provider) with a SAML AuthnRequest message. Required unless saml2_idp_metadata is set.",
 	},
 	"saml2_provider": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The string describing the IdP. One of the following: OKTA, ADFS, Custom.",
+		Optional:    true,
+		Computed:    true,
+		Description: "The string describing the IdP. One of the following: OKTA, ADFS, Custom. Required unless saml2_idp_metadata is set.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"OKTA", "ADFS", "CUSTOM",
 		}, true),
@@ -51,8 +62,14 @@ var samlIntegrationSchema = map[string]*schema.Schema{
 	},
 	"saml2_x509_cert": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The Base64 encoded IdP signing certificate on a single line without the leading -----BEGIN CERTIFICATE----- and ending -----END CERTIFICATE----- markers.",
+		Optional:    true,
+		Computed:    true,
+		Description: "The Base64 encoded IdP signing certificate on a single line without the leading -----BEGIN CERTIFICATE----- and ending -----END CERTIFICATE----- markers. Required unless saml2_idp_metadata is set. An expired certificate is rejected.",
+	},
+	"saml2_x509_cert_expires_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The expiration time of the IdP signing certificate (RFC 3339).",
 	},
 	"saml2_sp_initiated_login_page_label": {
 		Type:        schema.TypeString,
@@ -145,13 +162,73 @@ func SAMLIntegration() *schema.Resource {
 		Update: UpdateSAMLIntegration,
 		Delete: DeleteSAMLIntegration,
 
-		Schema: samlIntegrationSchema,
+		Schema:        samlIntegrationSchema,
+		CustomizeDiff: customizeSAMLIntegrationDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// samlIntegrationIdPAttributes lists the attributes describing the IdP, either configured or taken from the metadata.
+var samlIntegrationIdPAttributes = []string{"saml2_issuer", "saml2_sso_url", "saml2_provider", "saml2_x509_cert"}
+
+func customizeSAMLIntegrationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return diffSAMLIntegrationIdP(d, time.Now())
+}
+
+// diffSAMLIntegrationIdP fills the IdP attributes from saml2_idp_metadata and rejects a certificate expired at now.
+func diffSAMLIntegrationIdP(d *schema.ResourceDiff, now time.Time) error {
+	if !d.NewValueKnown("saml2_idp_metadata") {
+		for _, k := range []string{"saml2_issuer", "saml2_sso_url", "saml2_x509_cert", "saml2_x509_cert_expires_at"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if v := d.Get("saml2_idp_metadata").(string); v != "" {
+		metadata, err := snowflake.ParseSAMLIdPMetadata(v)
+		if err != nil {
+			return fmt.Errorf("invalid saml2_idp_metadata: %w", err)
+		}
+		if err := d.SetNew("saml2_issuer", metadata.Issuer); err != nil {
+			return err
+		}
+		if err := d.SetNew("saml2_sso_url", metadata.SSOURL); err != nil {
+			return err
+		}
+		if err := d.SetNew("saml2_x509_cert", metadata.X509Cert); err != nil {
+			return err
+		}
+		if d.Get("saml2_provider").(string) == "" {
+			if err := d.SetNew("saml2_provider", "CUSTOM"); err != nil {
+				return err
+			}
+		}
+	} else if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		// the attributes are computed, so only the raw configuration tells whether they were left out
+		for _, k := range samlIntegrationIdPAttributes {
+			if old, _ := d.GetChange(k); old.(string) == "" && config.GetAttr(k).IsNull() {
+				return fmt.Errorf("%s is required unless saml2_idp_metadata is set", k)
+			}
+		}
+	}
+
+	if !d.HasChange("saml2_x509_cert") || !d.NewValueKnown("saml2_x509_cert") {
+		return nil
+	}
+	cert, err := snowflake.ParseSAMLCertificate(d.Get("saml2_x509_cert").(string))
+	if err != nil {
+		return fmt.Errorf("invalid saml2_x509_cert: %w", err)
+	}
+	if !now.Before(cert.NotAfter) {
+		return fmt.Errorf("the IdP signing certificate expired at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return d.SetNew("saml2_x509_cert_expires_at", cert.NotAfter.UTC().Format(time.RFC3339))
+}
+
 // CreateSAMLIntegration implements schema.CreateFunc.
 func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -287,6 +364,13 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("saml2_x509_cert", v.(string)); err != nil {
 				return fmt.Errorf("unable to set saml2_x509_cert for security integration err = %w", err)
 			}
+			expiresAt := ""
+			if cert, err := snowflake.ParseSAMLCertificate(v.(string)); err == nil {
+				expiresAt = cert.NotAfter.UTC().Format(time.RFC3339)
+			}
+			if err := d.Set("saml2_x509_cert_expires_at", expiresAt); err != nil {
+				return err
+			}
 		case "SAML2_SP_INITIATED_LOGIN_PAGE_LABEL":
 			if err := d.Set("saml2_sp_initiated_login_page_label", v.(string)); err != nil {
 				return fmt.Errorf("unable to set saml2_sp_initiated_login_page_label for security integration")
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// samlTestCertificate expires at 2028-08-15T23:24:23Z.
+const samlTestCertificate = "MIIERTCCAq2gAwIBAgIJAKmtzjCD1+tqMA0GCSqGSIb3DQEBCwUAMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDAeFw0xODA4MTgyMzI0MjNaFw0yODA4MTUyMzI0MjNaMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDCCAaIwDQYJKoZIhvcNAQEBBQADggGPADCCAYoCggGBALhUlY3SkIOze+l8y6dBzM6p7B8OykJWlwizszU16Lih8D7KLhNJfahoVxbPxB3YFM/81PJLOeK2krvJ5zY6CJyQY3sPQAkZKI7I8qq9lmZ2g4QPqybNstXS6YUXJNUt/ixbbK/N97+LKTiSutbD1J7AoFnouMuLjlhN5VRZ43jez4xLSHVZaYuUFKn01Y9oLKbj46LQnZnJCAGpTgPqEQJr6GpVGw43bKyUpGoaPrdDRgRgtPMUWgFDkgcI3QiV1lsKfBs1t1E2UA7ACFnlJZpEuBtwgivzo3VeitiSaF3Jxh25EY5/vABpcgQQRz3RH2l8MMKdRsxb8VT3yh2S+CX55s+cN67LiCPr6f2u+KS1iKfB9mWN6o2S4lcmo82HIBbsuXJV0oA1HrGMyyc4Y9nng/I8iuAp8or1JrWRHQ+8NzO85DWK0rtvtLPxkvw0HK32glyuOP/9F05Z7+tiVIgn67buC0EdoUm1RSpibqmB1ST2PikslOlVbJuy4Ah93wIDAQABo1gwVjA1BgNVHREELjAsgippcC0xNzItMzEtMjgtNjQudXMtd2VzdC0yLmNvbXB1dGUuaW50ZXJuYWwwHQYDVR0OBBYEFAdsTxYfulJ5yunYtgYJHC9IcevzMA0GCSqGSIb3DQEBCwUAA4IBgQB3J6i7KreiHL8NPMglfWLHk1PZOgvIEEpKL+GRebvcbyqgcuc3VVPylq70VvGqhJxp1q/mzLfraUiypzfWFGm9zfwIg0H5TqRZYEPTvgIhIICjaDWRwZBDJG8D5G/KoV60DlUG0crPBlIuCCr/SRa5ZoDQqvucTfr3Rx4Ha6koXFSjoSXllR+jn4GnInhm/WH137a+v35PUcffNxfuehoGn6i4YeXF3cwJK4e35cOFW+dLbnaLk+Ty7HOGvpw86h979C6mJ9qEHYgq9rQyzlSPbLZGZSgVcIezunOaOsWm81BsXRNNJjzHGCqKf8RMhd8oZP55+2/SVRBwnkGyUNCuDPrJcymC95ZT2NW/KeWkz28HF2i31xQmecT2r3lQRSM8acvOXQsNEDCDvJvCzJT9c2AnsnO24r6arPXs/UWAxOI+MjclXPLkLD6uTHV+Oo8XZ7bOjegD5hL6/bKUWnNMurQNGrmi/jvqsCFLDKftl7ajuxKjtodnSuwhoY7NQy8="
+
+var samlTestMetadata = fmt.Sprintf(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com">
+  <IDPSSODescriptor>
+    <KeyDescriptor use="signing"><KeyInfo><X509Data><X509Certificate>%s</X509Certificate></X509Data></KeyInfo></KeyDescriptor>
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`, samlTestCertificate)
+
+// samlIntegrationDiff plans the given configuration of a new SAML integration as seen at now.
+func samlIntegrationDiff(t *testing.T, config map[string]interface{}, now time.Time) (*terraform.InstanceDiff, error) {
+	t.Helper()
+	res := SAMLIntegration()
+	res.CustomizeDiff = func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		return diffSAMLIntegrationIdP(d, now)
+	}
+	return res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+}
+
+func TestDiffSAMLIntegrationIdP(t *testing.T) {
+	now := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("from metadata", func(t *testing.T) {
+		r := require.New(t)
+		diff, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":               "saml",
+			"saml2_idp_metadata": samlTestMetadata,
+		}, now)
+		r.NoError(err)
+		r.Equal("https://idp.example.com", diff.Attributes["saml2_issuer"].New)
+		r.Equal("https://idp.example.com/sso", diff.Attributes["saml2_sso_url"].New)
+		r.Equal("CUSTOM", diff.Attributes["saml2_provider"].New)
+		r.Equal(samlTestCertificate, diff.Attributes["saml2_x509_cert"].New)
+		r.Equal("2028-08-15T23:24:23Z", diff.Attributes["saml2_x509_cert_expires_at"].New)
+	})
+
+	t.Run("keeps the configured provider", func(t *testing.T) {
+		r := require.New(t)
+		diff, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":               "saml",
+			"saml2_idp_metadata": samlTestMetadata,
+			"saml2_provider":     "OKTA",
+		}, now)
+		r.NoError(err)
+		r.Equal("OKTA", diff.Attributes["saml2_provider"].New)
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":               "saml",
+			"saml2_idp_metadata": "<EntityDescriptor/>",
+		}, now)
+		require.ErrorContains(t, err, "invalid saml2_idp_metadata")
+	})
+
+	t.Run("expired certificate", func(t *testing.T) {
+		_, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":               "saml",
+			"saml2_idp_metadata": samlTestMetadata,
+		}, time.Date(2028, 8, 16, 0, 0, 0, 0, time.UTC))
+		require.ErrorContains(t, err, "the IdP signing certificate expired at 2028-08-15T23:24:23Z")
+	})
+
+	t.Run("manual attributes", func(t *testing.T) {
+		r := require.New(t)
+		diff, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":            "saml",
+			"saml2_issuer":    "issuer",
+			"saml2_sso_url":   "https://idp.example.com/sso",
+			"saml2_provider":  "ADFS",
+			"saml2_x509_cert": samlTestCertificate,
+		}, now)
+		r.NoError(err)
+		r.Equal("2028-08-15T23:24:23Z", diff.Attributes["saml2_x509_cert_expires_at"].New)
+	})
+
+	t.Run("missing manual attribute", func(t *testing.T) {
+		r := require.New(t)
+		res := SAMLIntegration()
+		// the left out attributes are only known from the raw configuration, which Terraform sends with the prior state
+		rawConfig := map[string]cty.Value{}
+		for k, s := range res.Schema {
+			rawConfig[k] = cty.NullVal(cty.String)
+			if s.Type == schema.TypeBool {
+				rawConfig[k] = cty.NullVal(cty.Bool)
+			}
+		}
+		rawConfig["name"] = cty.StringVal("saml")
+		rawConfig["saml2_issuer"] = cty.StringVal("issuer")
+		state := &terraform.InstanceState{RawConfig: cty.ObjectVal(rawConfig)}
+		_, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":         "saml",
+			"saml2_issuer": "issuer",
+		}), nil)
+		r.EqualError(err, "saml2_sso_url is required unless saml2_idp_metadata is set")
+	})
+
+	t.Run("invalid manual certificate", func(t *testing.T) {
+		_, err := samlIntegrationDiff(t, map[string]interface{}{
+			"name":            "saml",
+			"saml2_issuer":    "issuer",
+			"saml2_sso_url":   "https://idp.example.com/sso",
+			"saml2_provider":  "ADFS",
+			"saml2_x509_cert": "MIICdummybase64certificate",
+		}, now)
+		require.ErrorContains(t, err, "invalid saml2_x509_cert")
+	})
+}
@@ -37,6 +37,7 @@ func TestSchemaGrantCreate(t *testing.T) {
 		r.NotNil(d)
 
 		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectNoExistingGrants(mock, `SHOW GRANTS ON SCHEMA "test-db"."test-schema"`)
 			mock.ExpectExec(
 				fmt.Sprintf(`^GRANT %s ON SCHEMA "test-db"."test-schema" TO ROLE "test-role-1" WITH GRANT OPTION$`, testPriv),
 			).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -115,6 +116,7 @@ func TestFutureSchemaGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW FUTURE GRANTS IN DATABASE "test-db"`)
 		mock.ExpectExec(
 			`^GRANT USAGE ON FUTURE SCHEMAS IN DATABASE "test-db" TO ROLE "test-role-1" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -33,12 +33,10 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 		},
 	},
 	"provisioner_role": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM.",
-		ValidateFunc: validation.StringInSlice([]string{
-			"OKTA_PROVISIONER", "AAD_PROVISIONER", "GENERIC_SCIM_PROVISIONER",
-		}, true),
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM.",
+		ValidateFunc: validation.StringInSlice(snowflake.SCIMProvisionerRoles, true),
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			normalize := func(s string) string {
 				return strings.ToUpper(strings.ReplaceAll(s, "-", ""))
@@ -0,0 +1,304 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var secretSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the secret.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the secret.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the schema in which the secret is created.",
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the type of the secret. Valid values are PASSWORD, GENERIC_STRING and OAUTH2.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.SecretTypePassword), string(sdk.SecretTypeGenericString), string(sdk.SecretTypeOAuth2)}, false),
+	},
+	"username": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the username value to store in the secret. Only used with the PASSWORD type.",
+		ConflictsWith: []string{"secret_string", "api_authentication", "oauth_scopes", "oauth_refresh_token", "oauth_refresh_token_expiry_time"},
+	},
+	"password": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		Description:   "Specifies the password value to store in the secret. Only used with the PASSWORD type.",
+		ConflictsWith: []string{"secret_string", "api_authentication", "oauth_scopes", "oauth_refresh_token", "oauth_refresh_token_expiry_time"},
+	},
+	"secret_string": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		Description:   "Specifies the string to store in the secret. Only used with the GENERIC_STRING type.",
+		ConflictsWith: []string{"username", "password", "api_authentication", "oauth_scopes", "oauth_refresh_token", "oauth_refresh_token_expiry_time"},
+	},
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the security integration that connects Snowflake to an external service. Only used with the OAUTH2 type.",
+	},
+	"oauth_scopes": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "Specifies a list of scopes to use when making a request from the OAuth server by a role with USAGE on the integration during the OAuth client credentials flow. Only used with the OAUTH2 type. All the scopes cannot be removed from an existing secret.",
+		ConflictsWith: []string{"oauth_refresh_token", "oauth_refresh_token_expiry_time"},
+	},
+	"oauth_refresh_token": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		Description:   "Specifies the token as a string that is used to obtain a new access token from the OAuth authorization server when the access token expires. Only used with the OAUTH2 type.",
+		ConflictsWith: []string{"oauth_scopes"},
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the timestamp as a string when the OAuth refresh token expires. Only used with the OAUTH2 type.",
+		RequiredWith: []string{"oauth_refresh_token"},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the secret.",
+	},
+}
+
+func Secret() *schema.Resource {
+	return &schema.Resource{
+		Description: "A secret stores sensitive values (credentials, strings or OAuth tokens) that can be used by external access integrations, UDFs and procedures.",
+		Create:      CreateSecret,
+		Read:        ReadSecret,
+		Update:      UpdateSecret,
+		Delete:      DeleteSecret,
+
+		Schema:        secretSchema,
+		CustomizeDiff: customizeSecretDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// customizeSecretDiff rejects the removal of all the OAuth scopes, which ALTER SECRET cannot unset.
+func customizeSecretDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("oauth_scopes") {
+		return nil
+	}
+	// compare the counts, the elements of a removed set are not reliably marked as removed in the diff
+	if old, new := d.GetChange("oauth_scopes.#"); old.(int) > 0 && new.(int) == 0 {
+		return errors.New("oauth_scopes cannot be removed from an existing secret, replace the secret (terraform apply -replace) to remove them")
+	}
+	return nil
+}
+
+func expandOAuthScopes(v interface{}) []sdk.OAuthScope {
+	scopes := make([]sdk.OAuthScope, 0)
+	for _, scope := range expandStringList(v.(*schema.Set).List()) {
+		scopes = append(scopes, sdk.OAuthScope{Scope: scope})
+	}
+	return scopes
+}
+
+// CreateSecret implements schema.CreateFunc.
+func CreateSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.SecretCreateOptions{
+		Type: sdk.SecretType(d.Get("type").(string)),
+	}
+	if v, ok := d.GetOk("username"); ok {
+		createOptions.Username = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("password"); ok {
+		createOptions.Password = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("secret_string"); ok {
+		createOptions.SecretString = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("api_authentication"); ok {
+		createOptions.APIAuthentication = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_scopes"); ok {
+		createOptions.OAuthScopes = expandOAuthScopes(v)
+	}
+	if v, ok := d.GetOk("oauth_refresh_token"); ok {
+		createOptions.OAuthRefreshToken = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_refresh_token_expiry_time"); ok {
+		createOptions.OAuthRefreshTokenExpiryTime = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.Secrets.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating secret %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadSecret(d, meta)
+}
+
+// ReadSecret implements schema.ReadFunc.
+func ReadSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	secret, err := client.Secrets.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", secret.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", secret.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", secret.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", string(secret.SecretType)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", secret.Comment); err != nil {
+		return err
+	}
+
+	// Snowflake never returns the sensitive values, so only the plain attributes are refreshed.
+	secretDetails, err := client.Secrets.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	switch secret.SecretType {
+	case sdk.SecretTypePassword:
+		if err := d.Set("username", secretDetails.Username); err != nil {
+			return err
+		}
+	case sdk.SecretTypeOAuth2:
+		if err := d.Set("api_authentication", secretDetails.IntegrationName); err != nil {
+			return err
+		}
+		if len(secretDetails.OAuthScopes) > 0 {
+			if err := d.Set("oauth_scopes", secretDetails.OAuthScopes); err != nil {
+				return err
+			}
+		}
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateSecret implements schema.UpdateFunc.
+func UpdateSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set := &sdk.SecretSet{}
+	runSet := false
+	if d.HasChange("username") {
+		set.Username = sdk.String(d.Get("username").(string))
+		runSet = true
+	}
+	if d.HasChange("password") {
+		set.Password = sdk.String(d.Get("password").(string))
+		runSet = true
+	}
+	if d.HasChange("secret_string") {
+		set.SecretString = sdk.String(d.Get("secret_string").(string))
+		runSet = true
+	}
+	if d.HasChange("oauth_scopes") {
+		// removing all the scopes is rejected by customizeSecretDiff
+		set.OAuthScopes = expandOAuthScopes(d.Get("oauth_scopes"))
+		runSet = true
+	}
+	if d.HasChange("oauth_refresh_token") {
+		set.OAuthRefreshToken = sdk.String(d.Get("oauth_refresh_token").(string))
+		runSet = true
+	}
+	if d.HasChange("oauth_refresh_token_expiry_time") {
+		set.OAuthRefreshTokenExpiryTime = sdk.String(d.Get("oauth_refresh_token_expiry_time").(string))
+		runSet = true
+	}
+	if runSet {
+		if err := client.Secrets.Alter(ctx, objectIdentifier, &sdk.SecretAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		alterOptions := &sdk.SecretAlterOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			alterOptions.Set = &sdk.SecretSet{
+				Comment: sdk.String(v.(string)),
+			}
+		} else {
+			alterOptions.Unset = &sdk.SecretUnset{
+				Comment: sdk.Bool(true),
+			}
+		}
+		if err := client.Secrets.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating secret %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadSecret(d, meta)
+}
+
+// DeleteSecret implements schema.DeleteFunc.
+func DeleteSecret(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.Secrets.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Secret(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secretConfig(accName, "admin", "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "type", "PASSWORD"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "username", "admin"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "qualified_name", fmt.Sprintf(`"%v"."%v"."%v"`, accName, accName, accName)),
+				),
+			},
+			{
+				Config: secretConfig(accName, "other", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.test", "username", "other"),
+					resource.TestCheckResourceAttr("snowflake_secret.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func secretConfig(name string, username string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_secret" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	schema   = snowflake_schema.test.name
+	type     = "PASSWORD"
+	username = "%[2]v"
+	password = "Password123!"
+	comment  = "%[3]v"
+}
+`, name, username, comment)
+}
@@ -0,0 +1,56 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecret(t *testing.T) {
+	r := require.New(t)
+	err := resources.Secret().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSecretOAuthScopesDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test_db|test_schema|test_secret",
+		Attributes: map[string]string{
+			"name":               "test_secret",
+			"database":           "test_db",
+			"schema":             "test_schema",
+			"type":               "OAUTH2",
+			"api_authentication": "test_integration",
+			"oauth_scopes.#":     "1",
+			fmt.Sprintf("oauth_scopes.%d", schema.HashString("read")): "read",
+		},
+	}
+	config := map[string]interface{}{
+		"name":               "test_secret",
+		"database":           "test_db",
+		"schema":             "test_schema",
+		"type":               "OAUTH2",
+		"api_authentication": "test_integration",
+	}
+
+	t.Run("removing all scopes is rejected", func(t *testing.T) {
+		_, err := resources.Secret().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.EqualError(t, err, "oauth_scopes cannot be removed from an existing secret, replace the secret (terraform apply -replace) to remove them")
+	})
+
+	t.Run("changing the scopes updates the secret in place", func(t *testing.T) {
+		changed := map[string]interface{}{"oauth_scopes": []interface{}{"write"}}
+		for k, v := range config {
+			changed[k] = v
+		}
+		diff, err := resources.Secret().Diff(context.Background(), state, terraform.NewResourceConfigRaw(changed), nil)
+		require.NoError(t, err)
+		require.False(t, diff.RequiresNew())
+	})
+}
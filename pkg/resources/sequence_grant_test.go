@@ -34,6 +34,7 @@ func TestSequenceGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW GRANTS ON SEQUENCE "test-db"."PUBLIC"."test-sequence"`)
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSequenceGrant(mock)
@@ -94,6 +95,7 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW FUTURE GRANTS IN SCHEMA "test-db"."PUBLIC"`)
 		mock.ExpectExec(
 			`^GRANT USAGE ON FUTURE SEQUENCES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-1" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -118,6 +120,7 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 	b.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW FUTURE GRANTS IN DATABASE "test-db"`)
 		mock.ExpectExec(
 			`^GRANT USAGE ON FUTURE SEQUENCES IN DATABASE "test-db" TO ROLE "test-role-1"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
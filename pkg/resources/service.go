@@ -0,0 +1,335 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var serviceSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the service.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the service.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the service; must be unique for the schema in which the service is created.",
+	},
+	"compute_pool": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the compute pool in your account on which to run the service.",
+	},
+	"specification": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the inline YAML service specification.",
+		ExactlyOneOf: []string{"specification", "specification_file"},
+	},
+	"stage": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the stage location where the service specification file is stored (e.g. `@db.schema.stage/path`).",
+		RequiredWith: []string{"specification_file"},
+	},
+	"specification_file": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the path to the service specification file on the stage.",
+		RequiredWith: []string{"stage"},
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the names of the external access integrations that allow the service to access external sites.",
+	},
+	"auto_resume": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to automatically resume the service when a service function or ingress is called.",
+	},
+	"min_instances": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		Description:  "Specifies the minimum number of service instances to run.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"max_instances": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		Description:  "Specifies the maximum number of service instances to run.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"query_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the warehouse to use if a service container connects to Snowflake to execute a query without explicitly specifying a warehouse.",
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the service should be suspended. The service is suspended or resumed when the value changes.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the service.",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current status of the service (e.g. PENDING, RUNNING, SUSPENDED).",
+	},
+	"dns_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Snowflake-assigned DNS name of the service, used for service-to-service communication.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the service.",
+	},
+}
+
+func Service() *schema.Resource {
+	return &schema.Resource{
+		Description: "A service is a long-running Snowpark Container Services application defined by a specification and run on a compute pool.",
+		Create:      CreateService,
+		Read:        ReadService,
+		Update:      UpdateService,
+		Delete:      DeleteService,
+
+		Schema: serviceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandServiceSpecification(d *schema.ResourceData) *sdk.ServiceSpecification {
+	if v, ok := d.GetOk("specification"); ok {
+		return &sdk.ServiceSpecification{
+			Specification: sdk.String(v.(string)),
+		}
+	}
+	return &sdk.ServiceSpecification{
+		Stage:             sdk.String(d.Get("stage").(string)),
+		SpecificationFile: sdk.String(d.Get("specification_file").(string)),
+	}
+}
+
+// CreateService implements schema.CreateFunc.
+func CreateService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.ServiceCreateOptions{
+		ComputePool:   sdk.NewAccountObjectIdentifier(d.Get("compute_pool").(string)),
+		Specification: expandServiceSpecification(d),
+		AutoResume:    sdk.Bool(d.Get("auto_resume").(bool)),
+		MinInstances:  sdk.Int(d.Get("min_instances").(int)),
+		MaxInstances:  sdk.Int(d.Get("max_instances").(int)),
+	}
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		createOptions.ExternalAccessIntegrations = expandAccountObjectIdentifierSet(v)
+	}
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		createOptions.QueryWarehouse = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.Services.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating service %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	if d.Get("suspended").(bool) {
+		if err := client.Services.Alter(ctx, objectIdentifier, &sdk.ServiceAlterOptions{Suspend: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error suspending service %v err = %w", name, err)
+		}
+	}
+	return ReadService(d, meta)
+}
+
+// ReadService implements schema.ReadFunc.
+func ReadService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	service, err := client.Services.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", service.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", service.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", service.Name); err != nil {
+		return err
+	}
+	if err := d.Set("compute_pool", service.ComputePool); err != nil {
+		return err
+	}
+	if err := d.Set("external_access_integrations", service.ExternalAccessIntegrations); err != nil {
+		return err
+	}
+	if err := d.Set("auto_resume", service.AutoResume); err != nil {
+		return err
+	}
+	if err := d.Set("min_instances", service.MinInstances); err != nil {
+		return err
+	}
+	if err := d.Set("max_instances", service.MaxInstances); err != nil {
+		return err
+	}
+	if err := d.Set("query_warehouse", service.QueryWarehouse); err != nil {
+		return err
+	}
+	if err := d.Set("comment", service.Comment); err != nil {
+		return err
+	}
+	// The specification is not refreshed, since Snowflake returns it normalized and enriched
+	// with defaults rather than as it was submitted.
+	if err := d.Set("status", string(service.Status)); err != nil {
+		return err
+	}
+	suspended := service.Status == sdk.ServiceStatusSuspended || service.Status == sdk.ServiceStatusSuspending
+	if err := d.Set("suspended", suspended); err != nil {
+		return err
+	}
+	if err := d.Set("dns_name", service.DNSName); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateService implements schema.UpdateFunc.
+func UpdateService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChanges("specification", "stage", "specification_file") {
+		if err := client.Services.Alter(ctx, objectIdentifier, &sdk.ServiceAlterOptions{Specification: expandServiceSpecification(d)}); err != nil {
+			return fmt.Errorf("error updating service specification %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	set := &sdk.ServiceSet{}
+	unset := &sdk.ServiceUnset{}
+	runSet, runUnset := false, false
+	if d.HasChange("auto_resume") {
+		set.AutoResume = sdk.Bool(d.Get("auto_resume").(bool))
+		runSet = true
+	}
+	if d.HasChange("min_instances") {
+		set.MinInstances = sdk.Int(d.Get("min_instances").(int))
+		runSet = true
+	}
+	if d.HasChange("max_instances") {
+		set.MaxInstances = sdk.Int(d.Get("max_instances").(int))
+		runSet = true
+	}
+	if d.HasChange("external_access_integrations") {
+		if ids := expandAccountObjectIdentifierSet(d.Get("external_access_integrations")); len(ids) > 0 {
+			set.ExternalAccessIntegrations = ids
+			runSet = true
+		} else {
+			unset.ExternalAccessIntegrations = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("query_warehouse") {
+		if v, ok := d.GetOk("query_warehouse"); ok {
+			set.QueryWarehouse = sdk.NewAccountObjectIdentifier(v.(string))
+			runSet = true
+		} else {
+			unset.QueryWarehouse = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset.Comment = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.Services.Alter(ctx, objectIdentifier, &sdk.ServiceAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating service %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+	if runUnset {
+		if err := client.Services.Alter(ctx, objectIdentifier, &sdk.ServiceAlterOptions{Unset: unset}); err != nil {
+			return fmt.Errorf("error updating service %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	if d.HasChange("suspended") {
+		alterOptions := &sdk.ServiceAlterOptions{}
+		if d.Get("suspended").(bool) {
+			alterOptions.Suspend = sdk.Bool(true)
+		} else {
+			alterOptions.Resume = sdk.Bool(true)
+		}
+		if err := client.Services.Alter(ctx, objectIdentifier, alterOptions); err != nil {
+			return fmt.Errorf("error updating service %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadService(d, meta)
+}
+
+// DeleteService implements schema.DeleteFunc.
+func DeleteService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.Services.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
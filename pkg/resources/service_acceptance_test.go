@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Service(t *testing.T) {
+	// services can only run images pushed to an image repository of the test account
+	image, ok := os.LookupEnv("SNOWFLAKE_SERVICE_TEST_IMAGE")
+	if !ok {
+		t.Skip("Skipping TestAcc_Service since SNOWFLAKE_SERVICE_TEST_IMAGE is not set")
+	}
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: serviceConfig(accName, image, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_service.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_service.test", "compute_pool", accName),
+					resource.TestCheckResourceAttrSet("snowflake_service.test", "dns_name"),
+				),
+			},
+			{
+				Config: serviceConfig(accName, image, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_service.test", "suspended", "true"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_service.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specification", "status"},
+			},
+		},
+	})
+}
+
+func serviceConfig(name string, image string, suspended bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_compute_pool" "test" {
+	name            = "%[1]v"
+	min_nodes       = 1
+	max_nodes       = 1
+	instance_family = "CPU_X64_XS"
+}
+
+resource "snowflake_service" "test" {
+	name          = "%[1]v"
+	database      = snowflake_database.test.name
+	schema        = snowflake_schema.test.name
+	compute_pool  = snowflake_compute_pool.test.name
+	specification = <<-EOT
+		spec:
+		  containers:
+		  - name: main
+		    image: %[2]v
+	EOT
+	suspended     = %[3]v
+	comment       = "Terraform acceptance test"
+}
+`, name, image, suspended)
+}
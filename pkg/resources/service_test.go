@@ -0,0 +1,60 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+)
+
+func TestService(t *testing.T) {
+	r := require.New(t)
+	err := resources.Service().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestServiceReadSuspended(t *testing.T) {
+	testCases := []struct {
+		status    string
+		suspended bool
+	}{
+		{status: "RUNNING", suspended: false},
+		{status: "PENDING", suspended: false},
+		{status: "SUSPENDING", suspended: true},
+		{status: "SUSPENDED", suspended: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.status, func(t *testing.T) {
+			r := require.New(t)
+			d := schema.TestResourceDataRaw(t, resources.Service().Schema, map[string]interface{}{
+				"name":      "test_service",
+				"database":  "test_db",
+				"schema":    "test_schema",
+				"suspended": !tc.suspended,
+			})
+			d.SetId("test_db|test_schema|test_service")
+
+			WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{
+					"name", "database_name", "schema_name", "owner", "compute_pool", "status", "dns_name",
+					"min_instances", "max_instances", "auto_resume", "created_on",
+				}).AddRow(
+					"test_service", "test_db", "test_schema", "ACCOUNTADMIN", "test_pool", tc.status, "test-service.svc",
+					1, 1, true, time.Now(),
+				)
+				mock.ExpectQuery(`^SHOW SERVICES LIKE 'test_service' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+				r.NoError(resources.ReadService(d, db))
+				r.Equal(tc.suspended, d.Get("suspended").(bool))
+				r.Equal(tc.status, d.Get("status").(string))
+			})
+		})
+	}
+}
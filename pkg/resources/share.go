@@ -38,6 +38,7 @@ var shareSchema = map[string]*schema.Schema{
 			"in the form of 'organization_name.account_name",
 		DiffSuppressFunc: diffCaseInsensitive,
 	},
+	"account_alias": accountAliasSchema,
 }
 
 // Share returns a pointer to the resource representing a share.
@@ -50,7 +51,7 @@ func Share() *schema.Resource {
 
 		Schema: shareSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithAccountAlias,
 		},
 	}
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/snowflakedb/gosnowflake"
@@ -48,10 +50,12 @@ var stageSchema = map[string]*schema.Schema{
 		Description: "Specifies the credentials for the stage.",
 		Sensitive:   true,
 	},
+	"credentials_wo":         writeOnlySchema("Specifies the credentials for the stage, never stored in the state. They are set when the stage is created and whenever `credentials_wo_version` changes.", "credentials"),
+	"credentials_wo_version": writeOnlyVersionSchema("credentials_wo"),
 	"storage_integration": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity.",
+		Description: "Specifies the name of the storage integration used to delegate authentication responsibility for external cloud storage to a Snowflake identity and access management (IAM) entity. When the integration already exists, the plan fails if the url is outside its allowed locations or inside its blocked locations.",
 	},
 	"file_format": {
 		Type:        schema.TypeString,
@@ -145,13 +149,45 @@ func Stage() *schema.Resource {
 		Update: UpdateStage,
 		Delete: DeleteStage,
 
-		Schema: stageSchema,
+		Schema:        stageSchema,
+		CustomizeDiff: customizeStageDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// customizeStageDiff fails the plan when the URL of the stage is outside the locations of its storage integration,
+// rather than leaving it to Snowflake at apply time.
+func customizeStageDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	db, ok := meta.(*sql.DB)
+	if !ok || !d.HasChanges("url", "storage_integration") || !d.NewValueKnown("url") || !d.NewValueKnown("storage_integration") {
+		return nil
+	}
+	url := d.Get("url").(string)
+	integration := d.Get("storage_integration").(string)
+	if integration == "" || !helpers.IsStorageURL(url) {
+		return nil
+	}
+
+	allowed, blocked, err := snowflake.StorageIntegrationLocations(db, integration)
+	if err != nil {
+		if snowflake.IsResourceNotExistOrNotAuthorized(err.Error(), "Integration") {
+			// the integration may be created in the same apply
+			log.Printf("[DEBUG] storage integration %v not found, skipping the validation of the stage url: %v", integration, err)
+			return nil
+		}
+		return fmt.Errorf("error describing storage integration %v to validate the stage url err = %w", integration, err)
+	}
+	if location, ok := helpers.MatchingURLPrefix(url, blocked); ok {
+		return fmt.Errorf("stage url %v is blocked by the location %v of storage integration %v", url, location, integration)
+	}
+	if _, ok := helpers.MatchingURLPrefix(url, allowed); !ok {
+		return fmt.Errorf("stage url %v is not in the allowed locations of storage integration %v: %v", url, integration, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
 // CreateStage implements schema.CreateFunc.
 func CreateStage(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -169,6 +205,9 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("credentials"); ok {
 		builder.WithCredentials(v.(string))
 	}
+	if v, ok := getWriteOnly(d, "credentials_wo"); ok {
+		builder.WithCredentials(v)
+	}
 
 	if v, ok := d.GetOk("storage_integration"); ok {
 		builder.WithStorageIntegration(v.(string))
@@ -335,6 +374,15 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("credentials_wo_version") {
+		if credentials, ok := getWriteOnly(d, "credentials_wo"); ok {
+			q := builder.ChangeCredentials(credentials)
+			if err := snowflake.Exec(db, q); err != nil {
+				return fmt.Errorf("error updating stage credentials on %v", d.Id())
+			}
+		}
+	}
+
 	if d.HasChange("storage_integration") {
 		si := d.Get("storage_integration")
 		q := builder.ChangeStorageIntegration(si.(string))
@@ -1,7 +1,9 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -10,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -107,3 +110,94 @@ func TestStageRead(t *testing.T) {
 		r.Nil(err)
 	})
 }
+
+func TestStageURLInStorageIntegrationLocations(t *testing.T) {
+	testCases := []struct {
+		name string
+		url  string
+		err  string
+	}{
+		{name: "allowed", url: "s3://bucket/data/2023/"},
+		{name: "allowed with a different scheme case", url: "S3://bucket/data"},
+		{name: "not allowed", url: "s3://bucket/other/", err: "stage url s3://bucket/other/ is not in the allowed locations of storage integration test_integration: s3://bucket/data/, gcs://bucket/"},
+		{name: "not a whole path segment", url: "s3://bucket/database/", err: "stage url s3://bucket/database/ is not in the allowed locations of storage integration test_integration: s3://bucket/data/, gcs://bucket/"},
+		{name: "blocked", url: "gcs://bucket/private/keys", err: "stage url gcs://bucket/private/keys is blocked by the location gcs://bucket/private of storage integration test_integration"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+				expectDescribe := func() {
+					rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+						AddRow("ENABLED", "Boolean", true, false).
+						AddRow("STORAGE_ALLOWED_LOCATIONS", "List", "s3://bucket/data/,gcs://bucket/", nil).
+						AddRow("STORAGE_BLOCKED_LOCATIONS", "List", "gcs://bucket/private", nil)
+					mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "test_integration"$`).WillReturnRows(rows)
+				}
+				expectDescribe()
+				if tc.err == "" {
+					// the diff of a new stage is customized again once the attributes forcing a replacement are set
+					expectDescribe()
+				}
+
+				config := terraform.NewResourceConfigRaw(map[string]interface{}{
+					"name":                "test_stage",
+					"database":            "test_db",
+					"schema":              "test_schema",
+					"url":                 tc.url,
+					"storage_integration": "test_integration",
+				})
+				_, err := resources.Stage().Diff(context.Background(), nil, config, db)
+				if tc.err == "" {
+					require.NoError(t, err)
+				} else {
+					require.EqualError(t, err, tc.err)
+				}
+			})
+		})
+	}
+
+	t.Run("integration not found", func(t *testing.T) {
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			notFound := errors.New("SQL compilation error:\nIntegration 'TEST_INTEGRATION' does not exist or not authorized.")
+			mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "test_integration"$`).WillReturnError(notFound)
+			mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "test_integration"$`).WillReturnError(notFound)
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":                "test_stage",
+				"database":            "test_db",
+				"schema":              "test_schema",
+				"url":                 "s3://bucket/other/",
+				"storage_integration": "test_integration",
+			})
+			_, err := resources.Stage().Diff(context.Background(), nil, config, db)
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("integration not described", func(t *testing.T) {
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "test_integration"$`).
+				WillReturnError(errors.New("390114 (08001): Authentication token has expired."))
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":                "test_stage",
+				"database":            "test_db",
+				"schema":              "test_schema",
+				"url":                 "s3://bucket/other/",
+				"storage_integration": "test_integration",
+			})
+			_, err := resources.Stage().Diff(context.Background(), nil, config, db)
+			require.EqualError(t, err, "error describing storage integration test_integration to validate the stage url err = 390114 (08001): Authentication token has expired.")
+		})
+	})
+
+	t.Run("internal stage", func(t *testing.T) {
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":     "test_stage",
+				"database": "test_db",
+				"schema":   "test_schema",
+			})
+			_, err := resources.Stage().Diff(context.Background(), nil, config, db)
+			require.NoError(t, err)
+		})
+	})
+}
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamlitSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the streamlit.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the streamlit.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the streamlit; must be unique for the schema in which the streamlit is created.",
+	},
+	"root_location": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the full path to the named stage containing the Streamlit Python files, media files, and the environment.yml file (e.g. `@db.schema.stage/app`).",
+	},
+	"main_file": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the filename of the Streamlit Python application, relative to `root_location`.",
+	},
+	"query_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the warehouse where SQL queries issued by the Streamlit application are run.",
+	},
+	"title": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a title for the Streamlit app to display in Snowsight.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the streamlit.",
+	},
+	"url_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Unique ID associated with the Streamlit app, used in its Snowsight URL.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the streamlit.",
+	},
+}
+
+func Streamlit() *schema.Resource {
+	return &schema.Resource{
+		Description: "A streamlit is a Streamlit application served by Snowflake from files on a stage.",
+		Create:      CreateStreamlit,
+		Read:        ReadStreamlit,
+		Update:      UpdateStreamlit,
+		Delete:      DeleteStreamlit,
+
+		Schema: streamlitSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateStreamlit implements schema.CreateFunc.
+func CreateStreamlit(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.StreamlitCreateOptions{
+		RootLocation: d.Get("root_location").(string),
+		MainFile:     d.Get("main_file").(string),
+	}
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		createOptions.QueryWarehouse = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		createOptions.Title = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.Streamlits.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating streamlit %v err = %w", name, err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadStreamlit(d, meta)
+}
+
+// ReadStreamlit implements schema.ReadFunc.
+func ReadStreamlit(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	streamlit, err := client.Streamlits.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("database", streamlit.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", streamlit.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", streamlit.Name); err != nil {
+		return err
+	}
+	if err := d.Set("query_warehouse", streamlit.QueryWarehouse); err != nil {
+		return err
+	}
+	if err := d.Set("title", streamlit.Title); err != nil {
+		return err
+	}
+	if err := d.Set("comment", streamlit.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("url_id", streamlit.URLID); err != nil {
+		return err
+	}
+
+	streamlitDetails, err := client.Streamlits.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("root_location", streamlitDetails.RootLocation); err != nil {
+		return err
+	}
+	if err := d.Set("main_file", streamlitDetails.MainFile); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateStreamlit implements schema.UpdateFunc.
+func UpdateStreamlit(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newIdentifier := sdk.NewSchemaObjectIdentifier(objectIdentifier.DatabaseName(), objectIdentifier.SchemaName(), d.Get("name").(string))
+		if err := client.Streamlits.Alter(ctx, objectIdentifier, &sdk.StreamlitAlterOptions{NewName: newIdentifier}); err != nil {
+			return fmt.Errorf("error renaming streamlit %v err = %w", objectIdentifier.Name(), err)
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newIdentifier))
+		objectIdentifier = newIdentifier
+	}
+
+	set := &sdk.StreamlitSet{}
+	unset := &sdk.StreamlitUnset{}
+	runSet, runUnset := false, false
+	if d.HasChange("root_location") {
+		set.RootLocation = sdk.String(d.Get("root_location").(string))
+		runSet = true
+	}
+	if d.HasChange("main_file") {
+		set.MainFile = sdk.String(d.Get("main_file").(string))
+		runSet = true
+	}
+	if d.HasChange("query_warehouse") {
+		if v, ok := d.GetOk("query_warehouse"); ok {
+			set.QueryWarehouse = sdk.NewAccountObjectIdentifier(v.(string))
+			runSet = true
+		} else {
+			unset.QueryWarehouse = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("title") {
+		if v, ok := d.GetOk("title"); ok {
+			set.Title = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset.Title = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset.Comment = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if runSet {
+		if err := client.Streamlits.Alter(ctx, objectIdentifier, &sdk.StreamlitAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating streamlit %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+	if runUnset {
+		if err := client.Streamlits.Alter(ctx, objectIdentifier, &sdk.StreamlitAlterOptions{Unset: unset}); err != nil {
+			return fmt.Errorf("error updating streamlit %v err = %w", objectIdentifier.Name(), err)
+		}
+	}
+
+	return ReadStreamlit(d, meta)
+}
+
+// DeleteStreamlit implements schema.DeleteFunc.
+func DeleteStreamlit(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.Streamlits.Drop(ctx, objectIdentifier, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Streamlit(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: streamlitConfig(accName, "streamlit_app.py"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "main_file", "streamlit_app.py"),
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "query_warehouse", accName),
+					resource.TestCheckResourceAttrSet("snowflake_streamlit.test", "url_id"),
+				),
+			},
+			{
+				Config: streamlitConfig(accName, "app.py"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_streamlit.test", "main_file", "app.py"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_streamlit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func streamlitConfig(name string, mainFile string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name    = "%[1]v"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_stage" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+	schema   = snowflake_schema.test.name
+}
+
+resource "snowflake_warehouse" "test" {
+	name                = "%[1]v"
+	warehouse_size      = "XSMALL"
+	initially_suspended = true
+}
+
+resource "snowflake_streamlit" "test" {
+	name            = "%[1]v"
+	database        = snowflake_database.test.name
+	schema          = snowflake_schema.test.name
+	root_location   = "@${snowflake_database.test.name}.${snowflake_schema.test.name}.${snowflake_stage.test.name}"
+	main_file       = "%[2]v"
+	query_warehouse = snowflake_warehouse.test.name
+	comment         = "Terraform acceptance test"
+}
+`, name, mainFile)
+}
@@ -549,17 +549,18 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 	}
 	builder := snowflake.NewTableBuilder(tableID.TableName, tableID.DatabaseName, tableID.SchemaName)
 
-	row := snowflake.QueryRow(db, builder.Show())
-	table, err := snowflake.ScanTable(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	var tables []snowflake.Table
+	err = snowflake.QueryAll(db, &tables, builder.Show())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if len(tables) == 0 {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] table (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
-	if err != nil {
-		return err
-	}
+	table := &tables[0]
 
 	// Describe the table to read the cols
 	tableDescriptionRows, err := snowflake.Query(db, builder.ShowColumns())
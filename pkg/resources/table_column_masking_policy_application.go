@@ -58,7 +58,7 @@ func CreateTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta inte
 	stmt := manager.Create(input)
 
 	db := meta.(*sql.DB)
-	_, err := db.Exec(stmt)
+	err := snowflake.Exec(db, stmt)
 	if err != nil {
 		return fmt.Errorf("error applying masking policy: %w", err)
 	}
@@ -121,7 +121,7 @@ func DeleteTableColumnMaskingPolicyApplication(d *schema.ResourceData, meta inte
 	stmt := manager.Delete(input)
 
 	db := meta.(*sql.DB)
-	_, err := db.Exec(stmt)
+	err := snowflake.Exec(db, stmt)
 	if err != nil {
 		return fmt.Errorf("error executing drop statement: %w", err)
 	}
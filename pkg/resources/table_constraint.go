@@ -269,11 +269,9 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 	stmt := builder.Create()
 	log.Printf("[DEBUG] create table constraint statement: %v\n", stmt)
-	result, err := db.Exec(stmt)
-	if err != nil {
+	if err := snowflake.Exec(db, stmt); err != nil {
 		return fmt.Errorf("error creating table constraint %v err = %w", name, err)
 	}
-	log.Printf("[DEBUG] result: %v\n", result)
 
 	tc := tableConstraintID{
 		name,
@@ -317,7 +315,7 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 	/* "unsupported feature comment error message"
 	if d.HasChange("comment") {
 		_, new := d.GetChange("comment")
-		_, err := db.Exec(builder.SetComment(new.(string)))
+		err := snowflake.Exec(db, builder.SetComment(new.(string)))
 		if err != nil {
 			return fmt.Errorf("error setting comment for table constraint %v", tc.name)
 		}
@@ -325,7 +323,7 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("name") {
 		_, n := d.GetChange("name")
-		_, err := db.Exec(builder.Rename(n.(string)))
+		err := snowflake.Exec(db, builder.Rename(n.(string)))
 		if err != nil {
 			return fmt.Errorf("error renaming table constraint %v err = %w", tc.name, err)
 		}
@@ -349,7 +347,7 @@ func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
 	builder.WithColumns(columns)
 
 	stmt := builder.Drop()
-	_, err := db.Exec(stmt)
+	err := snowflake.Exec(db, stmt)
 	if err != nil {
 		// if the table constraint does not exist, then remove from state file
 		if strings.Contains(err.Error(), "does not exist") {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"golang.org/x/exp/slices"
@@ -21,6 +22,23 @@ const (
 	taskIDDelimiter = '|'
 )
 
+var taskWarehouseSizes = []string{
+	"XSMALL", "X-SMALL", "SMALL", "MEDIUM", "LARGE", "XLARGE", "X-LARGE", "XXLARGE", "X2LARGE", "2X-LARGE",
+}
+
+// taskExecutionAttributes are the attributes that change what a task runs, so that the task is executed again when
+// execute_on_change is set.
+var taskExecutionAttributes = []string{
+	"sql_statement",
+	"when",
+	"after",
+	"config",
+	"warehouse",
+	"user_task_managed_initial_warehouse_size",
+	"serverless_task_min_statement_size",
+	"serverless_task_max_statement_size",
+}
+
 var taskSchema = map[string]*schema.Schema{
 	"enabled": {
 		Type:        schema.TypeBool,
@@ -96,14 +114,71 @@ var taskSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
 	"user_task_managed_initial_warehouse_size": {
-		Type:     schema.TypeString,
-		Optional: true,
-		ValidateFunc: validation.StringInSlice([]string{
-			"XSMALL", "X-SMALL", "SMALL", "MEDIUM", "LARGE", "XLARGE", "X-LARGE", "XXLARGE", "X2LARGE", "2X-LARGE",
-		}, true),
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.StringInSlice(taskWarehouseSizes, true),
 		Description:   "Specifies the size of the compute resources to provision for the first run of the task, before a task history is available for Snowflake to determine an ideal size. Once a task has successfully completed a few runs, Snowflake ignores this parameter setting. (Conflicts with warehouse)",
 		ConflictsWith: []string{"warehouse"},
 	},
+	"serverless_task_min_statement_size": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.StringInSlice(taskWarehouseSizes, true),
+		Description:   "Specifies the minimum allowed size of the compute resources Snowflake provisions for runs of the serverless task. (Conflicts with warehouse)",
+		ConflictsWith: []string{"warehouse"},
+	},
+	"serverless_task_max_statement_size": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.StringInSlice(taskWarehouseSizes, true),
+		Description:   "Specifies the maximum allowed size of the compute resources Snowflake provisions for runs of the serverless task. (Conflicts with warehouse)",
+		ConflictsWith: []string{"warehouse"},
+	},
+	"suspend_task_after_num_failures": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of consecutive failed task runs after which the current task is suspended automatically. The default is 0 (no automatic suspension).",
+	},
+	"task_auto_retry_attempts": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, 30),
+		Description:  "Specifies the number of automatic task graph retry attempts. Only applies to root tasks.",
+	},
+	"config": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		Description:      "Specifies a JSON string of key-value pairs that can be accessed by all tasks in the task graph. Only applies to root tasks.",
+	},
+	"execute_on_change": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies if the task should be executed once (EXECUTE TASK) after it is created and whenever what it runs changes: its sql_statement, when condition, predecessors (after), config, or the warehouse or serverless compute settings. This can be used, for example, to backfill data. Only root and standalone tasks can be executed.",
+	},
+	"last_run_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the most recent run of the task in the task history, e.g. SUCCEEDED, FAILED or EXECUTING.",
+	},
+	"last_run_scheduled_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the most recent run of the task was scheduled to start.",
+	},
+	"last_run_completed_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the most recent run of the task completed.",
+	},
+	"last_run_error_message": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Error message of the most recent run of the task, if it failed.",
+	},
 	"error_integration": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -289,6 +364,19 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// SHOW TASKS returns the string "null" for tasks without a configuration
+	config := ""
+	if t.Config.Valid && t.Config.String != "null" {
+		config = t.Config.String
+	}
+	if err := d.Set("config", config); err != nil {
+		return err
+	}
+
+	if err := setTaskLastRun(d, db, builder); err != nil {
+		return err
+	}
+
 	q = builder.ShowParameters()
 	paramRows, err := snowflake.Query(db, q)
 	if err != nil {
@@ -303,6 +391,10 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		sessionParameters := map[string]interface{}{}
 		fieldParameters := map[string]interface{}{
 			"user_task_managed_initial_warehouse_size": "",
+			"serverless_task_min_statement_size":       "",
+			"serverless_task_max_statement_size":       "",
+			"suspend_task_after_num_failures":          0,
+			"task_auto_retry_attempts":                 0,
 		}
 
 		for _, param := range params {
@@ -321,6 +413,24 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				fieldParameters["user_task_timeout_ms"] = timeout
+			case "SERVERLESS_TASK_MIN_STATEMENT_SIZE":
+				fieldParameters["serverless_task_min_statement_size"] = param.Value
+			case "SERVERLESS_TASK_MAX_STATEMENT_SIZE":
+				fieldParameters["serverless_task_max_statement_size"] = param.Value
+			case "SUSPEND_TASK_AFTER_NUM_FAILURES":
+				failures, err := strconv.Atoi(param.Value)
+				if err != nil {
+					return err
+				}
+
+				fieldParameters["suspend_task_after_num_failures"] = failures
+			case "TASK_AUTO_RETRY_ATTEMPTS":
+				attempts, err := strconv.Atoi(param.Value)
+				if err != nil {
+					return err
+				}
+
+				fieldParameters["task_auto_retry_attempts"] = attempts
 			default:
 				sessionParameters[param.Key] = param.Value
 			}
@@ -364,10 +474,30 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		builder.WithInitialWarehouseSize(v.(string))
 	}
 
+	if v, ok := d.GetOk("serverless_task_min_statement_size"); ok {
+		builder.WithServerlessTaskMinStatementSize(v.(string))
+	}
+
+	if v, ok := d.GetOk("serverless_task_max_statement_size"); ok {
+		builder.WithServerlessTaskMaxStatementSize(v.(string))
+	}
+
 	if v, ok := d.GetOk("schedule"); ok {
 		builder.WithSchedule(v.(string))
 	}
 
+	if v, ok := d.GetOk("suspend_task_after_num_failures"); ok {
+		builder.WithSuspendTaskAfterNumFailures(v.(int))
+	}
+
+	if v, ok := d.GetOk("task_auto_retry_attempts"); ok {
+		builder.WithTaskAutoRetryAttempts(v.(int))
+	}
+
+	if v, ok := d.GetOk("config"); ok {
+		builder.WithConfig(v.(string))
+	}
+
 	if v, ok := d.GetOk("session_parameters"); ok {
 		builder.WithSessionParameters(v.(map[string]interface{}))
 	}
@@ -440,6 +570,12 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("execute_on_change").(bool) {
+		if err := snowflake.Exec(db, builder.Execute()); err != nil {
+			return fmt.Errorf("error executing task %v err = %w", name, err)
+		}
+	}
+
 	return ReadTask(d, meta)
 }
 
@@ -450,6 +586,31 @@ func resumeTask(db *sql.DB, rootTask *snowflake.Task) {
 	}
 }
 
+// setTaskLastRun reads the most recent run of the task. TASK_HISTORY needs an active warehouse and the privileges to
+// see the history of the task, so failing to read it does not fail the read of the task and leaves the last run empty.
+func setTaskLastRun(d *schema.ResourceData, db *sql.DB, builder *snowflake.TaskBuilder) error {
+	lastRun, err := snowflake.ScanTaskRun(snowflake.QueryRow(db, builder.LastRun()))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		lastRun = &snowflake.TaskRun{}
+	case err != nil:
+		log.Printf("[WARN] could not read last run of task %v: %v", d.Id(), err)
+		lastRun = &snowflake.TaskRun{}
+	}
+	values := map[string]interface{}{
+		"last_run_state":          lastRun.State,
+		"last_run_scheduled_time": lastRun.ScheduledTime.String,
+		"last_run_completed_time": lastRun.CompletedTime.String,
+		"last_run_error_message":  lastRun.ErrorMessage.String,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdateTask implements schema.UpdateFunc.
 func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 	taskID, err := taskIDFromString(d.Id())
@@ -509,6 +670,66 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("serverless_task_min_statement_size") {
+		var q string
+		if size, ok := d.GetOk("serverless_task_min_statement_size"); ok {
+			q = builder.ChangeServerlessTaskMinStatementSize(size.(string))
+		} else {
+			q = builder.RemoveServerlessTaskMinStatementSize()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating serverless_task_min_statement_size on task %v", d.Id())
+		}
+	}
+
+	if d.HasChange("serverless_task_max_statement_size") {
+		var q string
+		if size, ok := d.GetOk("serverless_task_max_statement_size"); ok {
+			q = builder.ChangeServerlessTaskMaxStatementSize(size.(string))
+		} else {
+			q = builder.RemoveServerlessTaskMaxStatementSize()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating serverless_task_max_statement_size on task %v", d.Id())
+		}
+	}
+
+	if d.HasChange("suspend_task_after_num_failures") {
+		var q string
+		if failures, ok := d.GetOk("suspend_task_after_num_failures"); ok {
+			q = builder.ChangeSuspendTaskAfterNumFailures(failures.(int))
+		} else {
+			q = builder.RemoveSuspendTaskAfterNumFailures()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating suspend_task_after_num_failures on task %v", d.Id())
+		}
+	}
+
+	if d.HasChange("task_auto_retry_attempts") {
+		var q string
+		if attempts, ok := d.GetOk("task_auto_retry_attempts"); ok {
+			q = builder.ChangeTaskAutoRetryAttempts(attempts.(int))
+		} else {
+			q = builder.RemoveTaskAutoRetryAttempts()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating task_auto_retry_attempts on task %v", d.Id())
+		}
+	}
+
+	if d.HasChange("config") {
+		var q string
+		if config, ok := d.GetOk("config"); ok {
+			q = builder.ChangeConfig(config.(string))
+		} else {
+			q = builder.RemoveConfig()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating config on task %v", d.Id())
+		}
+	}
+
 	if d.HasChange("error_integration") {
 		var q string
 		if errorIntegration, ok := d.GetOk("error_integration"); ok {
@@ -710,6 +931,12 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating task state %v", d.Id())
 		}
 	}
+
+	if d.Get("execute_on_change").(bool) && d.HasChanges(taskExecutionAttributes...) {
+		if err := snowflake.Exec(db, builder.Execute()); err != nil {
+			return fmt.Errorf("error executing task %v err = %w", d.Id(), err)
+		}
+	}
 	return ReadTask(d, meta)
 }
 
@@ -681,6 +681,65 @@ resource "snowflake_task" "test_task" {
 	return fmt.Sprintf(s, name, name, taskRootName, name)
 }
 
+func TestAcc_Task_ExecutionControls(t *testing.T) {
+	accName := "tst-terraform-" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskConfigExecutionControls(accName, 3, 1, "SELECT 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "suspend_task_after_num_failures", "3"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "task_auto_retry_attempts", "1"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "serverless_task_min_statement_size", "XSMALL"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "serverless_task_max_statement_size", "SMALL"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "config", `{"output_dir":"/temp/"}`),
+				),
+			},
+			{
+				Config: taskConfigExecutionControls(accName, 5, 0, "SELECT 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "suspend_task_after_num_failures", "5"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "task_auto_retry_attempts", "0"),
+					resource.TestCheckResourceAttr("snowflake_task.test_task", "sql_statement", "SELECT 2"),
+				),
+			},
+		},
+	})
+}
+
+func taskConfigExecutionControls(name string, failures int, retries int, statement string) string {
+	s := `
+resource "snowflake_database" "test_database" {
+	name    = "%[1]s"
+	comment = "Terraform acceptance test"
+}
+
+resource "snowflake_schema" "test_schema" {
+	name     = "%[1]s"
+	database = snowflake_database.test_database.name
+	comment  = "Terraform acceptance test"
+}
+
+resource "snowflake_task" "test_task" {
+	name                               = "%[1]s"
+	database                           = snowflake_database.test_database.name
+	schema                             = snowflake_schema.test_schema.name
+	sql_statement                      = "%[4]s"
+	schedule                           = "60 MINUTE"
+	suspend_task_after_num_failures    = %[2]d
+	task_auto_retry_attempts           = %[3]d
+	serverless_task_min_statement_size = "XSMALL"
+	serverless_task_max_statement_size = "SMALL"
+	config                             = jsonencode({ output_dir = "/temp/" })
+	execute_on_change                  = true
+}
+`
+	return fmt.Sprintf(s, name, failures, retries, statement)
+}
+
 func checkInt64(name, key string, value int64) func(*terraform.State) error {
 	return func(state *terraform.State) error {
 		return resource.TestCheckResourceAttr(name, key, fmt.Sprintf("%v", value))(state)
@@ -0,0 +1,778 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+var taskGraphSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the tasks of the graph.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the tasks of the graph.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies if the task graph should be started (enabled) or should remain suspended (default). Enabling the graph resumes the root task and all of its dependents.",
+	},
+	"task": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Set:         taskGraphTaskHash,
+		Description: "The tasks of the graph, keyed by their name. Exactly one task (the root) must have no predecessors, and the dependencies between the tasks must not contain cycles.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier for the task; must be unique for the database and schema in which the task is created.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the task runs.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"after": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Description: "Specifies the names of the predecessor tasks within the graph. Must be empty for the root task only.",
+				},
+				"schedule": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The schedule for periodically running the graph. This can be a cron or interval in minutes. Only allowed on the root task.",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse the task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.",
+				},
+				"when": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a Boolean SQL expression; multiple conditions joined with AND/OR are supported.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the task.",
+				},
+			},
+		},
+	},
+	"finalizer": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies a finalizer task that runs after all the other tasks of a graph run complete, whether they succeeded or not.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier for the finalizer task.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the finalizer task runs.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse the finalizer task will use. Omit this parameter to use Snowflake-managed compute resources.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the finalizer task.",
+				},
+			},
+		},
+	},
+	"root": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the root task of the graph.",
+	},
+}
+
+// TaskGraph returns a pointer to the resource representing a graph of tasks.
+func TaskGraph() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a task graph (DAG): a root task, its dependent tasks and an optional finalizer task. All the changes to the graph are applied while the root task is suspended once. Changes are not transactional: when an apply fails, the tasks changed before the failure keep their new definition, the previous enabled state of the graph is restored and the state is refreshed from Snowflake, so the next plan shows the remaining changes.",
+		Create:      CreateTaskGraph,
+		Read:        ReadTaskGraph,
+		Update:      UpdateTaskGraph,
+		Delete:      DeleteTaskGraph,
+
+		CustomizeDiff: customizeTaskGraphDiff,
+
+		Schema: taskGraphSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type taskGraphTask struct {
+	Name         string
+	SQLStatement string
+	After        []string
+	Schedule     string
+	Warehouse    string
+	When         string
+	Comment      string
+}
+
+// taskGraphTaskHash identifies the tasks of a graph by their name, so that changing a task is planned as an in-place
+// change of that task rather than as the removal and the addition of a task.
+func taskGraphTaskHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	name, _ := m["name"].(string)
+	return schema.HashString(name)
+}
+
+// taskGraph holds the tasks of a graph ordered by name.
+type taskGraph struct {
+	tasks []*taskGraphTask
+}
+
+func expandTaskGraph(v interface{}) *taskGraph {
+	g := &taskGraph{}
+	for _, raw := range v.(*schema.Set).List() {
+		// blocks with unknown values during planning are read as nil
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		g.tasks = append(g.tasks, &taskGraphTask{
+			Name:         m["name"].(string),
+			SQLStatement: m["sql_statement"].(string),
+			After:        expandStringList(m["after"].(*schema.Set).List()),
+			Schedule:     m["schedule"].(string),
+			Warehouse:    m["warehouse"].(string),
+			When:         m["when"].(string),
+			Comment:      m["comment"].(string),
+		})
+	}
+	sort.Slice(g.tasks, func(i, j int) bool { return g.tasks[i].Name < g.tasks[j].Name })
+	return g
+}
+
+// duplicateTaskGraphTask returns the first task name declared more than once in the configuration. Such tasks share
+// their key in the task set and cannot be told apart once the configuration is read into it.
+func duplicateTaskGraphTask(config cty.Value) string {
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("task") {
+		return ""
+	}
+	tasks := config.GetAttr("task")
+	if tasks.IsNull() || !tasks.IsKnown() {
+		return ""
+	}
+	names := make(map[string]bool)
+	for it := tasks.ElementIterator(); it.Next(); {
+		_, task := it.Element()
+		if task.IsNull() || !task.IsKnown() {
+			continue
+		}
+		name := task.GetAttr("name")
+		if name.IsNull() || !name.IsKnown() {
+			continue
+		}
+		if names[name.AsString()] {
+			return name.AsString()
+		}
+		names[name.AsString()] = true
+	}
+	return ""
+}
+
+func (g *taskGraph) get(name string) *taskGraphTask {
+	for _, t := range g.tasks {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// roots returns the names of the tasks without predecessors.
+func (g *taskGraph) roots() []string {
+	var roots []string
+	for _, t := range g.tasks {
+		if len(t.After) == 0 {
+			roots = append(roots, t.Name)
+		}
+	}
+	return roots
+}
+
+// root returns the name of the root task, or an empty string if the graph does not have a single root.
+func (g *taskGraph) root() string {
+	if roots := g.roots(); len(roots) == 1 {
+		return roots[0]
+	}
+	return ""
+}
+
+// topologicalOrder returns the task names ordered so that every task comes after all of its predecessors.
+func (g *taskGraph) topologicalOrder() ([]string, error) {
+	remaining := make(map[string]int, len(g.tasks))
+	for _, t := range g.tasks {
+		remaining[t.Name] = len(t.After)
+	}
+	order := make([]string, 0, len(g.tasks))
+	for len(order) < len(g.tasks) {
+		progressed := false
+		for _, t := range g.tasks {
+			if remaining[t.Name] != 0 || slices.Contains(order, t.Name) {
+				continue
+			}
+			order = append(order, t.Name)
+			progressed = true
+			for _, dependent := range g.tasks {
+				if slices.Contains(dependent.After, t.Name) {
+					remaining[dependent.Name]--
+				}
+			}
+		}
+		if !progressed {
+			var cyclic []string
+			for _, t := range g.tasks {
+				if !slices.Contains(order, t.Name) {
+					cyclic = append(cyclic, t.Name)
+				}
+			}
+			return nil, fmt.Errorf("task graph contains a cycle between tasks %v", cyclic)
+		}
+	}
+	return order, nil
+}
+
+// validate checks that the graph has unique task names, known predecessors, a single scheduled root and no cycles.
+func (g *taskGraph) validate() error {
+	names := make(map[string]bool, len(g.tasks))
+	for _, t := range g.tasks {
+		if names[t.Name] {
+			return fmt.Errorf("task %v is declared more than once in the task graph", t.Name)
+		}
+		names[t.Name] = true
+	}
+	for _, t := range g.tasks {
+		for _, predecessor := range t.After {
+			if !names[predecessor] {
+				return fmt.Errorf("task %v runs after %v, which is not a task of the task graph", t.Name, predecessor)
+			}
+		}
+	}
+	if _, err := g.topologicalOrder(); err != nil {
+		return err
+	}
+	roots := g.roots()
+	if len(roots) != 1 {
+		return fmt.Errorf("task graph must have a single root task without predecessors, found %v", roots)
+	}
+	for _, t := range g.tasks {
+		if t.Schedule != "" && t.Name != roots[0] {
+			return fmt.Errorf("task %v has a schedule, but only the root task %v of the task graph can be scheduled", t.Name, roots[0])
+		}
+	}
+	return nil
+}
+
+type taskGraphFinalizer struct {
+	Name         string
+	SQLStatement string
+	Warehouse    string
+	Comment      string
+}
+
+func expandTaskGraphFinalizer(v interface{}) *taskGraphFinalizer {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	return &taskGraphFinalizer{
+		Name:         m["name"].(string),
+		SQLStatement: m["sql_statement"].(string),
+		Warehouse:    m["warehouse"].(string),
+		Comment:      m["comment"].(string),
+	}
+}
+
+func customizeTaskGraphDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if name := duplicateTaskGraphTask(d.GetRawConfig()); name != "" {
+		return fmt.Errorf("task %v is declared more than once in the task graph", name)
+	}
+	if !d.NewValueKnown("task") || !d.NewValueKnown("finalizer") {
+		return nil
+	}
+	graph := expandTaskGraph(d.Get("task"))
+	if err := graph.validate(); err != nil {
+		return err
+	}
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil && graph.get(finalizer.Name) != nil {
+		return fmt.Errorf("finalizer task %v cannot also be a task of the task graph", finalizer.Name)
+	}
+
+	if d.Id() != "" && d.HasChange("task") {
+		o, _ := d.GetChange("task")
+		// a graph with a different root task is a different graph
+		if expandTaskGraph(o).root() != graph.root() {
+			if err := d.ForceNew("task"); err != nil {
+				return err
+			}
+		}
+	}
+	return d.SetNew("root", graph.root())
+}
+
+func taskGraphTaskBuilder(database string, schema string, t *taskGraphTask) *snowflake.TaskBuilder {
+	builder := snowflake.NewTaskBuilder(t.Name, database, schema)
+	builder.WithStatement(t.SQLStatement)
+	if t.Warehouse != "" {
+		builder.WithWarehouse(t.Warehouse)
+	}
+	if t.Schedule != "" {
+		builder.WithSchedule(t.Schedule)
+	}
+	if t.Comment != "" {
+		builder.WithComment(t.Comment)
+	}
+	if len(t.After) > 0 {
+		builder.WithAfter(t.After)
+	}
+	if t.When != "" {
+		builder.WithCondition(t.When)
+	}
+	return builder
+}
+
+func taskGraphFinalizerBuilder(database string, schema string, root string, f *taskGraphFinalizer) *snowflake.TaskBuilder {
+	builder := snowflake.NewTaskBuilder(f.Name, database, schema)
+	builder.WithStatement(f.SQLStatement)
+	if f.Warehouse != "" {
+		builder.WithWarehouse(f.Warehouse)
+	}
+	if f.Comment != "" {
+		builder.WithComment(f.Comment)
+	}
+	builder.WithFinalize(root)
+	return builder
+}
+
+// CreateTaskGraph implements schema.CreateFunc.
+func CreateTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+
+	graph := expandTaskGraph(d.Get("task"))
+	if err := graph.validate(); err != nil {
+		return err
+	}
+	order, err := graph.topologicalOrder()
+	if err != nil {
+		return err
+	}
+	root := graph.root()
+
+	// tasks are created suspended, so the graph only starts once all of them exist
+	for _, name := range order {
+		q := taskGraphTaskBuilder(database, schema, graph.get(name)).Create()
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error creating task %v of task graph %v err = %w", name, root, err)
+		}
+		if name == root {
+			taskID := &taskID{
+				DatabaseName: database,
+				SchemaName:   schema,
+				TaskName:     root,
+			}
+			dataIDInput, err := taskID.String()
+			if err != nil {
+				return err
+			}
+			d.SetId(dataIDInput)
+		}
+	}
+
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil {
+		q := taskGraphFinalizerBuilder(database, schema, root, finalizer).Create()
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error creating finalizer task %v of task graph %v err = %w", finalizer.Name, root, err)
+		}
+	}
+
+	if d.Get("enabled").(bool) {
+		q := snowflake.NewTaskBuilder(root, database, schema).EnableDependents()
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error enabling task graph %v err = %w", root, err)
+		}
+	}
+
+	return ReadTaskGraph(d, meta)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ReadTaskGraph implements schema.ReadFunc.
+func ReadTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	taskID, err := taskIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	database := taskID.DatabaseName
+	schema := taskID.SchemaName
+	root := taskID.TaskName
+
+	tasks, err := snowflake.ListTasks(database, schema, db)
+	if err != nil {
+		return err
+	}
+	tasksByName := make(map[string]snowflake.Task, len(tasks))
+	dependents := make(map[string][]string)
+	predecessors := make(map[string][]string)
+	for _, t := range tasks {
+		tasksByName[t.Name] = t
+		p, err := t.GetPredecessors()
+		if err != nil {
+			return err
+		}
+		predecessors[t.Name] = p
+		for _, predecessor := range p {
+			dependents[predecessor] = append(dependents[predecessor], t.Name)
+		}
+	}
+
+	rootTask, ok := tasksByName[root]
+	if !ok {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] root task (%s) of task graph not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// collect every task reachable from the root
+	found := []string{root}
+	for i := 0; i < len(found); i++ {
+		next := dependents[found[i]]
+		sort.Strings(next)
+		for _, dependent := range next {
+			if !slices.Contains(found, dependent) {
+				found = append(found, dependent)
+			}
+		}
+	}
+
+	flattened := make([]interface{}, 0, len(found))
+	for _, name := range found {
+		t := tasksByName[name]
+		flattened = append(flattened, map[string]interface{}{
+			"name":          t.Name,
+			"sql_statement": t.Definition,
+			"after":         predecessors[name],
+			"schedule":      stringValue(t.Schedule),
+			"warehouse":     stringValue(t.Warehouse),
+			"when":          stringValue(t.Condition),
+			"comment":       stringValue(t.Comment),
+		})
+	}
+
+	var flattenedFinalizer []interface{}
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil {
+		if t, ok := tasksByName[finalizer.Name]; ok {
+			flattenedFinalizer = append(flattenedFinalizer, map[string]interface{}{
+				"name":          t.Name,
+				"sql_statement": t.Definition,
+				"warehouse":     stringValue(t.Warehouse),
+				"comment":       stringValue(t.Comment),
+			})
+		}
+	}
+
+	if err := d.Set("database", database); err != nil {
+		return err
+	}
+	if err := d.Set("schema", schema); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", rootTask.IsEnabled()); err != nil {
+		return err
+	}
+	if err := d.Set("task", flattened); err != nil {
+		return err
+	}
+	if err := d.Set("finalizer", flattenedFinalizer); err != nil {
+		return err
+	}
+	if err := d.Set("root", root); err != nil {
+		return err
+	}
+	return nil
+}
+
+func updateTaskGraphTask(db *sql.DB, builder *snowflake.TaskBuilder, o *taskGraphTask, n *taskGraphTask) error {
+	var statements []string
+	if o.Warehouse != n.Warehouse {
+		if n.Warehouse == "" {
+			statements = append(statements, builder.SwitchWarehouseToManaged())
+		} else {
+			statements = append(statements, builder.ChangeWarehouse(n.Warehouse))
+		}
+	}
+	if o.Schedule != n.Schedule {
+		if n.Schedule == "" {
+			statements = append(statements, builder.RemoveSchedule())
+		} else {
+			statements = append(statements, builder.ChangeSchedule(n.Schedule))
+		}
+	}
+	if o.Comment != n.Comment {
+		if n.Comment == "" {
+			statements = append(statements, builder.RemoveComment())
+		} else {
+			statements = append(statements, builder.ChangeComment(n.Comment))
+		}
+	}
+	var toRemove, toAdd []string
+	for _, predecessor := range o.After {
+		if !slices.Contains(n.After, predecessor) {
+			toRemove = append(toRemove, predecessor)
+		}
+	}
+	for _, predecessor := range n.After {
+		if !slices.Contains(o.After, predecessor) {
+			toAdd = append(toAdd, predecessor)
+		}
+	}
+	if len(toRemove) > 0 {
+		statements = append(statements, builder.RemoveAfter(toRemove))
+	}
+	if len(toAdd) > 0 {
+		statements = append(statements, builder.AddAfter(toAdd))
+	}
+	if o.When != n.When {
+		if n.When == "" {
+			statements = append(statements, builder.RemoveCondition())
+		} else {
+			statements = append(statements, builder.ChangeCondition(n.When))
+		}
+	}
+	if o.SQLStatement != n.SQLStatement {
+		statements = append(statements, builder.ChangeSQLStatement(n.SQLStatement))
+	}
+
+	for _, q := range statements {
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating task %v err = %w", builder.Name(), err)
+		}
+	}
+	return nil
+}
+
+// applyTaskGraphChanges creates, alters and drops the tasks of the graph. The root task must be suspended.
+func applyTaskGraphChanges(d *schema.ResourceData, db *sql.DB, database string, schema string, root string) error {
+	o, n := d.GetChange("task")
+	oldGraph, newGraph := expandTaskGraph(o), expandTaskGraph(n)
+
+	// predecessors are created or altered before their dependents
+	order, err := newGraph.topologicalOrder()
+	if err != nil {
+		return err
+	}
+	for _, name := range order {
+		newTask := newGraph.get(name)
+		builder := taskGraphTaskBuilder(database, schema, newTask)
+		oldTask := oldGraph.get(name)
+		if oldTask == nil {
+			if err := snowflake.Exec(db, builder.Create()); err != nil {
+				return fmt.Errorf("error creating task %v err = %w", name, err)
+			}
+			continue
+		}
+		if err := updateTaskGraphTask(db, builder, oldTask, newTask); err != nil {
+			return err
+		}
+	}
+
+	// removed tasks are dropped after their dependents
+	oldOrder, err := oldGraph.topologicalOrder()
+	if err != nil {
+		return err
+	}
+	for i := len(oldOrder) - 1; i >= 0; i-- {
+		name := oldOrder[i]
+		if newGraph.get(name) != nil {
+			continue
+		}
+		if err := snowflake.Exec(db, snowflake.NewTaskBuilder(name, database, schema).Drop()); err != nil {
+			return fmt.Errorf("error dropping task %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("finalizer") {
+		o, n := d.GetChange("finalizer")
+		oldFinalizer, newFinalizer := expandTaskGraphFinalizer(o), expandTaskGraphFinalizer(n)
+		if oldFinalizer != nil && (newFinalizer == nil || oldFinalizer.Name != newFinalizer.Name) {
+			if err := snowflake.Exec(db, snowflake.NewTaskBuilder(oldFinalizer.Name, database, schema).Drop()); err != nil {
+				return fmt.Errorf("error dropping finalizer task %v err = %w", oldFinalizer.Name, err)
+			}
+			oldFinalizer = nil
+		}
+		if newFinalizer != nil {
+			builder := taskGraphFinalizerBuilder(database, schema, root, newFinalizer)
+			if oldFinalizer == nil {
+				if err := snowflake.Exec(db, builder.Create()); err != nil {
+					return fmt.Errorf("error creating finalizer task %v err = %w", newFinalizer.Name, err)
+				}
+			} else {
+				o := &taskGraphTask{Name: oldFinalizer.Name, SQLStatement: oldFinalizer.SQLStatement, Warehouse: oldFinalizer.Warehouse, Comment: oldFinalizer.Comment}
+				n := &taskGraphTask{Name: newFinalizer.Name, SQLStatement: newFinalizer.SQLStatement, Warehouse: newFinalizer.Warehouse, Comment: newFinalizer.Comment}
+				if err := updateTaskGraphTask(db, builder, o, n); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateTaskGraph implements schema.UpdateFunc.
+func UpdateTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	taskID, err := taskIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	database := taskID.DatabaseName
+	schema := taskID.SchemaName
+	root := taskID.TaskName
+	builder := snowflake.NewTaskBuilder(root, database, schema)
+
+	rootTask, err := snowflake.ScanTask(snowflake.QueryRow(db, builder.Show()))
+	if err != nil {
+		return err
+	}
+	wasEnabled := rootTask.IsEnabled()
+
+	if d.HasChanges("task", "finalizer") {
+		// the tasks of a graph can only be modified while its root task is suspended
+		if wasEnabled {
+			if err := snowflake.Exec(db, builder.Suspend()); err != nil {
+				return fmt.Errorf("error suspending task graph %v err = %w", root, err)
+			}
+		}
+		if err := applyTaskGraphChanges(d, db, database, schema, root); err != nil {
+			if wasEnabled {
+				if rerr := snowflake.Exec(db, builder.EnableDependents()); rerr != nil {
+					log.Printf("[WARN] failed to restore the enabled state of task graph %s: %v", root, rerr)
+				}
+			}
+			// the changes applied before the failure are kept, so the state is refreshed to plan the remaining ones
+			if rerr := ReadTaskGraph(d, meta); rerr != nil {
+				log.Printf("[WARN] failed to read task graph %s after a failed update: %v", root, rerr)
+			}
+			return err
+		}
+	}
+
+	if d.Get("enabled").(bool) {
+		if !wasEnabled || d.HasChanges("task", "finalizer") {
+			if err := snowflake.Exec(db, builder.EnableDependents()); err != nil {
+				return fmt.Errorf("error enabling task graph %v err = %w", root, err)
+			}
+		}
+	} else if wasEnabled {
+		if err := snowflake.Exec(db, builder.Suspend()); err != nil {
+			return fmt.Errorf("error suspending task graph %v err = %w", root, err)
+		}
+	}
+
+	return ReadTaskGraph(d, meta)
+}
+
+// DeleteTaskGraph implements schema.DeleteFunc.
+func DeleteTaskGraph(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	taskID, err := taskIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	database := taskID.DatabaseName
+	schema := taskID.SchemaName
+	root := taskID.TaskName
+
+	// only the tasks that still exist are dropped, since a failed create may have left the graph incomplete
+	tasks, err := snowflake.ListTasks(database, schema, db)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]snowflake.Task, len(tasks))
+	for _, t := range tasks {
+		existing[t.Name] = t
+	}
+
+	if rootTask, ok := existing[root]; ok && rootTask.IsEnabled() {
+		if err := snowflake.Exec(db, rootTask.Suspend()); err != nil {
+			return fmt.Errorf("error suspending task graph %v err = %w", root, err)
+		}
+	}
+
+	var names []string
+	if finalizer := expandTaskGraphFinalizer(d.Get("finalizer")); finalizer != nil {
+		names = append(names, finalizer.Name)
+	}
+	graph := expandTaskGraph(d.Get("task"))
+	order, err := graph.topologicalOrder()
+	if err != nil {
+		return err
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		names = append(names, order[i])
+	}
+
+	for _, name := range names {
+		if _, ok := existing[name]; !ok {
+			continue
+		}
+		if err := snowflake.Exec(db, snowflake.NewTaskBuilder(name, database, schema).Drop()); err != nil {
+			return fmt.Errorf("error deleting task %v of task graph %v err = %w", name, root, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,114 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_TaskGraph(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_TASK_TESTS"); ok {
+		t.Skip("Skipping TestAcc_TaskGraph")
+	}
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskGraphConfig(accName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "root", "ROOT"),
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "task.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_task_graph.test", "task.*", map[string]string{
+						"name":    "CHILD",
+						"after.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr("snowflake_task_graph.test", "task.*.after.*", "ROOT"),
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "finalizer.0.name", "FINALIZER"),
+				),
+			},
+			{
+				Config: taskGraphConfig(accName, true, `
+  task {
+    name          = "SECOND_CHILD"
+    warehouse     = snowflake_warehouse.test.name
+    after         = ["CHILD"]
+    sql_statement = "SELECT 3"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "task.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_task_graph.test", "task.*", map[string]string{
+						"name":    "SECOND_CHILD",
+						"after.#": "1",
+					}),
+				),
+			},
+			{
+				Config: taskGraphConfig(accName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_task_graph.test", "task.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_task_graph.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"finalizer"},
+			},
+		},
+	})
+}
+
+func taskGraphConfig(name string, enabled bool, extraTasks string) string {
+	s := `
+resource "snowflake_warehouse" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+}
+
+resource "snowflake_task_graph" "test" {
+  database = snowflake_database.test.name
+  schema   = snowflake_schema.test.name
+  enabled  = %[2]t
+
+  task {
+    name          = "ROOT"
+    warehouse     = snowflake_warehouse.test.name
+    schedule      = "60 MINUTE"
+    sql_statement = "SELECT 1"
+  }
+
+  task {
+    name          = "CHILD"
+    warehouse     = snowflake_warehouse.test.name
+    after         = ["ROOT"]
+    sql_statement = "SELECT 2"
+  }
+%[3]v
+  finalizer {
+    name          = "FINALIZER"
+    warehouse     = snowflake_warehouse.test.name
+    sql_statement = "SELECT 4"
+  }
+}
+`
+	return fmt.Sprintf(s, name, enabled, extraTasks)
+}
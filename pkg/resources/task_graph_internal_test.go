@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func testTaskGraph(tasks ...*taskGraphTask) *taskGraph {
+	return &taskGraph{tasks: tasks}
+}
+
+func TestTaskGraphValidate(t *testing.T) {
+	r := require.New(t)
+
+	g := testTaskGraph(
+		&taskGraphTask{Name: "root", Schedule: "5 MINUTE"},
+		&taskGraphTask{Name: "a", After: []string{"root"}},
+		&taskGraphTask{Name: "b", After: []string{"root"}},
+		&taskGraphTask{Name: "c", After: []string{"a", "b"}},
+	)
+	r.NoError(g.validate())
+	r.Equal("root", g.root())
+
+	g = testTaskGraph(
+		&taskGraphTask{Name: "root"},
+		&taskGraphTask{Name: "other"},
+	)
+	r.ErrorContains(g.validate(), "single root task")
+	r.Equal("", g.root())
+
+	g = testTaskGraph(
+		&taskGraphTask{Name: "root"},
+		&taskGraphTask{Name: "a", After: []string{"root", "b"}},
+		&taskGraphTask{Name: "b", After: []string{"a"}},
+	)
+	r.ErrorContains(g.validate(), "cycle between tasks [a b]")
+
+	g = testTaskGraph(
+		&taskGraphTask{Name: "root"},
+		&taskGraphTask{Name: "a", After: []string{"missing"}},
+	)
+	r.ErrorContains(g.validate(), "a runs after missing")
+
+	g = testTaskGraph(
+		&taskGraphTask{Name: "root"},
+		&taskGraphTask{Name: "root", After: []string{"root"}},
+	)
+	r.ErrorContains(g.validate(), "declared more than once")
+
+	g = testTaskGraph(
+		&taskGraphTask{Name: "root"},
+		&taskGraphTask{Name: "a", After: []string{"root"}, Schedule: "5 MINUTE"},
+	)
+	r.ErrorContains(g.validate(), "only the root task root")
+}
+
+func TestTaskGraphTopologicalOrder(t *testing.T) {
+	r := require.New(t)
+
+	g := testTaskGraph(
+		&taskGraphTask{Name: "c", After: []string{"a", "b"}},
+		&taskGraphTask{Name: "b", After: []string{"a"}},
+		&taskGraphTask{Name: "a", After: []string{"root"}},
+		&taskGraphTask{Name: "root"},
+	)
+	order, err := g.topologicalOrder()
+	r.NoError(err)
+	r.Equal([]string{"root", "a", "b", "c"}, order)
+}
+
+func TestDuplicateTaskGraphTask(t *testing.T) {
+	config := func(names ...string) cty.Value {
+		tasks := make([]cty.Value, 0, len(names))
+		for i, name := range names {
+			tasks = append(tasks, cty.ObjectVal(map[string]cty.Value{
+				"name":          cty.StringVal(name),
+				"sql_statement": cty.StringVal(fmt.Sprintf("SELECT %d", i)),
+			}))
+		}
+		return cty.ObjectVal(map[string]cty.Value{"task": cty.SetVal(tasks)})
+	}
+
+	require.Equal(t, "", duplicateTaskGraphTask(config("root", "a")))
+	require.Equal(t, "a", duplicateTaskGraphTask(config("root", "a", "a")))
+	require.Equal(t, "", duplicateTaskGraphTask(cty.NullVal(cty.DynamicPseudoType)))
+}
+
+func TestExpandTaskGraphFinalizer(t *testing.T) {
+	r := require.New(t)
+
+	r.Nil(expandTaskGraphFinalizer([]interface{}{}))
+
+	f := expandTaskGraphFinalizer([]interface{}{map[string]interface{}{
+		"name":          "cleanup",
+		"sql_statement": "SELECT 1",
+		"warehouse":     "",
+		"comment":       "done",
+	}})
+	r.Equal(&taskGraphFinalizer{Name: "cleanup", SQLStatement: "SELECT 1", Comment: "done"}, f)
+}
+
+func TestUpdateTaskGraphPartialFailure(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	res := TaskGraph()
+	d := res.Data(nil)
+	d.SetId("test_db|test_schema|ROOT")
+	r.NoError(d.Set("database", "test_db"))
+	r.NoError(d.Set("schema", "test_schema"))
+	r.NoError(d.Set("root", "ROOT"))
+	r.NoError(d.Set("task", []interface{}{
+		map[string]interface{}{"name": "ROOT", "sql_statement": "SELECT 0"},
+		map[string]interface{}{"name": "CHILD", "sql_statement": "SELECT 1", "after": []interface{}{"ROOT"}},
+	}))
+	state := d.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"task": []interface{}{
+			map[string]interface{}{"name": "ROOT", "sql_statement": "SELECT 0"},
+			map[string]interface{}{"name": "CHILD", "sql_statement": "SELECT 2", "after": []interface{}{"ROOT"}},
+			map[string]interface{}{"name": "NEW", "sql_statement": "SELECT 3", "after": []interface{}{"ROOT"}},
+		},
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+
+	columns := []string{"name", "database_name", "schema_name", "state", "definition", "predecessors"}
+	mock.ExpectQuery(`^SHOW TASKS LIKE 'ROOT' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(
+		sqlmock.NewRows(columns).AddRow("ROOT", "test_db", "test_schema", "suspended", "SELECT 0", "[]"),
+	)
+	mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."CHILD" MODIFY AS SELECT 2$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^CREATE TASK "test_db"."test_schema"."NEW"`).WillReturnError(sqlmock.ErrCancelled)
+	mock.ExpectQuery(`^SHOW TASKS IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(
+		sqlmock.NewRows(columns).
+			AddRow("ROOT", "test_db", "test_schema", "suspended", "SELECT 0", "[]").
+			AddRow("CHILD", "test_db", "test_schema", "suspended", "SELECT 2", `["\"test_db\".\"test_schema\".\"ROOT\""]`),
+	)
+
+	newState, diags := res.Apply(context.Background(), state, diff, db)
+	r.True(diags.HasError())
+	r.NoError(mock.ExpectationsWereMet())
+
+	// the state holds the change applied before the failure and not the task that could not be created
+	graph := expandTaskGraph(res.Data(newState).Get("task"))
+	r.Len(graph.tasks, 2)
+	r.Equal("SELECT 2", graph.get("CHILD").SQLStatement)
+	r.Nil(graph.get("NEW"))
+}
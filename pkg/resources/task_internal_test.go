@@ -1,9 +1,16 @@
 package resources
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +52,138 @@ func TestTaskIDFromString(t *testing.T) {
 	_, err = taskIDFromString(id)
 	r.Equal(fmt.Errorf("1 line per task"), err)
 }
+
+func TestSetTaskLastRun(t *testing.T) {
+	builder := snowflake.NewTaskBuilder("test_task", "test_db", "test_schema")
+	state := &terraform.InstanceState{
+		ID: "test_db|test_schema|test_task",
+		Attributes: map[string]string{
+			"last_run_state":         "FAILED",
+			"last_run_error_message": "previous error",
+		},
+	}
+
+	t.Run("last run", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"state", "scheduled_time", "completed_time", "error_message"}).
+			AddRow("SUCCEEDED", "2023-10-01 10:00:00", "2023-10-01 10:00:05", nil)
+		mock.ExpectQuery(`TASK_HISTORY`).WillReturnRows(rows)
+
+		d := Task().Data(state)
+		r.NoError(setTaskLastRun(d, db, builder))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("SUCCEEDED", d.Get("last_run_state"))
+		r.Equal("2023-10-01 10:00:05", d.Get("last_run_completed_time"))
+		r.Equal("", d.Get("last_run_error_message"))
+	})
+
+	t.Run("history not readable", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		mock.ExpectQuery(`TASK_HISTORY`).WillReturnError(errors.New("No active warehouse selected in the current session"))
+
+		d := Task().Data(state)
+		r.NoError(setTaskLastRun(d, db, builder))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("", d.Get("last_run_state"))
+		r.Equal("", d.Get("last_run_error_message"))
+	})
+}
+
+func TestUpdateTaskExecuteOnChange(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test_db|test_schema|test_task",
+		Attributes: map[string]string{
+			"name":              "test_task",
+			"database":          "test_db",
+			"schema":            "test_schema",
+			"sql_statement":     "SELECT 1",
+			"warehouse":         "wh",
+			"comment":           "comment",
+			"execute_on_change": "true",
+		},
+	}
+	config := func(attributes map[string]interface{}) *terraform.ResourceConfig {
+		c := map[string]interface{}{
+			"name":              "test_task",
+			"database":          "test_db",
+			"schema":            "test_schema",
+			"sql_statement":     "SELECT 1",
+			"warehouse":         "wh",
+			"comment":           "comment",
+			"execute_on_change": true,
+		}
+		for k, v := range attributes {
+			c[k] = v
+		}
+		return terraform.NewResourceConfigRaw(c)
+	}
+
+	testCases := []struct {
+		name       string
+		attributes map[string]interface{}
+		statement  string
+		execute    bool
+	}{
+		{
+			name:       "sql statement",
+			attributes: map[string]interface{}{"sql_statement": "SELECT 2"},
+			statement:  `ALTER TASK "test_db"."test_schema"."test_task" MODIFY AS SELECT 2`,
+			execute:    true,
+		},
+		{
+			name:       "condition",
+			attributes: map[string]interface{}{"when": "SYSTEM$STREAM_HAS_DATA('s')"},
+			statement:  `ALTER TASK "test_db"."test_schema"."test_task" MODIFY WHEN SYSTEM$STREAM_HAS_DATA('s')`,
+			execute:    true,
+		},
+		{
+			name:       "warehouse",
+			attributes: map[string]interface{}{"warehouse": "other_wh"},
+			statement:  `ALTER TASK "test_db"."test_schema"."test_task" SET WAREHOUSE = "other_wh"`,
+			execute:    true,
+		},
+		{
+			name:       "comment",
+			attributes: map[string]interface{}{"comment": "other comment"},
+			statement:  `ALTER TASK "test_db"."test_schema"."test_task" SET COMMENT = 'other comment'`,
+			execute:    false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			db, mock, err := sqlmock.New()
+			r.NoError(err)
+			defer db.Close()
+
+			res := Task()
+			diff, err := res.Diff(context.Background(), state, config(tc.attributes), nil)
+			r.NoError(err)
+
+			show := `^SHOW TASKS LIKE 'test_task' IN SCHEMA "test_db"."test_schema"$`
+			mock.ExpectQuery(show).WillReturnRows(
+				sqlmock.NewRows([]string{"name", "database_name", "schema_name", "state", "definition"}).
+					AddRow("test_task", "test_db", "test_schema", "suspended", "SELECT 1"),
+			)
+			mock.ExpectExec(regexp.QuoteMeta(tc.statement)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."test_task" SUSPEND$`).WillReturnResult(sqlmock.NewResult(1, 1))
+			if tc.execute {
+				mock.ExpectExec(`^EXECUTE TASK "test_db"."test_schema"."test_task"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+			// the task is not found on the read that follows the update
+			mock.ExpectQuery(show).WillReturnError(sql.ErrNoRows)
+
+			_, diags := res.Apply(context.Background(), state, diff, db)
+			r.False(diags.HasError(), "%v", diags)
+			r.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
@@ -28,6 +33,38 @@ var userProperties = []string{
 	"last_name",
 }
 
+// userParameterProperties are applied with ALTER USER ... SET and UNSET, since they have to be unset when removed.
+var userParameterProperties = []string{
+	"type",
+	"mins_to_unlock",
+	"days_to_expiry",
+	"mins_to_bypass_mfa",
+	"network_policy",
+	"timezone",
+	"query_tag",
+}
+
+// userParameters are the userParameterProperties read with "SHOW PARAMETERS IN USER ..." rather than DESCRIBE USER.
+var userParameters = []string{
+	"network_policy",
+	"timezone",
+	"query_tag",
+}
+
+// userIdpManagedAttributes are the attributes of users that identity providers set through SCIM.
+var userIdpManagedAttributes = []string{
+	"login_name",
+	"password",
+	"disabled",
+	"default_warehouse",
+	"default_role",
+	"default_secondary_roles",
+	"email",
+	"display_name",
+	"first_name",
+	"last_name",
+}
+
 var diffCaseInsensitive = func(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
 }
@@ -46,7 +83,7 @@ var userSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "The name users use to log in. If not supplied, snowflake will use name instead.",
 		// login_name is case-insensitive
-		DiffSuppressFunc: diffCaseInsensitive,
+		DiffSuppressFunc: suppressIdpManaged(diffCaseInsensitive),
 	},
 	"comment": {
 		Type:     schema.TypeString,
@@ -57,18 +94,23 @@ var userSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: "**WARNING:** this will put the password in the terraform state file. Use carefully.",
+		Description: "**WARNING:** this will put the password in the terraform state file. Use carefully. Use `password_wo` to keep the password out of the state.",
 		// TODO validation https://docs.snowflake.net/manuals/sql-reference/sql/create-user.html#optional-parameters
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
+	"password_wo":         writeOnlySchema("Password of the user, never stored in the state. It is set when the user is created and whenever `password_wo_version` changes.", "password"),
+	"password_wo_version": writeOnlyVersionSchema("password_wo"),
 	"disabled": {
-		Type:     schema.TypeBool,
-		Optional: true,
-		Computed: true,
+		Type:             schema.TypeBool,
+		Optional:         true,
+		Computed:         true,
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
 	"default_warehouse": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the virtual warehouse that is active by default for the user’s session upon login.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Specifies the virtual warehouse that is active by default for the user’s session upon login.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
 	"default_namespace": {
 		Type:             schema.TypeString,
@@ -77,16 +119,18 @@ var userSchema = map[string]*schema.Schema{
 		Description:      "Specifies the namespace (database only or database and schema) that is active by default for the user’s session upon login.",
 	},
 	"default_role": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Specifies the role that is active by default for the user’s session upon login.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Specifies the role that is active by default for the user’s session upon login.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
 	"default_secondary_roles": {
-		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
-		Optional:    true,
-		Description: "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported - more information can be found in [doc](https://docs.snowflake.com/en/sql-reference/sql/create-user#optional-object-properties-objectproperties)",
+		Type:             schema.TypeSet,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Optional:         true,
+		Description:      "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported - more information can be found in [doc](https://docs.snowflake.com/en/sql-reference/sql/create-user#optional-object-properties-objectproperties)",
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
 	"rsa_public_key": {
 		Type:        schema.TypeString,
@@ -109,44 +153,95 @@ var userSchema = map[string]*schema.Schema{
 		Description: "Specifies whether the user is forced to change their password on next login (including their first/initial login) into the system.",
 	},
 	"email": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Sensitive:   true,
-		Description: "Email address for the user.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      "Email address for the user.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
 	},
 	"display_name": {
+		Type:             schema.TypeString,
+		Computed:         true,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      "Name displayed for the user in the Snowflake web interface.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
+	},
+	"first_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      "First name of the user.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
+	},
+	"last_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      "Last name of the user.",
+		DiffSuppressFunc: suppressIdpManaged(nil),
+	},
+	"type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Type of the user, one of SERVICE, LEGACY_SERVICE or PERSON. SERVICE users cannot have a password. Snowflake treats users without a type as PERSON users.",
+		ValidateFunc:     validation.StringInSlice([]string{string(sdk.UserTypeService), string(sdk.UserTypeLegacyService), string(sdk.UserTypePerson)}, true),
+		DiffSuppressFunc: suppressUserTypeDiff,
+	},
+	"mins_to_unlock": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the number of minutes until the temporary lock on the user login is cleared. As the value counts down in Snowflake, it is not read back.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"days_to_expiry": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the number of days after which the user status is set to Expired and the user is no longer allowed to log in. As the value counts down in Snowflake, it is not read back.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"mins_to_bypass_mfa": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the number of minutes to temporarily bypass MFA for the user. As the value counts down in Snowflake, it is not read back.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"network_policy": {
 		Type:        schema.TypeString,
-		Computed:    true,
 		Optional:    true,
-		Sensitive:   true,
-		Description: "Name displayed for the user in the Snowflake web interface.",
+		Description: "Specifies the network policy to enforce for the user.",
 	},
-	"first_name": {
+	"timezone": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Sensitive:   true,
-		Description: "First name of the user.",
+		Description: "Specifies the time zone of the sessions of the user.",
 	},
-	"last_name": {
+	"query_tag": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Sensitive:   true,
-		Description: "Last name of the user.",
+		Description: "Specifies the query tag of the sessions of the user.",
 	},
-	"tag": tagReferenceSchema,
+	"ignore_idp_managed_attributes": ignoreIdpManagedAttributesSchema(strings.Join(userIdpManagedAttributes, ", ")),
+	"idp_managed":                   idpManagedSchema,
+	"tag":                           tagReferenceSchema,
 
 	//    MIDDLE_NAME = <string>
 	//    SNOWFLAKE_LOCK = TRUE | FALSE
 	//    SNOWFLAKE_SUPPORT = TRUE | FALSE
-	//    DAYS_TO_EXPIRY = <integer>
-	//    MINS_TO_UNLOCK = <integer>
 	//    EXT_AUTHN_DUO = TRUE | FALSE
 	//    EXT_AUTHN_UID = <string>
-	//    MINS_TO_BYPASS_MFA = <integer>
 	//    DISABLE_MFA = TRUE | FALSE
 	//    MINS_TO_BYPASS_NETWORK POLICY = <integer>
 }
 
+// suppressUserTypeDiff ignores the case of the type, and users without a type read back as PERSON users.
+func suppressUserTypeDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if strings.EqualFold(old, new) {
+		return true
+	}
+	return new == "" && strings.EqualFold(old, string(sdk.UserTypePerson))
+}
+
 func User() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateUser,
@@ -154,15 +249,55 @@ func User() *schema.Resource {
 		Update: UpdateUser,
 		Delete: DeleteUser,
 
-		Schema: userSchema,
+		Schema:        userSchema,
+		CustomizeDiff: customizeUserDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importUser,
 		},
 	}
 }
 
+// importUser reads the parameters set on the imported user, which the reads only refresh once they are in the state.
+func importUser(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := readUserParameters(d, meta); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeUserDiff rejects passwords on SERVICE users, which can only authenticate with key pairs or OAuth.
+func customizeUserDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !strings.EqualFold(d.Get("type").(string), string(sdk.UserTypeService)) {
+		return nil
+	}
+	if d.Get("password").(string) != "" || d.Get("must_change_password").(bool) {
+		return errors.New("password and must_change_password cannot be set on SERVICE users")
+	}
+	config := d.GetRawConfig()
+	if !config.IsNull() && config.IsKnown() && config.Type().HasAttribute("password_wo") {
+		if v := config.GetAttr("password_wo"); !v.IsNull() {
+			return errors.New("password_wo cannot be set on SERVICE users")
+		}
+	}
+	return nil
+}
+
+// readUserLater defers the read of CreateResource and UpdateResource until the user is fully applied.
+func readUserLater(*schema.ResourceData, interface{}) error {
+	return nil
+}
+
 func CreateUser(d *schema.ResourceData, meta interface{}) error {
-	return CreateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, ReadUser)(d, meta)
+	if err := CreateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, readUserLater)(d, meta); err != nil {
+		return err
+	}
+	if err := setUserWriteOnlyPassword(d, meta); err != nil {
+		return err
+	}
+	if err := updateUserParameters(d, meta); err != nil {
+		return err
+	}
+	return ReadUser(d, meta)
 }
 
 func ReadUser(d *schema.ResourceData, meta interface{}) error {
@@ -229,11 +364,160 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("last_name", u.LastName.String); err != nil {
 		return err
 	}
+	if err = d.Set("type", u.Type.String); err != nil {
+		return err
+	}
+	if err = readUserIdpManaged(d, meta); err != nil {
+		return err
+	}
+	// "SHOW PARAMETERS IN USER ..." is another query, so the parameters are only refreshed when they are managed
+	for _, key := range userParameters {
+		if d.Get(key).(string) != "" {
+			return readUserParameters(d, meta)
+		}
+	}
+	return nil
+}
+
+// readUserIdpManaged detects whether the user is provisioned by SCIM. As "SHOW USERS ..." requires the "MANAGE GRANTS"
+// global privilege, the owner is only read when ignore_idp_managed_attributes is set.
+func readUserIdpManaged(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("ignore_idp_managed_attributes").(bool) {
+		return d.Set("idp_managed", false)
+	}
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	user, err := client.Users.ShowByID(context.Background(), sdk.NewAccountObjectIdentifier(d.Id()))
+	if err != nil {
+		return fmt.Errorf("error reading owner of user %v err = %w", d.Id(), err)
+	}
+	return d.Set("idp_managed", snowflake.IsSCIMProvisionerRole(user.Owner))
+}
+
+// readUserParameters sets the parameters of the user, which are only kept when they are set on the user itself.
+func readUserParameters(d *schema.ResourceData, meta interface{}) error {
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	parameters, err := client.Users.ShowParameters(context.Background(), sdk.NewAccountObjectIdentifier(d.Id()))
+	if err != nil {
+		return fmt.Errorf("error reading parameters of user %v err = %w", d.Id(), err)
+	}
+	values := make(map[string]string, len(userParameters))
+	for _, key := range userParameters {
+		values[key] = ""
+	}
+	for _, parameter := range parameters {
+		key := strings.ToLower(parameter.Key)
+		if _, ok := values[key]; ok && strings.EqualFold(parameter.Level, "USER") {
+			values[key] = parameter.Value
+		}
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func UpdateUser(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, ReadUser)(d, meta)
+	if err := UpdateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, readUserLater)(d, meta); err != nil {
+		return err
+	}
+	if d.HasChange("password_wo_version") {
+		if err := setUserWriteOnlyPassword(d, meta); err != nil {
+			return err
+		}
+	}
+	if d.HasChanges(userParameterProperties...) {
+		if err := updateUserParameters(d, meta); err != nil {
+			return err
+		}
+	}
+	return ReadUser(d, meta)
+}
+
+// updateUserParameters sets the changed type and parameters of the user and unsets the removed ones.
+func updateUserParameters(d *schema.ResourceData, meta interface{}) error {
+	properties := &sdk.UserObjectProperties{}
+	objectParams := &sdk.UserObjectParams{}
+	sessionParams := &sdk.UserSessionParams{}
+	unset := &sdk.UserUnset{}
+	hasSet, hasUnset := false, false
+
+	for _, key := range userParameterProperties {
+		if !d.HasChange(key) {
+			continue
+		}
+		v, ok := d.GetOk(key)
+		if !ok {
+			hasUnset = true
+			switch key {
+			case "type":
+				unset.Type = sdk.Bool(true)
+			case "mins_to_unlock":
+				unset.MinsToUnlock = sdk.Bool(true)
+			case "days_to_expiry":
+				unset.DaysToExpiry = sdk.Bool(true)
+			case "mins_to_bypass_mfa":
+				unset.MinsToBypassMFA = sdk.Bool(true)
+			case "network_policy":
+				unset.NetworkPolicy = sdk.Bool(true)
+			case "timezone":
+				unset.Timezone = sdk.Bool(true)
+			case "query_tag":
+				unset.QueryTag = sdk.Bool(true)
+			}
+			continue
+		}
+		hasSet = true
+		switch key {
+		case "type":
+			userType := sdk.UserType(strings.ToUpper(v.(string)))
+			properties.Type = &userType
+		case "mins_to_unlock":
+			properties.MinsToUnlock = sdk.Int(v.(int))
+		case "days_to_expiry":
+			properties.DaysToExpiry = sdk.Int(v.(int))
+		case "mins_to_bypass_mfa":
+			properties.MinsToBypassMFA = sdk.Int(v.(int))
+		case "network_policy":
+			objectParams.NetworkPolicy = sdk.String(v.(string))
+		case "timezone":
+			sessionParams.Timezone = sdk.String(v.(string))
+		case "query_tag":
+			sessionParams.QueryTag = sdk.String(v.(string))
+		}
+	}
+
+	client := sdk.NewClientFromDB(meta.(*sql.DB))
+	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	if hasSet {
+		set := &sdk.UserSet{ObjectProperties: properties, ObjectParams: objectParams, SessionParams: sessionParams}
+		if err := client.Users.Alter(ctx, id, &sdk.UserAlterOptions{Set: set}); err != nil {
+			return fmt.Errorf("error setting parameters of user %v err = %w", d.Id(), err)
+		}
+	}
+	if hasUnset {
+		if err := client.Users.Alter(ctx, id, &sdk.UserAlterOptions{Unset: unset}); err != nil {
+			return fmt.Errorf("error unsetting parameters of user %v err = %w", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// setUserWriteOnlyPassword sets the password of the user from password_wo, if configured.
+func setUserWriteOnlyPassword(d *schema.ResourceData, meta interface{}) error {
+	password, ok := getWriteOnly(d, "password_wo")
+	if !ok || d.Id() == "" {
+		return nil
+	}
+	db := meta.(*sql.DB)
+	qb := snowflake.NewUserBuilder(d.Id()).Alter()
+	qb.SetString("password", password)
+	if err := snowflake.Exec(db, qb.Statement()); err != nil {
+		return fmt.Errorf("error setting password of user %v err = %w", d.Id(), err)
+	}
+	return nil
 }
 
 func DeleteUser(d *schema.ResourceData, meta interface{}) error {
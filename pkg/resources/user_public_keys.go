@@ -1,14 +1,17 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var userPublicKeyProperties = []string{
@@ -43,6 +46,48 @@ var userPublicKeysSchema = map[string]*schema.Schema{
 		Description: "Specifies the user’s second RSA public key; used to rotate the public and Public keys for key-pair authentication based on an expiration schedule set by your organization. Must be on 1 line without header and trailer.",
 		StateFunc:   publicKeyStateFunc,
 	},
+	"rsa_public_key_wo":           writeOnlySchema("Specifies the user’s RSA public key, never stored in the state. It is set when the resource is created and whenever `rsa_public_key_wo_version` changes. Must be on 1 line without header and trailer.", "rsa_public_key"),
+	"rsa_public_key_wo_version":   writeOnlyVersionSchema("rsa_public_key_wo"),
+	"rsa_public_key_2_wo":         writeOnlySchema("Specifies the user’s second RSA public key, never stored in the state. It is set when the resource is created and whenever `rsa_public_key_2_wo_version` changes. Must be on 1 line without header and trailer.", "rsa_public_key_2"),
+	"rsa_public_key_2_wo_version": writeOnlyVersionSchema("rsa_public_key_2_wo"),
+	"active_rsa_public_key": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the user’s active RSA public key, managing both key slots for rotation without downtime. When it changes, the previous key is moved to RSA_PUBLIC_KEY_2 and the new key is set as RSA_PUBLIC_KEY. The previous key is removed by the first apply after `previous_key_retention_hours`. Must be on 1 line without header and trailer.",
+		StateFunc:     publicKeyStateFunc,
+		ConflictsWith: []string{"rsa_public_key", "rsa_public_key_2", "rsa_public_key_wo", "rsa_public_key_2_wo"},
+	},
+	"previous_key_retention_hours": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      24,
+		Description:  "Number of hours the previous key of `active_rsa_public_key` stays valid as RSA_PUBLIC_KEY_2 after a rotation.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"previous_key_expires_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time after which the previous key of `active_rsa_public_key` is removed, empty when there is no previous key.",
+	},
+	"rsa_public_key_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fingerprint of the RSA_PUBLIC_KEY of the user.",
+	},
+	"rsa_public_key_2_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fingerprint of the RSA_PUBLIC_KEY_2 of the user.",
+	},
+}
+
+// getUserPublicKey returns the configured public key, from the attribute itself or from its write-only counterpart.
+func getUserPublicKey(d *schema.ResourceData, prop string) (string, bool) {
+	if publicKey, ok := d.GetOk(prop); ok {
+		return publicKey.(string), true
+	}
+	publicKey, ok := getWriteOnly(d, prop+"_wo")
+	return publicKeyStateFunc(publicKey), ok
 }
 
 func UserPublicKeys() *schema.Resource {
@@ -52,43 +97,39 @@ func UserPublicKeys() *schema.Resource {
 		Update: UpdateUserPublicKeys,
 		Delete: DeleteUserPublicKeys,
 
-		Schema: userPublicKeysSchema,
+		Schema:        userPublicKeysSchema,
+		CustomizeDiff: customizeUserPublicKeysDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func checkUserExists(db *sql.DB, name string) (bool, error) {
-	// First check if user exists
-	stmt := snowflake.NewUserBuilder(name).Describe()
-	_, err := snowflake.Query(db, stmt)
-	if errors.Is(err, sql.ErrNoRows) {
-		log.Printf("[DEBUG] user (%s) not found", name)
-		return false, nil
-	}
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
-}
-
 func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
-	exists, err := checkUserExists(db, id)
+	rows, err := snowflake.Query(db, snowflake.NewUserBuilder(id).Describe())
+	if errors.Is(err, sql.ErrNoRows) || (err != nil && snowflake.IsResourceNotExistOrNotAuthorized(err.Error(), "User")) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] user (%s) not found", id)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	// If not found, mark resource to be removed from state file during apply or refresh
-	if !exists {
-		d.SetId("")
-		return nil
+	defer rows.Close()
+	u, err := snowflake.ScanUserDescription(rows)
+	if err != nil {
+		return err
 	}
-	// we can't really read the public keys back from Snowflake so assume they haven't changed
-	return nil
+	// we can't really read the public keys back from Snowflake so assume they haven't changed, only their
+	// fingerprints are known
+	if err := d.Set("rsa_public_key_fp", u.RsaPublicKeyFp.String); err != nil {
+		return err
+	}
+	return d.Set("rsa_public_key_2_fp", u.RsaPublicKey2Fp.String)
 }
 
 func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
@@ -96,15 +137,23 @@ func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 
 	for _, prop := range userPublicKeyProperties {
-		publicKey, publicKeyOK := d.GetOk(prop)
+		publicKey, publicKeyOK := getUserPublicKey(d, prop)
 		if !publicKeyOK {
 			continue
 		}
-		err := updateUserPublicKeys(db, name, prop, publicKey.(string))
+		err := updateUserPublicKeys(db, name, prop, publicKey)
 		if err != nil {
 			return err
 		}
 	}
+	if activeKey, ok := d.GetOk("active_rsa_public_key"); ok {
+		if err := updateUserPublicKeys(db, name, "rsa_public_key", activeKey.(string)); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("previous_key_expires_at", ""); err != nil {
+		return err
+	}
 
 	d.SetId(name)
 	return ReadUserPublicKeys(d, meta)
@@ -119,15 +168,15 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 
 	for _, prop := range userPublicKeyProperties {
 		// if key hasn't changed, continue
-		if !d.HasChange(prop) {
+		if !d.HasChange(prop) && !d.HasChange(prop+"_wo_version") {
 			continue
 		}
 		// if it has changed then we should do something about it
-		publicKey, publicKeyOK := d.GetOk(prop)
+		publicKey, publicKeyOK := getUserPublicKey(d, prop)
 		if publicKeyOK { // if set, then we should update the value
-			propsToSet[prop] = publicKey.(string)
+			propsToSet[prop] = publicKey
 		} else { // if now unset, we should unset the key from the user
-			propsToUnset[prop] = publicKey.(string)
+			propsToUnset[prop] = publicKey
 		}
 	}
 
@@ -146,10 +195,79 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+
+	if err := rotateUserPublicKeys(d, db, name, time.Now()); err != nil {
+		return err
+	}
 	// re-sync
 	return ReadUserPublicKeys(d, meta)
 }
 
+// rotateUserPublicKeys moves the previous active key to RSA_PUBLIC_KEY_2 before setting the new one, so that clients
+// using either key keep working, and removes the previous key once it has expired.
+func rotateUserPublicKeys(d *schema.ResourceData, db *sql.DB, name string, now time.Time) error {
+	if d.HasChange("active_rsa_public_key") {
+		o, n := d.GetChange("active_rsa_public_key")
+		previousKey, activeKey := o.(string), n.(string)
+		expiresAt := ""
+		if activeKey == "" {
+			for _, prop := range userPublicKeyProperties {
+				if err := unsetUserPublicKeys(db, name, prop); err != nil {
+					return err
+				}
+			}
+		} else {
+			if previousKey != "" {
+				if err := updateUserPublicKeys(db, name, "rsa_public_key_2", previousKey); err != nil {
+					return err
+				}
+				retention := time.Duration(d.Get("previous_key_retention_hours").(int)) * time.Hour
+				expiresAt = now.Add(retention).UTC().Format(time.RFC3339)
+			}
+			if err := updateUserPublicKeys(db, name, "rsa_public_key", activeKey); err != nil {
+				return err
+			}
+		}
+		return d.Set("previous_key_expires_at", expiresAt)
+	}
+
+	if o, n := d.GetChange("previous_key_expires_at"); o.(string) != "" && n.(string) == "" {
+		log.Printf("[DEBUG] previous key of user (%s) expired at %s, removing it", name, o.(string))
+		return unsetUserPublicKeys(db, name, "rsa_public_key_2")
+	}
+	return nil
+}
+
+// previousKeyExpired reports whether the previous key of a rotation has expired.
+func previousKeyExpired(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(t)
+}
+
+func customizeUserPublicKeysDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.HasChange("active_rsa_public_key") {
+		for _, key := range []string{"previous_key_expires_at", "rsa_public_key_fp", "rsa_public_key_2_fp"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if previousKeyExpired(d.Get("previous_key_expires_at").(string), time.Now()) {
+		if err := d.SetNew("previous_key_expires_at", ""); err != nil {
+			return err
+		}
+		return d.SetNewComputed("rsa_public_key_2_fp")
+	}
+	return nil
+}
+
 func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Id()
@@ -0,0 +1,127 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// userPublicKeysData returns the resource data of an update from the given state to the given configuration.
+func userPublicKeysData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	res := UserPublicKeys()
+	is := &terraform.InstanceState{ID: "user", Attributes: state}
+	diff, err := res.Diff(context.Background(), is, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	d, err := schema.InternalMap(res.Schema).Data(is, diff)
+	require.NoError(t, err)
+	return d
+}
+
+func TestRotateUserPublicKeys(t *testing.T) {
+	now := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("moves the previous key to the second slot", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		d := userPublicKeysData(t,
+			map[string]string{"name": "user", "active_rsa_public_key": "old", "previous_key_retention_hours": "24"},
+			map[string]interface{}{"name": "user", "active_rsa_public_key": "new"},
+		)
+
+		mock.ExpectExec(`^ALTER USER "user" SET rsa_public_key_2 = 'old'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "user" SET rsa_public_key = 'new'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		r.NoError(rotateUserPublicKeys(d, db, "user", now))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("2023-10-02T10:00:00Z", d.Get("previous_key_expires_at"))
+	})
+
+	t.Run("sets the first key", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		d := userPublicKeysData(t,
+			map[string]string{"name": "user", "previous_key_retention_hours": "24"},
+			map[string]interface{}{"name": "user", "active_rsa_public_key": "new"},
+		)
+
+		mock.ExpectExec(`^ALTER USER "user" SET rsa_public_key = 'new'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		r.NoError(rotateUserPublicKeys(d, db, "user", now))
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("", d.Get("previous_key_expires_at"))
+	})
+
+	t.Run("removes the expired previous key", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		d := userPublicKeysData(t,
+			map[string]string{"name": "user", "active_rsa_public_key": "new", "previous_key_retention_hours": "24", "previous_key_expires_at": "2023-10-02T10:00:00Z"},
+			map[string]interface{}{"name": "user", "active_rsa_public_key": "new"},
+		)
+		r.Equal("", d.Get("previous_key_expires_at"))
+
+		mock.ExpectExec(`^ALTER USER "user" UNSET rsa_public_key_2$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		r.NoError(rotateUserPublicKeys(d, db, "user", now))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the previous key until it expires", func(t *testing.T) {
+		r := require.New(t)
+		expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		d := userPublicKeysData(t,
+			map[string]string{"name": "user", "active_rsa_public_key": "new", "previous_key_retention_hours": "24", "previous_key_expires_at": expiresAt},
+			map[string]interface{}{"name": "user", "active_rsa_public_key": "new"},
+		)
+		r.Equal(expiresAt, d.Get("previous_key_expires_at"))
+	})
+}
+
+func TestPreviousKeyExpired(t *testing.T) {
+	now := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		expiresAt string
+		expired   bool
+	}{
+		{expiresAt: "", expired: false},
+		{expiresAt: "invalid", expired: false},
+		{expiresAt: "2023-10-01T09:59:59Z", expired: true},
+		{expiresAt: "2023-10-01T10:00:00Z", expired: true},
+		{expiresAt: "2023-10-01T10:00:01Z", expired: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expiresAt, func(t *testing.T) {
+			require.Equal(t, tc.expired, previousKeyExpired(tc.expiresAt, now))
+		})
+	}
+}
+
+func TestReadUserPublicKeysFingerprints(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+		AddRow("NAME", "user", "", "").
+		AddRow("RSA_PUBLIC_KEY_FP", "SHA256:first", "", "").
+		AddRow("RSA_PUBLIC_KEY_2_FP", "null", "", "")
+	mock.ExpectQuery(`^DESCRIBE USER "user"$`).WillReturnRows(rows)
+
+	d := UserPublicKeys().Data(&terraform.InstanceState{ID: "user", Attributes: map[string]string{"name": "user"}})
+	r.NoError(ReadUserPublicKeys(d, db))
+	r.Equal("SHA256:first", d.Get("rsa_public_key_fp"))
+	r.Equal("", d.Get("rsa_public_key_2_fp"))
+}
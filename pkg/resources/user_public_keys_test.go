@@ -32,7 +32,7 @@ func TestUserPublicKeysCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key = 'asdf'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key_2 = 'asdf2'`).WillReturnResult(sqlmock.NewResult(1, 1))
-		expectReadUser(mock, "good_name")
+		expectDescribeUser(mock, "good_name")
 		err := resources.CreateUserPublicKeys(d, db)
 		r.NoError(err)
 
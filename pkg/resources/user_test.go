@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -10,7 +11,9 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/stretchr/testify/require"
 )
@@ -48,13 +51,26 @@ func TestUserCreate(t *testing.T) {
 		name := "good_name"
 		q := fmt.Sprintf(`^CREATE USER "%s" COMMENT='great comment' DEFAULT_NAMESPACE='mynamespace' DEFAULT_ROLE='bestrole' DEFAULT_WAREHOUSE='mywarehouse' DISPLAY_NAME='Display Name' EMAIL='fake@email.com' FIRST_NAME='Marcin' LAST_NAME='Zukowski' LOGIN_NAME='gname' PASSWORD='awesomepassword' RSA_PUBLIC_KEY='asdf' RSA_PUBLIC_KEY_2='asdf2' DISABLED=true MUST_CHANGE_PASSWORD=true$`, name)
 		mock.ExpectExec(q).WillReturnResult(sqlmock.NewResult(1, 1))
-		expectReadUser(mock, name)
+		expectDescribeUser(mock, name)
 		err := resources.CreateUser(d, db)
 		r.NoError(err)
 	})
 }
 
 func expectReadUser(mock sqlmock.Sqlmock, name string) {
+	expectDescribeUser(mock, name)
+	expectShowUserParameters(mock, name)
+}
+
+func expectShowUserParameters(mock sqlmock.Sqlmock, name string) {
+	parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("NETWORK_POLICY", "", "", "", "", "STRING").
+		AddRow("QUERY_TAG", "account_tag", "", "ACCOUNT", "", "STRING").
+		AddRow("TIMEZONE", "Europe/Warsaw", "America/Los_Angeles", "USER", "", "STRING")
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW PARAMETERS IN USER "%s"$`, name)).WillReturnRows(parameterRows)
+}
+
+func expectDescribeUser(mock sqlmock.Sqlmock, name string) {
 	rowsmap := map[string]string{
 		"NAME":                 name,
 		"CREATED_ON":           "created_on",
@@ -98,7 +114,7 @@ func expectReadUser(mock sqlmock.Sqlmock, name string) {
 func TestUserRead(t *testing.T) {
 	r := require.New(t)
 	name := "good_name"
-	d := user(t, name, map[string]interface{}{"name": name})
+	d := user(t, name, map[string]interface{}{"name": name, "timezone": "UTC"})
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadUser(mock, name)
@@ -107,6 +123,8 @@ func TestUserRead(t *testing.T) {
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("myloginname", d.Get("login_name").(string))
 		r.Equal(false, d.Get("disabled").(bool))
+		r.Equal("Europe/Warsaw", d.Get("timezone").(string))
+		r.Equal("", d.Get("query_tag").(string))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
@@ -118,6 +136,86 @@ func TestUserRead(t *testing.T) {
 	})
 }
 
+func TestUserReadWithoutParameters(t *testing.T) {
+	r := require.New(t)
+	name := "good_name"
+	d := user(t, name, map[string]interface{}{"name": name})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the parameters are not managed, so they are not shown
+		expectDescribeUser(mock, name)
+		err := resources.ReadUser(d, db)
+		r.NoError(err)
+		r.Equal("", d.Get("timezone").(string))
+	})
+}
+
+func TestUserImport(t *testing.T) {
+	r := require.New(t)
+	name := "good_name"
+	d := user(t, name, map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowUserParameters(mock, name)
+		imported, err := resources.User().Importer.StateContext(context.Background(), d, db)
+		r.NoError(err)
+		r.Len(imported, 1)
+		r.Equal("Europe/Warsaw", d.Get("timezone").(string))
+
+		// once imported, the parameters are refreshed by the reads
+		expectReadUser(mock, name)
+		err = resources.ReadUser(d, db)
+		r.NoError(err)
+		r.Equal("Europe/Warsaw", d.Get("timezone").(string))
+	})
+}
+
+func TestUserCreateWithTypeAndParameters(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "service_user",
+		"type":           "service",
+		"days_to_expiry": 30,
+		"timezone":       "Europe/Warsaw",
+	}
+	d := schema.TestResourceDataRaw(t, resources.User().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE USER "service_user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "service_user" SET DAYS_TO_EXPIRY = 30 TYPE = SERVICE TIMEZONE = 'Europe/Warsaw'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadUser(mock, "service_user")
+		err := resources.CreateUser(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestUserServiceTypeRejectsPasswords(t *testing.T) {
+	r := require.New(t)
+
+	for _, config := range []map[string]interface{}{
+		{"name": "service_user", "type": "SERVICE", "password": "secret"},
+		{"name": "service_user", "type": "service", "must_change_password": true},
+	} {
+		_, err := resources.User().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		r.Error(err)
+	}
+
+	// the write-only password is only known from the raw configuration, which Terraform sends with the prior state
+	state := &terraform.InstanceState{RawConfig: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.StringVal("secret")})}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "service_user", "type": "SERVICE", "password_wo": "secret", "password_wo_version": 1})
+	_, err := resources.User().Diff(context.Background(), state, config, nil)
+	r.EqualError(err, "password_wo cannot be set on SERVICE users")
+
+	_, err = resources.User().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "person_user",
+		"type":     "PERSON",
+		"password": "secret",
+	}), nil)
+	r.NoError(err)
+}
+
 func TestUserDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -90,6 +92,17 @@ var warehouseSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
+	"state": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.WarehouseStateStarted),
+			string(sdk.WarehouseStateSuspended),
+		}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+		Description:      "Specifies the state of the warehouse: STARTED or SUSPENDED. The warehouse is resumed or suspended on apply to match it. Note that auto_suspend and auto_resume change the state outside of Terraform, which shows up as a diff on the next plan.",
+	},
 	"resource_monitor": {
 		Type:        schema.TypeString,
 		Description: "Specifies the name of a resource monitor that is explicitly assigned to the warehouse.",
@@ -141,7 +154,21 @@ var warehouseSchema = map[string]*schema.Schema{
 			string(sdk.WarehouseTypeStandard),
 			string(sdk.WarehouseTypeSnowparkOptimized),
 		}, true),
-		Description: "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse",
+		Description: "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse. The warehouse is suspended while its type is changed and its prior state is restored afterwards.",
+	},
+	"resource_constraint": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.WarehouseResourceConstraintMemory1X),
+			string(sdk.WarehouseResourceConstraintMemory1Xx86),
+			string(sdk.WarehouseResourceConstraintMemory16X),
+			string(sdk.WarehouseResourceConstraintMemory16Xx86),
+			string(sdk.WarehouseResourceConstraintMemory64X),
+			string(sdk.WarehouseResourceConstraintMemory64Xx86),
+		}, false),
+		Description: "Specifies the memory and CPU architecture of a SNOWPARK-OPTIMIZED warehouse. The warehouse is suspended while the constraint is changed and its prior state is restored afterwards.",
 	},
 }
 
@@ -169,7 +196,7 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
 
-	whType := sdk.WarehouseType(d.Get("warehouse_type").(string))
+	whType := sdk.WarehouseType(strings.ToUpper(d.Get("warehouse_type").(string)))
 	createOptions := &sdk.WarehouseCreateOptions{
 		Comment:                         sdk.String(d.Get("comment").(string)),
 		StatementTimeoutInSeconds:       sdk.Int(d.Get("statement_timeout_in_seconds").(int)),
@@ -206,6 +233,10 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("resource_monitor"); ok {
 		createOptions.ResourceMonitor = sdk.String(v.(string))
 	}
+	if v, ok := d.GetOk("resource_constraint"); ok {
+		constraint := sdk.WarehouseResourceConstraint(v.(string))
+		createOptions.ResourceConstraint = &constraint
+	}
 
 	err := client.Warehouses.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
@@ -213,6 +244,12 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
+	if v, ok := d.GetOk("state"); ok {
+		if err := setWarehouseState(ctx, client, objectIdentifier, sdk.WarehouseState(strings.ToUpper(v.(string)))); err != nil {
+			return err
+		}
+	}
+
 	return ReadWarehouse(d, meta)
 }
 
@@ -241,6 +278,9 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("warehouse_size", w.Size); err != nil {
 		return err
 	}
+	if err = d.Set("resource_constraint", w.ResourceConstraint); err != nil {
+		return err
+	}
 	if err = d.Set("max_cluster_count", w.MaxClusterCount); err != nil {
 		return err
 	}
@@ -259,6 +299,9 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("resource_monitor", w.ResourceMonitor); err != nil {
 		return err
 	}
+	if err = d.Set("state", warehouseState(w.State)); err != nil {
+		return err
+	}
 	if err = d.Set("enable_query_acceleration", w.EnableQueryAcceleration); err != nil {
 		return err
 	}
@@ -304,10 +347,18 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		runSet = true
 		set.Comment = sdk.String(d.Get("comment").(string))
 	}
+	// Changing the type or the resource constraint requires the warehouse to be suspended, so these are applied separately.
+	// The size is applied together with them, because not every size is supported by every type.
+	changeType := d.HasChanges("warehouse_type", "resource_constraint")
+	typeSet := sdk.WarehouseSet{}
 	if d.HasChange("warehouse_size") {
-		runSet = true
 		size := sdk.WarehouseSize(strings.ReplaceAll(d.Get("warehouse_size").(string), "-", ""))
-		set.WarehouseSize = &size
+		if changeType {
+			typeSet.WarehouseSize = &size
+		} else {
+			runSet = true
+			set.WarehouseSize = &size
+		}
 	}
 	if d.HasChange("max_cluster_count") {
 		if v, ok := d.GetOk("max_cluster_count"); ok {
@@ -384,14 +435,17 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		runSet = true
 		set.QueryAccelerationMaxScaleFactor = sdk.Int(d.Get("query_acceleration_max_scale_factor").(int))
 	}
-	if d.HasChange("warehouse_type") {
+	if changeType {
 		if v, ok := d.GetOk("warehouse_type"); ok {
-			runSet = true
-			whType := sdk.WarehouseType(v.(string))
-			set.WarehouseType = &whType
-		} else {
-			runUnset = true
-			unset.WarehouseType = sdk.Bool(true)
+			whType := sdk.WarehouseType(strings.ToUpper(v.(string)))
+			typeSet.WarehouseType = &whType
+		}
+		if v, ok := d.GetOk("resource_constraint"); ok && d.HasChange("resource_constraint") {
+			constraint := sdk.WarehouseResourceConstraint(v.(string))
+			typeSet.ResourceConstraint = &constraint
+		}
+		if err := alterSuspendedWarehouse(ctx, client, id, &typeSet); err != nil {
+			return err
 		}
 	}
 
@@ -413,9 +467,76 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("state") {
+		if v, ok := d.GetOk("state"); ok {
+			if err := setWarehouseState(ctx, client, id, sdk.WarehouseState(strings.ToUpper(v.(string)))); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// warehouseState returns the state the warehouse converges to, treating transitional states as the state they lead to.
+func warehouseState(state sdk.WarehouseState) sdk.WarehouseState {
+	switch state {
+	case sdk.WarehouseStateSuspended, sdk.WarehouseStateSuspending:
+		return sdk.WarehouseStateSuspended
+	default:
+		return sdk.WarehouseStateStarted
+	}
+}
+
+// setWarehouseState suspends or resumes the warehouse if it is not already in the desired state.
+func setWarehouseState(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, desired sdk.WarehouseState) error {
+	w, err := client.Warehouses.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if warehouseState(w.State) == desired {
+		return nil
+	}
+	if desired == sdk.WarehouseStateSuspended {
+		return client.Warehouses.Suspend(ctx, id)
+	}
+	return client.Warehouses.Resume(ctx, id, true)
+}
+
+// alterSuspendedWarehouse applies the given changes while the warehouse is suspended, which Snowflake requires for
+// changes of the warehouse type and resource constraint. A warehouse that was running is resumed afterwards, also
+// when the changes fail.
+func alterSuspendedWarehouse(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, set *sdk.WarehouseSet) error {
+	w, err := client.Warehouses.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	running := warehouseState(w.State) == sdk.WarehouseStateStarted
+	if running {
+		if err := client.Warehouses.Suspend(ctx, id); err != nil {
+			return fmt.Errorf("error suspending warehouse %v err = %w", id.Name(), err)
+		}
+	}
+
+	err = client.Warehouses.Alter(ctx, id, &sdk.WarehouseAlterOptions{
+		Set: set,
+	})
+	if err != nil {
+		err = fmt.Errorf("error altering type of warehouse %v err = %w", id.Name(), err)
+	}
+
+	if running {
+		if resumeErr := client.Warehouses.Resume(ctx, id, true); resumeErr != nil {
+			if err != nil {
+				log.Printf("[WARN] could not resume warehouse %s after failed alter: %v", id.Name(), resumeErr)
+				return err
+			}
+			return fmt.Errorf("error resuming warehouse %v err = %w", id.Name(), resumeErr)
+		}
+	}
+	return err
+}
+
 // DeleteWarehouse implements schema.DeleteFunc.
 func DeleteWarehouse(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -140,3 +140,107 @@ resource "snowflake_warehouse" "w2" {
 `
 	return fmt.Sprintf(s, prefix, prefix)
 }
+
+func TestAcc_WarehouseStateAndResize(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_WAREHOUSE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_WarehouseStateAndResize")
+	}
+
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: wConfigState(prefix, "SUSPENDED", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "state", "SUSPENDED"),
+				),
+			},
+			{
+				Config: wConfigState(prefix, "STARTED", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "state", "STARTED"),
+				),
+			},
+			{
+				Config: wConfigState(prefix, "STARTED", `
+resource "snowflake_warehouse_resize" "r" {
+	warehouse      = snowflake_warehouse.w.name
+	warehouse_size = "SMALL"
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse_resize.r", "warehouse_size", "SMALL"),
+					resource.TestCheckResourceAttrSet("snowflake_warehouse_resize.r", "resized_on"),
+				),
+			},
+		},
+	})
+}
+
+func wConfigState(prefix string, state string, extra string) string {
+	s := `
+resource "snowflake_warehouse" "w" {
+	name         = "%s"
+	auto_suspend = 600
+	auto_resume  = false
+	state        = "%s"
+
+	lifecycle {
+		ignore_changes = [warehouse_size]
+	}
+}
+%s
+`
+	return fmt.Sprintf(s, prefix, state, extra)
+}
+
+func TestAcc_WarehouseTypeChange(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_WAREHOUSE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_WarehouseTypeChange")
+	}
+
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: wConfigType(prefix, "STANDARD", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_type", "STANDARD"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "state", "STARTED"),
+				),
+			},
+			{
+				Config: wConfigType(prefix, "SNOWPARK-OPTIMIZED", "MEMORY_16X"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_type", "SNOWPARK-OPTIMIZED"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "resource_constraint", "MEMORY_16X"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "state", "STARTED"),
+				),
+			},
+		},
+	})
+}
+
+func wConfigType(prefix string, whType string, constraint string) string {
+	if constraint != "" {
+		constraint = fmt.Sprintf("resource_constraint = %q", constraint)
+	}
+	s := `
+resource "snowflake_warehouse" "w" {
+	name           = "%s"
+	warehouse_size = "MEDIUM"
+	warehouse_type = "%s"
+	auto_suspend   = 600
+	auto_resume    = false
+	state          = "STARTED"
+	%s
+}
+`
+	return fmt.Sprintf(s, prefix, whType, constraint)
+}
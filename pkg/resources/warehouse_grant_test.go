@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ func TestWarehouseGrantCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectNoExistingGrants(mock, `SHOW GRANTS ON WAREHOUSE "test-warehouse"`)
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadWarehouseGrant(mock)
@@ -39,6 +41,35 @@ func TestWarehouseGrantCreate(t *testing.T) {
 	})
 }
 
+func TestWarehouseGrantCreateRollsBackNewGrantsOnly(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"warehouse_name": "test-warehouse",
+		"privilege":      "USAGE",
+		"roles":          []interface{}{"test-role-1", "test-role-2", "test-role-3"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.WarehouseGrant().Resource.Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// test-role-3 already holds the privilege, so it must survive the rollback
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "USAGE", "WAREHOUSE", "test-warehouse", "ROLE", "test-role-3", false, "bob",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS ON WAREHOUSE "test-warehouse"$`).WillReturnRows(rows)
+		// the roles are granted in the order of the set
+		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-3"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^GRANT USAGE ON WAREHOUSE "test-warehouse" TO ROLE "test-role-1"$`).WillReturnError(errors.New("role does not exist"))
+		mock.ExpectExec(`^REVOKE USAGE ON WAREHOUSE "test-warehouse" FROM ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.CreateWarehouseGrant(d, db)
+		r.ErrorContains(err, "role does not exist")
+	})
+}
+
 func expectReadWarehouseGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseState(t *testing.T) {
+	r := require.New(t)
+	r.Equal(sdk.WarehouseStateSuspended, warehouseState(sdk.WarehouseStateSuspended))
+	r.Equal(sdk.WarehouseStateSuspended, warehouseState(sdk.WarehouseStateSuspending))
+	r.Equal(sdk.WarehouseStateStarted, warehouseState(sdk.WarehouseStateStarted))
+	r.Equal(sdk.WarehouseStateStarted, warehouseState(sdk.WarehouseStateResuming))
+	r.Equal(sdk.WarehouseStateStarted, warehouseState(sdk.WarehouseStateResizing))
+}
+
+func TestCreateWarehouseLowercaseType(t *testing.T) {
+	t.Run("unsupported size", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		d := schema.TestResourceDataRaw(t, warehouseSchema, map[string]interface{}{
+			"name":           "test_wh",
+			"warehouse_type": "snowpark-optimized",
+			"warehouse_size": "XSMALL",
+		})
+		err = CreateWarehouse(d, db)
+		r.ErrorContains(err, "WarehouseSize XSMALL is not supported by SNOWPARK-OPTIMIZED warehouses")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("resource constraint", func(t *testing.T) {
+		r := require.New(t)
+		db, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer db.Close()
+
+		d := schema.TestResourceDataRaw(t, warehouseSchema, map[string]interface{}{
+			"name":                "test_wh",
+			"warehouse_type":      "snowpark-optimized",
+			"warehouse_size":      "MEDIUM",
+			"resource_constraint": "MEMORY_16X",
+		})
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE WAREHOUSE "test_wh" WAREHOUSE_TYPE = 'SNOWPARK-OPTIMIZED' WAREHOUSE_SIZE = 'MEDIUM' RESOURCE_CONSTRAINT = 'MEMORY_16X'`)).
+			WillReturnError(errors.New("stop after create"))
+		err = CreateWarehouse(d, db)
+		r.ErrorContains(err, "stop after create")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var warehouseResizeSchema = map[string]*schema.Schema{
+	"warehouse": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the warehouse to resize.",
+	},
+	"warehouse_size": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     warehouseSchema["warehouse_size"].ValidateFunc,
+		DiffSuppressFunc: warehouseSchema["warehouse_size"].DiffSuppressFunc,
+		Description:      "The size the warehouse is resized to.",
+	},
+	"wait_for_running_queries": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		ForceNew:    true,
+		Description: "Specifies whether to wait until no queries are running on the warehouse before resizing it. The wait is bounded by the create timeout of the resource.",
+	},
+	"abort_queries_on_timeout": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether to abort the queries still running on the warehouse when waiting for them times out, instead of failing the resize.",
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Arbitrary map of values that, when changed, will run the resize again.",
+	},
+	"resized_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time when the resize completed.",
+	},
+}
+
+// WarehouseResize returns a pointer to the resource representing a one-shot warehouse resize.
+func WarehouseResize() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resizes a warehouse once, optionally waiting for the queries running on it to finish first. The size of the warehouse is not managed after the resize; destroying the resource does not change the warehouse.",
+		Create:      CreateWarehouseResize,
+		Read:        ReadWarehouseResize,
+		Delete:      DeleteWarehouseResize,
+
+		Schema: warehouseResizeSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+// waitForWarehouseQueries waits until no queries are running on the warehouse. It reports whether the queries
+// finished before the timeout expired.
+func waitForWarehouseQueries(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, timeout time.Duration) (bool, error) {
+	var showErr error
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		w, err := client.Warehouses.ShowByID(ctx, id)
+		if err != nil {
+			showErr = err
+			return retry.NonRetryableError(err)
+		}
+		if w.Running > 0 {
+			log.Printf("[DEBUG] waiting for %d queries running on warehouse %s", w.Running, id.Name())
+			return retry.RetryableError(fmt.Errorf("%d queries are still running on warehouse %s", w.Running, id.Name()))
+		}
+		return nil
+	})
+	if showErr != nil {
+		return false, showErr
+	}
+	return err == nil, nil
+}
+
+// CreateWarehouseResize implements schema.CreateFunc.
+func CreateWarehouseResize(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
+
+	if d.Get("wait_for_running_queries").(bool) {
+		drained, err := waitForWarehouseQueries(ctx, client, id, d.Timeout(schema.TimeoutCreate)-time.Minute)
+		if err != nil {
+			return err
+		}
+		if !drained {
+			if !d.Get("abort_queries_on_timeout").(bool) {
+				return fmt.Errorf("timed out waiting for the queries running on warehouse %v to finish", id.Name())
+			}
+			log.Printf("[WARN] aborting the queries still running on warehouse %s", id.Name())
+			if err := client.Warehouses.AbortAllQueries(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	size := sdk.WarehouseSize(strings.ReplaceAll(strings.ToUpper(d.Get("warehouse_size").(string)), "-", ""))
+	err := client.Warehouses.Alter(ctx, id, &sdk.WarehouseAlterOptions{
+		Set: &sdk.WarehouseSet{
+			WarehouseSize:     &size,
+			WaitForCompletion: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error resizing warehouse %v err = %w", id.Name(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+	if err := d.Set("resized_on", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return ReadWarehouseResize(d, meta)
+}
+
+// ReadWarehouseResize implements schema.ReadFunc.
+func ReadWarehouseResize(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+
+	// the resize is a one-shot operation, so only the existence of the warehouse is tracked
+	_, err := client.Warehouses.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] warehouse (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	return err
+}
+
+// DeleteWarehouseResize implements schema.DeleteFunc.
+func DeleteWarehouseResize(d *schema.ResourceData, _ interface{}) error {
+	d.SetId("")
+	return nil
+}
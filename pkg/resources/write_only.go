@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlySchema returns the schema of a secret that is sent to Snowflake but never stored in the state. Its changes
+// are suppressed, so the value stays out of the plan and is read from the configuration instead.
+func writeOnlySchema(description string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      description,
+		ConflictsWith:    conflictsWith,
+		DiffSuppressFunc: suppressWriteOnly,
+	}
+}
+
+// writeOnlyVersionSchema returns the schema of the version of a write-only attribute. As changes of the write-only
+// value cannot be detected, the value is applied again whenever the version changes.
+func writeOnlyVersionSchema(key string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{key},
+		Description:  fmt.Sprintf("Version of `%v`. Change it, e.g. increment it, to apply a new value of `%v`.", key, key),
+	}
+}
+
+func suppressWriteOnly(_, _, _ string, _ *schema.ResourceData) bool {
+	return true
+}
+
+// getWriteOnly returns the configured value of a write-only attribute.
+func getWriteOnly(d *schema.ResourceData, key string) (string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(key) {
+		return "", false
+	}
+	v := config.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() || v.AsString() == "" {
+		return "", false
+	}
+	return v.AsString(), true
+}
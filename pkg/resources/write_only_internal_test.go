@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteOnlyIsNotPlanned(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "user",
+		"password_wo":         "secret",
+		"password_wo_version": 1,
+	})
+	diff, err := User().Diff(context.Background(), nil, config, nil)
+	r.NoError(err)
+	r.Contains(diff.Attributes, "password_wo_version")
+	r.NotContains(diff.Attributes, "password_wo")
+}
+
+func TestGetWriteOnly(t *testing.T) {
+	r := require.New(t)
+
+	d := User().Data(&terraform.InstanceState{
+		ID: "user",
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"password_wo":         cty.StringVal("secret"),
+			"password_wo_version": cty.NumberIntVal(1),
+		}),
+	})
+	password, ok := getWriteOnly(d, "password_wo")
+	r.True(ok)
+	r.Equal("secret", password)
+	r.Empty(d.Get("password_wo"))
+
+	d = User().Data(&terraform.InstanceState{
+		ID:        "user",
+		RawConfig: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.NullVal(cty.String)}),
+	})
+	_, ok = getWriteOnly(d, "password_wo")
+	r.False(ok)
+
+	_, ok = getWriteOnly(User().Data(&terraform.InstanceState{ID: "user"}), "password_wo")
+	r.False(ok)
+}
+
+func TestSetUserWriteOnlyPassword(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	d := User().Data(&terraform.InstanceState{
+		ID:        "user",
+		RawConfig: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.StringVal("secret")}),
+	})
+	mock.ExpectExec(`^ALTER USER "user" SET PASSWORD='secret'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	r.NoError(setUserWriteOnlyPassword(d, db))
+	r.NoError(mock.ExpectationsWereMet())
+}
@@ -17,16 +17,26 @@ type Client struct {
 	config *gosnowflake.Config
 	db     *sqlx.DB
 	dryRun bool
-
-	ContextFunctions ContextFunctions
-	Databases        Databases
-	Grants           Grants
-	MaskingPolicies  MaskingPolicies
-	PasswordPolicies PasswordPolicies
-	Sessions         Sessions
-	Shares           Shares
-	SystemFunctions  SystemFunctions
-	Warehouses       Warehouses
+	cache  *readCache
+
+	ComputePools               ComputePools
+	ContextFunctions           ContextFunctions
+	Databases                  Databases
+	ExternalAccessIntegrations ExternalAccessIntegrations
+	Grants                     Grants
+	ImageRepositories          ImageRepositories
+	MaskingPolicies            MaskingPolicies
+	NetworkRules               NetworkRules
+	PasswordPolicies           PasswordPolicies
+	ResourceMonitors           ResourceMonitors
+	Secrets                    Secrets
+	Services                   Services
+	Sessions                   Sessions
+	Shares                     Shares
+	Streamlits                 Streamlits
+	SystemFunctions            SystemFunctions
+	Users                      Users
+	Warehouses                 Warehouses
 }
 
 func NewDefaultClient() (*Client, error) {
@@ -87,21 +97,31 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 func NewClientFromDB(db *sql.DB) *Client {
 	dbx := sqlx.NewDb(db, "snowflake")
 	client := &Client{
-		db: dbx.Unsafe(),
+		db:    dbx.Unsafe(),
+		cache: readCacheFor(db),
 	}
 	client.initialize()
 	return client
 }
 
 func (c *Client) initialize() {
+	c.ComputePools = &computePools{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.Databases = &databases{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
 	c.Grants = &grants{client: c}
+	c.ImageRepositories = &imageRepositories{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
+	c.NetworkRules = &networkRules{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
+	c.ResourceMonitors = &resourceMonitors{client: c}
+	c.Secrets = &secrets{client: c}
+	c.Services = &services{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.Streamlits = &streamlits{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
+	c.Users = &users{client: c}
 	c.Warehouses = &warehouses{client: c}
 }
 
@@ -124,15 +144,23 @@ func (c *Client) Close() error {
 func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
 	if !c.dryRun {
 		result, err := c.db.ExecContext(ctx, sql)
+		c.cache.invalidate(sql)
 		return result, decodeDriverError(err)
 	}
 	return nil, nil
 }
 
-// query runs a query and returns the rows. dest is expected to be a slice of structs.
+// query runs a query and returns the rows. dest is expected to be a slice of structs. SHOW statements are served from
+// the read cache when it is enabled.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
 	if !c.dryRun {
-		return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+		if c.cache.load(sql, dest) {
+			return nil
+		}
+		if err := c.db.SelectContext(ctx, dest, sql); err != nil {
+			return decodeDriverError(err)
+		}
+		c.cache.store(sql, dest)
 	}
 	return nil
 }
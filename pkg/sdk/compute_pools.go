@@ -0,0 +1,406 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ComputePools = (*computePools)(nil)
+
+// ComputePools describes all the compute pool related methods that the
+// Snowflake API supports.
+type ComputePools interface {
+	// Create creates a compute pool.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolCreateOptions) error
+	// Alter modifies an existing compute pool.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolAlterOptions) error
+	// Drop removes a compute pool.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolDropOptions) error
+	// Show returns a list of compute pools.
+	Show(ctx context.Context, opts *ComputePoolShowOptions) ([]*ComputePool, error)
+	// ShowByID returns a compute pool by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error)
+	// Describe returns the details of a compute pool.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error)
+}
+
+// computePools implements ComputePools.
+type computePools struct {
+	client *Client
+}
+
+type ComputePoolInstanceFamily string
+
+var (
+	ComputePoolInstanceFamilyCPUX64XS     ComputePoolInstanceFamily = "CPU_X64_XS"
+	ComputePoolInstanceFamilyCPUX64S      ComputePoolInstanceFamily = "CPU_X64_S"
+	ComputePoolInstanceFamilyCPUX64M      ComputePoolInstanceFamily = "CPU_X64_M"
+	ComputePoolInstanceFamilyCPUX64L      ComputePoolInstanceFamily = "CPU_X64_L"
+	ComputePoolInstanceFamilyHighMemX64S  ComputePoolInstanceFamily = "HIGHMEM_X64_S"
+	ComputePoolInstanceFamilyHighMemX64M  ComputePoolInstanceFamily = "HIGHMEM_X64_M"
+	ComputePoolInstanceFamilyHighMemX64L  ComputePoolInstanceFamily = "HIGHMEM_X64_L"
+	ComputePoolInstanceFamilyGPUNVS       ComputePoolInstanceFamily = "GPU_NV_S"
+	ComputePoolInstanceFamilyGPUNVM       ComputePoolInstanceFamily = "GPU_NV_M"
+	ComputePoolInstanceFamilyGPUNVL       ComputePoolInstanceFamily = "GPU_NV_L"
+	ComputePoolInstanceFamilyGPUGCPNVL4   ComputePoolInstanceFamily = "GPU_GCP_NV_L4_1_24G"
+	ComputePoolInstanceFamilyGPUGCPNVL44  ComputePoolInstanceFamily = "GPU_GCP_NV_L4_4_24G"
+	ComputePoolInstanceFamilyGPUGCPNVA100 ComputePoolInstanceFamily = "GPU_GCP_NV_A100_8_40G"
+)
+
+// AllComputePoolInstanceFamilies lists the instance families accepted by CREATE COMPUTE POOL.
+var AllComputePoolInstanceFamilies = []ComputePoolInstanceFamily{
+	ComputePoolInstanceFamilyCPUX64XS,
+	ComputePoolInstanceFamilyCPUX64S,
+	ComputePoolInstanceFamilyCPUX64M,
+	ComputePoolInstanceFamilyCPUX64L,
+	ComputePoolInstanceFamilyHighMemX64S,
+	ComputePoolInstanceFamilyHighMemX64M,
+	ComputePoolInstanceFamilyHighMemX64L,
+	ComputePoolInstanceFamilyGPUNVS,
+	ComputePoolInstanceFamilyGPUNVM,
+	ComputePoolInstanceFamilyGPUNVL,
+	ComputePoolInstanceFamilyGPUGCPNVL4,
+	ComputePoolInstanceFamilyGPUGCPNVL44,
+	ComputePoolInstanceFamilyGPUGCPNVA100,
+}
+
+type ComputePoolCreateOptions struct {
+	create      bool                    `ddl:"static" db:"CREATE"`       //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" db:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" db:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	ForApplication     AccountObjectIdentifier   `ddl:"identifier" db:"FOR APPLICATION"`
+	MinNodes           int                       `ddl:"parameter" db:"MIN_NODES"`
+	MaxNodes           int                       `ddl:"parameter" db:"MAX_NODES"`
+	InstanceFamily     ComputePoolInstanceFamily `ddl:"parameter" db:"INSTANCE_FAMILY"`
+	AutoResume         *bool                     `ddl:"parameter" db:"AUTO_RESUME"`
+	InitiallySuspended *bool                     `ddl:"parameter" db:"INITIALLY_SUSPENDED"`
+	AutoSuspendSecs    *int                      `ddl:"parameter" db:"AUTO_SUSPEND_SECS"`
+	Comment            *string                   `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *ComputePoolCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.InstanceFamily == "" {
+		return fmt.Errorf("InstanceFamily must be set")
+	}
+	if !validateIntGreaterThanOrEqual(opts.MinNodes, 1) {
+		return fmt.Errorf("MinNodes must be greater than or equal to 1")
+	}
+	if !validateIntGreaterThanOrEqual(opts.MaxNodes, opts.MinNodes) {
+		return fmt.Errorf("MaxNodes must be greater than or equal to MinNodes")
+	}
+	if valueSet(opts.AutoSuspendSecs) && !validateIntGreaterThanOrEqual(*opts.AutoSuspendSecs, 0) {
+		return fmt.Errorf("AutoSuspendSecs must be greater than or equal to 0")
+	}
+	return nil
+}
+
+func (c *computePools) Create(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolCreateOptions) error {
+	if opts == nil {
+		opts = &ComputePoolCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	stmt, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.exec(ctx, stmt)
+	return err
+}
+
+type ComputePoolAlterOptions struct {
+	alter       bool                    `ddl:"static" db:"ALTER"`        //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" db:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	Suspend *bool `ddl:"keyword" db:"SUSPEND"`
+	Resume  *bool `ddl:"keyword" db:"RESUME"`
+	StopAll *bool `ddl:"keyword" db:"STOP ALL"`
+
+	Set   *ComputePoolSet   `ddl:"keyword" db:"SET"`
+	Unset *ComputePoolUnset `ddl:"list,no_parentheses" db:"UNSET"`
+}
+
+func (opts *ComputePoolAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.StopAll, opts.Set, opts.Unset); !ok {
+		return fmt.Errorf("exactly one of Suspend, Resume, StopAll, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ComputePoolSet struct {
+	MinNodes        *int    `ddl:"parameter" db:"MIN_NODES"`
+	MaxNodes        *int    `ddl:"parameter" db:"MAX_NODES"`
+	AutoResume      *bool   `ddl:"parameter" db:"AUTO_RESUME"`
+	AutoSuspendSecs *int    `ddl:"parameter" db:"AUTO_SUSPEND_SECS"`
+	Comment         *string `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *ComputePoolSet) validate() error {
+	if everyValueNil(v.MinNodes, v.MaxNodes, v.AutoResume, v.AutoSuspendSecs, v.Comment) {
+		return fmt.Errorf("at least one of MinNodes, MaxNodes, AutoResume, AutoSuspendSecs, Comment must be set")
+	}
+	if valueSet(v.MinNodes) && !validateIntGreaterThanOrEqual(*v.MinNodes, 1) {
+		return fmt.Errorf("MinNodes must be greater than or equal to 1")
+	}
+	if everyValueSet(v.MinNodes, v.MaxNodes) && !validateIntGreaterThanOrEqual(*v.MaxNodes, *v.MinNodes) {
+		return fmt.Errorf("MaxNodes must be greater than or equal to MinNodes")
+	}
+	if valueSet(v.AutoSuspendSecs) && !validateIntGreaterThanOrEqual(*v.AutoSuspendSecs, 0) {
+		return fmt.Errorf("AutoSuspendSecs must be greater than or equal to 0")
+	}
+	return nil
+}
+
+type ComputePoolUnset struct {
+	AutoResume      *bool `ddl:"keyword" db:"AUTO_RESUME"`
+	AutoSuspendSecs *bool `ddl:"keyword" db:"AUTO_SUSPEND_SECS"`
+	Comment         *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *ComputePoolUnset) validate() error {
+	if everyValueNil(v.AutoResume, v.AutoSuspendSecs, v.Comment) {
+		return fmt.Errorf("at least one of AutoResume, AutoSuspendSecs, Comment must be set")
+	}
+	return nil
+}
+
+func (c *computePools) Alter(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolAlterOptions) error {
+	if opts == nil {
+		opts = &ComputePoolAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.exec(ctx, sql)
+	return err
+}
+
+type ComputePoolDropOptions struct {
+	drop        bool                    `ddl:"static" db:"DROP"`         //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" db:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *ComputePoolDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (c *computePools) Drop(ctx context.Context, id AccountObjectIdentifier, opts *ComputePoolDropOptions) error {
+	if opts == nil {
+		opts = &ComputePoolDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.exec(ctx, sql)
+	return err
+}
+
+type ComputePoolShowOptions struct {
+	show         bool  `ddl:"static" db:"SHOW"`          //lint:ignore U1000 This is used in the ddl tag
+	computePools bool  `ddl:"static" db:"COMPUTE POOLS"` //lint:ignore U1000 This is used in the ddl tag
+	Like         *Like `ddl:"keyword" db:"LIKE"`
+}
+
+func (opts *ComputePoolShowOptions) validate() error {
+	return nil
+}
+
+type ComputePoolState string
+
+const (
+	ComputePoolStateIdle      ComputePoolState = "IDLE"
+	ComputePoolStateActive    ComputePoolState = "ACTIVE"
+	ComputePoolStateStarting  ComputePoolState = "STARTING"
+	ComputePoolStateSuspended ComputePoolState = "SUSPENDED"
+	ComputePoolStateStopping  ComputePoolState = "STOPPING"
+	ComputePoolStateResizing  ComputePoolState = "RESIZING"
+)
+
+type ComputePool struct {
+	Name            string
+	State           ComputePoolState
+	MinNodes        int
+	MaxNodes        int
+	InstanceFamily  ComputePoolInstanceFamily
+	NumServices     int
+	NumJobs         int
+	AutoSuspendSecs int
+	AutoResume      bool
+	ActiveNodes     int
+	IdleNodes       int
+	CreatedOn       time.Time
+	ResumedOn       time.Time
+	UpdatedOn       time.Time
+	Owner           string
+	Comment         string
+	IsExclusive     bool
+	Application     string
+}
+
+type computePoolDBRow struct {
+	Name            string         `db:"name"`
+	State           string         `db:"state"`
+	MinNodes        int            `db:"min_nodes"`
+	MaxNodes        int            `db:"max_nodes"`
+	InstanceFamily  string         `db:"instance_family"`
+	NumServices     int            `db:"num_services"`
+	NumJobs         int            `db:"num_jobs"`
+	AutoSuspendSecs sql.NullInt64  `db:"auto_suspend_secs"`
+	AutoResume      bool           `db:"auto_resume"`
+	ActiveNodes     int            `db:"active_nodes"`
+	IdleNodes       int            `db:"idle_nodes"`
+	CreatedOn       time.Time      `db:"created_on"`
+	ResumedOn       sql.NullTime   `db:"resumed_on"`
+	UpdatedOn       sql.NullTime   `db:"updated_on"`
+	Owner           string         `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+	IsExclusive     bool           `db:"is_exclusive"`
+	Application     sql.NullString `db:"application"`
+}
+
+func (row computePoolDBRow) toComputePool() *ComputePool {
+	cp := &ComputePool{
+		Name:           row.Name,
+		State:          ComputePoolState(row.State),
+		MinNodes:       row.MinNodes,
+		MaxNodes:       row.MaxNodes,
+		InstanceFamily: ComputePoolInstanceFamily(row.InstanceFamily),
+		NumServices:    row.NumServices,
+		NumJobs:        row.NumJobs,
+		AutoResume:     row.AutoResume,
+		ActiveNodes:    row.ActiveNodes,
+		IdleNodes:      row.IdleNodes,
+		CreatedOn:      row.CreatedOn,
+		Owner:          row.Owner,
+		Comment:        row.Comment.String,
+		IsExclusive:    row.IsExclusive,
+		Application:    row.Application.String,
+	}
+	if row.AutoSuspendSecs.Valid {
+		cp.AutoSuspendSecs = int(row.AutoSuspendSecs.Int64)
+	}
+	if row.ResumedOn.Valid {
+		cp.ResumedOn = row.ResumedOn.Time
+	}
+	if row.UpdatedOn.Valid {
+		cp.UpdatedOn = row.UpdatedOn.Time
+	}
+	return cp
+}
+
+func (c *computePools) Show(ctx context.Context, opts *ComputePoolShowOptions) ([]*ComputePool, error) {
+	if opts == nil {
+		opts = &ComputePoolShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []computePoolDBRow{}
+	err = c.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ComputePool, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toComputePool()
+	}
+	return resultList, nil
+}
+
+func (c *computePools) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error) {
+	computePools, err := c.Show(ctx, &ComputePoolShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, computePool := range computePools {
+		if computePool.ID().name == id.Name() {
+			return computePool, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type computePoolDescribeOptions struct {
+	describe    bool                    `ddl:"static" db:"DESCRIBE"`     //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" db:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *computePoolDescribeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// Describe returns the same columns as SHOW COMPUTE POOLS for a single compute pool.
+func (c *computePools) Describe(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error) {
+	opts := &computePoolDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := computePoolDBRow{}
+	err = c.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toComputePool(), nil
+}
+
+func (v *ComputePool) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
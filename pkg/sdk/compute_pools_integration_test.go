@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ComputePoolsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.ComputePools.Create(ctx, id, &ComputePoolCreateOptions{
+		MinNodes:           1,
+		MaxNodes:           1,
+		InstanceFamily:     ComputePoolInstanceFamilyCPUX64XS,
+		AutoResume:         Bool(false),
+		InitiallySuspended: Bool(true),
+		Comment:            String("test comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ComputePools.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	})
+
+	computePool, err := client.ComputePools.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), computePool.Name)
+	assert.Equal(t, ComputePoolInstanceFamilyCPUX64XS, computePool.InstanceFamily)
+	assert.Equal(t, ComputePoolStateSuspended, computePool.State)
+	assert.Equal(t, "test comment", computePool.Comment)
+
+	err = client.ComputePools.Alter(ctx, id, &ComputePoolAlterOptions{
+		Set: &ComputePoolSet{
+			MaxNodes:        Int(2),
+			AutoSuspendSecs: Int(300),
+		},
+	})
+	require.NoError(t, err)
+
+	computePool, err = client.ComputePools.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 2, computePool.MaxNodes)
+	assert.Equal(t, 300, computePool.AutoSuspendSecs)
+}
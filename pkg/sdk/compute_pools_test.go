@@ -0,0 +1,207 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePoolCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only required options", func(t *testing.T) {
+		opts := &ComputePoolCreateOptions{
+			name:           id,
+			MinNodes:       1,
+			MaxNodes:       1,
+			InstanceFamily: ComputePoolInstanceFamilyCPUX64XS,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE COMPUTE POOL %s MIN_NODES = 1 MAX_NODES = 1 INSTANCE_FAMILY = CPU_X64_XS`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &ComputePoolCreateOptions{
+			IfNotExists:        Bool(true),
+			name:               id,
+			MinNodes:           1,
+			MaxNodes:           3,
+			InstanceFamily:     ComputePoolInstanceFamilyGPUNVS,
+			AutoResume:         Bool(false),
+			InitiallySuspended: Bool(true),
+			AutoSuspendSecs:    Int(600),
+			Comment:            String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE COMPUTE POOL IF NOT EXISTS %s MIN_NODES = 1 MAX_NODES = 3 INSTANCE_FAMILY = GPU_NV_S AUTO_RESUME = false INITIALLY_SUSPENDED = true AUTO_SUSPEND_SECS = 600 COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("for application", func(t *testing.T) {
+		opts := &ComputePoolCreateOptions{
+			name:           id,
+			ForApplication: NewAccountObjectIdentifier("app"),
+			MinNodes:       1,
+			MaxNodes:       1,
+			InstanceFamily: ComputePoolInstanceFamilyCPUX64S,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE COMPUTE POOL %s FOR APPLICATION "app" MIN_NODES = 1 MAX_NODES = 1 INSTANCE_FAMILY = CPU_X64_S`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: max nodes lower than min nodes", func(t *testing.T) {
+		opts := &ComputePoolCreateOptions{
+			name:           id,
+			MinNodes:       2,
+			MaxNodes:       1,
+			InstanceFamily: ComputePoolInstanceFamilyCPUX64XS,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing instance family", func(t *testing.T) {
+		opts := &ComputePoolCreateOptions{
+			name:     id,
+			MinNodes: 1,
+			MaxNodes: 1,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestComputePoolAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			name:    id,
+			Suspend: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER COMPUTE POOL %s SUSPEND`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Resume:   Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER COMPUTE POOL IF EXISTS %s RESUME`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("stop all", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			name:    id,
+			StopAll: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER COMPUTE POOL %s STOP ALL`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			name: id,
+			Set: &ComputePoolSet{
+				MinNodes:        Int(1),
+				MaxNodes:        Int(2),
+				AutoResume:      Bool(true),
+				AutoSuspendSecs: Int(300),
+				Comment:         String("test comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER COMPUTE POOL %s SET MIN_NODES = 1 MAX_NODES = 2 AUTO_RESUME = true AUTO_SUSPEND_SECS = 300 COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			name: id,
+			Unset: &ComputePoolUnset{
+				AutoSuspendSecs: Bool(true),
+				Comment:         Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER COMPUTE POOL %s UNSET AUTO_SUSPEND_SECS,COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: suspend and set", func(t *testing.T) {
+		opts := &ComputePoolAlterOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Set: &ComputePoolSet{
+				Comment: String("test comment"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestComputePoolDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &ComputePoolDropOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP COMPUTE POOL %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestComputePoolShow(t *testing.T) {
+	t.Run("with like", func(t *testing.T) {
+		opts := &ComputePoolShowOptions{
+			Like: &Like{
+				Pattern: String("pool%"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW COMPUTE POOLS LIKE 'pool%'`
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestComputePoolDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &computePoolDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE COMPUTE POOL %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -1,12 +1,22 @@
 package sdk
 
 import (
+	"crypto/rsa"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	homedir "github.com/mitchellh/go-homedir"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/snowflakedb/gosnowflake"
+	"github.com/youmark/pkcs8"
+	"golang.org/x/crypto/ssh"
 )
 
 func DefaultConfig() *gosnowflake.Config {
@@ -30,30 +40,50 @@ func ProfileConfig(profile string) (*gosnowflake.Config, error) {
 	var config *gosnowflake.Config
 	if cfg, ok := configs[profile]; ok {
 		log.Printf("[DEBUG] loading config for profile: \"%s\"", profile)
-		config = cfg
+		config, err = cfg.toConfig()
+		if err != nil {
+			return nil, fmt.Errorf("invalid config for profile %s err = %w", profile, err)
+		}
 	}
 
 	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
 	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
-	if config.Region == "us-west-2" {
+	if config != nil && config.Region == "us-west-2" {
 		config.Region = ""
 	}
 
 	return config, nil
 }
 
+// MergeConfig overrides the settings of baseConfig with the settings set in mergeConfig. The authentication settings
+// (authenticator, password, private key and token) are overridden together, so that the authentication method of
+// baseConfig is not mixed with the credentials of another one.
 func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config) *gosnowflake.Config {
 	if baseConfig == nil {
 		return mergeConfig
 	}
+	if mergeConfig == nil {
+		return baseConfig
+	}
 	if mergeConfig.Account != "" {
 		baseConfig.Account = mergeConfig.Account
 	}
 	if mergeConfig.User != "" {
 		baseConfig.User = mergeConfig.User
 	}
-	if mergeConfig.Password != "" {
+	if hasAuthentication(mergeConfig) {
+		baseConfig.Authenticator = mergeConfig.Authenticator
+		baseConfig.OktaURL = mergeConfig.OktaURL
 		baseConfig.Password = mergeConfig.Password
+		baseConfig.PrivateKey = mergeConfig.PrivateKey
+		baseConfig.Token = mergeConfig.Token
+		baseConfig.TokenAccessor = mergeConfig.TokenAccessor
+	}
+	if mergeConfig.Passcode != "" {
+		baseConfig.Passcode = mergeConfig.Passcode
+	}
+	if mergeConfig.PasscodeInPassword {
+		baseConfig.PasscodeInPassword = true
 	}
 	if mergeConfig.Role != "" {
 		baseConfig.Role = mergeConfig.Role
@@ -64,9 +94,252 @@ func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config
 	if mergeConfig.Host != "" {
 		baseConfig.Host = mergeConfig.Host
 	}
+	if mergeConfig.Port != 0 {
+		baseConfig.Port = mergeConfig.Port
+	}
+	if mergeConfig.Protocol != "" {
+		baseConfig.Protocol = mergeConfig.Protocol
+	}
+	if mergeConfig.Warehouse != "" {
+		baseConfig.Warehouse = mergeConfig.Warehouse
+	}
+	if mergeConfig.Database != "" {
+		baseConfig.Database = mergeConfig.Database
+	}
+	if mergeConfig.Schema != "" {
+		baseConfig.Schema = mergeConfig.Schema
+	}
+	if mergeConfig.LoginTimeout != 0 {
+		baseConfig.LoginTimeout = mergeConfig.LoginTimeout
+	}
+	if mergeConfig.RequestTimeout != 0 {
+		baseConfig.RequestTimeout = mergeConfig.RequestTimeout
+	}
+	if mergeConfig.ClientTimeout != 0 {
+		baseConfig.ClientTimeout = mergeConfig.ClientTimeout
+	}
+	if mergeConfig.JWTExpireTimeout != 0 {
+		baseConfig.JWTExpireTimeout = mergeConfig.JWTExpireTimeout
+	}
+	if mergeConfig.InsecureMode {
+		baseConfig.InsecureMode = true
+	}
+	if mergeConfig.ValidateDefaultParameters != 0 {
+		baseConfig.ValidateDefaultParameters = mergeConfig.ValidateDefaultParameters
+	}
+	if mergeConfig.Application != "" {
+		baseConfig.Application = mergeConfig.Application
+	}
+	if len(mergeConfig.Params) > 0 {
+		params := make(map[string]*string, len(baseConfig.Params)+len(mergeConfig.Params))
+		for k, v := range baseConfig.Params {
+			params[k] = v
+		}
+		for k, v := range mergeConfig.Params {
+			params[k] = v
+		}
+		baseConfig.Params = params
+	}
 	return baseConfig
 }
 
+func hasAuthentication(config *gosnowflake.Config) bool {
+	return config.Authenticator != gosnowflake.AuthTypeSnowflake || config.Password != "" || config.PrivateKey != nil || config.Token != "" || config.TokenAccessor != nil
+}
+
+// ConfigDTO is a connection profile of the config file. Timeouts are in seconds.
+type ConfigDTO struct {
+	Account                   string            `toml:"account"`
+	User                      string            `toml:"user"`
+	Password                  string            `toml:"password"`
+	Host                      string            `toml:"host"`
+	Port                      int               `toml:"port"`
+	Protocol                  string            `toml:"protocol"`
+	Region                    string            `toml:"region"`
+	Role                      string            `toml:"role"`
+	Warehouse                 string            `toml:"warehouse"`
+	Database                  string            `toml:"database"`
+	Schema                    string            `toml:"schema"`
+	Authenticator             string            `toml:"authenticator"`
+	Passcode                  string            `toml:"passcode"`
+	PasscodeInPassword        bool              `toml:"passcode_in_password"`
+	PrivateKeyFile            string            `toml:"private_key_file"`
+	PrivateKeyPassphrase      string            `toml:"private_key_passphrase"`
+	Token                     string            `toml:"token"`
+	TokenFilePath             string            `toml:"token_file_path"`
+	LoginTimeout              int               `toml:"login_timeout"`
+	RequestTimeout            int               `toml:"request_timeout"`
+	ClientTimeout             int               `toml:"client_timeout"`
+	JWTExpireTimeout          int               `toml:"jwt_expire_timeout"`
+	ClientSessionKeepAlive    bool              `toml:"client_session_keep_alive"`
+	InsecureMode              bool              `toml:"insecure_mode"`
+	ValidateDefaultParameters *bool             `toml:"validate_default_parameters"`
+	Params                    map[string]string `toml:"params"`
+}
+
+func (c *ConfigDTO) toConfig() (*gosnowflake.Config, error) {
+	config := &gosnowflake.Config{
+		Account:            c.Account,
+		User:               c.User,
+		Password:           c.Password,
+		Host:               c.Host,
+		Port:               c.Port,
+		Protocol:           c.Protocol,
+		Region:             c.Region,
+		Role:               c.Role,
+		Warehouse:          c.Warehouse,
+		Database:           c.Database,
+		Schema:             c.Schema,
+		Passcode:           c.Passcode,
+		PasscodeInPassword: c.PasscodeInPassword,
+		Token:              c.Token,
+		LoginTimeout:       time.Duration(c.LoginTimeout) * time.Second,
+		RequestTimeout:     time.Duration(c.RequestTimeout) * time.Second,
+		ClientTimeout:      time.Duration(c.ClientTimeout) * time.Second,
+		JWTExpireTimeout:   time.Duration(c.JWTExpireTimeout) * time.Second,
+		InsecureMode:       c.InsecureMode,
+	}
+	if c.ValidateDefaultParameters != nil {
+		config.ValidateDefaultParameters = gosnowflake.ConfigBoolFalse
+		if *c.ValidateDefaultParameters {
+			config.ValidateDefaultParameters = gosnowflake.ConfigBoolTrue
+		}
+	}
+	if c.Authenticator != "" {
+		authenticator, oktaURL, err := ToAuthenticatorType(c.Authenticator)
+		if err != nil {
+			return nil, err
+		}
+		config.Authenticator = authenticator
+		config.OktaURL = oktaURL
+	}
+	if c.PrivateKeyFile != "" {
+		privateKeyBytes, err := ReadPrivateKeyFile(c.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := ParsePrivateKey(privateKeyBytes, []byte(c.PrivateKeyPassphrase))
+		if err != nil {
+			return nil, err
+		}
+		config.PrivateKey = privateKey
+		if c.Authenticator == "" {
+			config.Authenticator = gosnowflake.AuthTypeJwt
+		}
+	}
+	if c.TokenFilePath != "" {
+		token, err := ReadTokenFile(c.TokenFilePath)
+		if err != nil {
+			return nil, err
+		}
+		config.Token = token
+	}
+	if config.Token != "" && c.Authenticator == "" {
+		config.Authenticator = gosnowflake.AuthTypeOAuth
+	}
+	if len(c.Params) > 0 || c.ClientSessionKeepAlive {
+		config.Params = make(map[string]*string, len(c.Params)+1)
+		for k, v := range c.Params {
+			v := v
+			config.Params[k] = &v
+		}
+		if c.ClientSessionKeepAlive {
+			config.Params["client_session_keep_alive"] = String("true")
+		}
+	}
+	return config, nil
+}
+
+// ToAuthenticatorType returns the authenticator type for its name as accepted by Snowflake drivers, e.g.
+// SNOWFLAKE_JWT or EXTERNALBROWSER. An https URL selects native Okta authentication with the given Okta URL.
+func ToAuthenticatorType(authenticator string) (gosnowflake.AuthType, *url.URL, error) {
+	for _, authType := range []gosnowflake.AuthType{
+		gosnowflake.AuthTypeSnowflake,
+		gosnowflake.AuthTypeOAuth,
+		gosnowflake.AuthTypeExternalBrowser,
+		gosnowflake.AuthTypeJwt,
+		gosnowflake.AuthTypeTokenAccessor,
+		gosnowflake.AuthTypeUsernamePasswordMFA,
+	} {
+		if strings.EqualFold(authenticator, authType.String()) {
+			return authType, nil, nil
+		}
+	}
+	if strings.HasPrefix(strings.ToLower(authenticator), "https://") {
+		oktaURL, err := url.Parse(authenticator)
+		if err != nil {
+			return gosnowflake.AuthTypeSnowflake, nil, fmt.Errorf("invalid Okta URL %s err = %w", authenticator, err)
+		}
+		return gosnowflake.AuthTypeOkta, oktaURL, nil
+	}
+	return gosnowflake.AuthTypeSnowflake, nil, fmt.Errorf("unknown authenticator %s", authenticator)
+}
+
+// ReadTokenFile returns the token stored in the file at the given path, without surrounding whitespace.
+func ReadTokenFile(path string) (string, error) {
+	expandedPath, err := homedir.Expand(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path to token file err = %w", err)
+	}
+	token, err := os.ReadFile(expandedPath)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file err = %w", err)
+	}
+	if len(strings.TrimSpace(string(token))) == 0 {
+		return "", errors.New("token file is empty")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// ReadPrivateKeyFile returns the contents of the private key file at the given path.
+func ReadPrivateKeyFile(privateKeyPath string) ([]byte, error) {
+	expandedPrivateKeyPath, err := homedir.Expand(privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Path to private key err = %w", err)
+	}
+
+	privateKeyBytes, err := os.ReadFile(expandedPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read private key err = %w", err)
+	}
+
+	if len(privateKeyBytes) == 0 {
+		return nil, errors.New("private key is empty")
+	}
+
+	return privateKeyBytes, nil
+}
+
+// ParsePrivateKey parses a PEM encoded RSA private key, decrypting it with the passphrase if it is encrypted.
+func ParsePrivateKey(privateKeyBytes []byte, passhrase []byte) (*rsa.PrivateKey, error) {
+	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
+	if privateKeyBlock == nil {
+		return nil, fmt.Errorf("could not parse private key, key is not in PEM format")
+	}
+
+	if privateKeyBlock.Type == "ENCRYPTED PRIVATE KEY" {
+		if len(passhrase) == 0 {
+			return nil, fmt.Errorf("private key requires a passphrase, but private_key_passphrase was not supplied")
+		}
+		privateKey, err := pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes, passhrase)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse encrypted private key with passphrase, only ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc are supported err = %w", err)
+		}
+		return privateKey, nil
+	}
+
+	privateKey, err := ssh.ParseRawPrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse private key err = %w", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("privateKey not of type RSA")
+	}
+	return rsaPrivateKey, nil
+}
+
 func configFile() (string, error) {
 	// has the user overwridden the default config path?
 	if configPath, ok := os.LookupEnv("SNOWFLAKE_CONFIG_PATH"); ok {
@@ -110,7 +383,7 @@ func EnvConfig() *gosnowflake.Config {
 	return config
 }
 
-func loadConfigFile() (map[string]*gosnowflake.Config, error) {
+func loadConfigFile() (map[string]*ConfigDTO, error) {
 	path, err := configFile()
 	if err != nil {
 		return nil, err
@@ -119,11 +392,10 @@ func loadConfigFile() (map[string]*gosnowflake.Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	var s map[string]*gosnowflake.Config
+	var s map[string]*ConfigDTO
 	err = toml.Unmarshal(dat, &s)
 	if err != nil {
-		log.Printf("[DEBUG] error unmarshalling config file: %v\n", err)
-		return nil, nil
+		return nil, fmt.Errorf("could not parse config file %s err = %w", path, err)
 	}
 	return s, nil
 }
@@ -1,10 +1,17 @@
 package sdk
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -109,3 +116,109 @@ func setupEnvVars(t *testing.T, account, user, password, role, configPath string
 		os.Setenv("SNOWFLAKE_CONFIG_PATH", originalPath)
 	}
 }
+
+func TestProfileConfigFull(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKeyPath := testFile(t, "rsa_key.p8", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+	tokenPath := testFile(t, "token", []byte("token-from-file\n"))
+	c := fmt.Sprintf(`
+	[jwt]
+	account='TEST_ACCOUNT'
+	user='TEST_USER'
+	warehouse='TEST_WAREHOUSE'
+	private_key_file='%s'
+	login_timeout=30
+	request_timeout=60
+	client_session_keep_alive=true
+	validate_default_parameters=false
+
+	[jwt.params]
+	query_tag='terraform'
+
+	[oauth]
+	account='TEST_ACCOUNT'
+	token_file_path='%s'
+
+	[okta]
+	account='TEST_ACCOUNT'
+	authenticator='https://example.okta.com'
+	`, privateKeyPath, tokenPath)
+	configPath := testFile(t, "config", []byte(c))
+	cleanupEnvVars := setupEnvVars(t, "", "", "", "", configPath)
+	t.Cleanup(cleanupEnvVars)
+
+	config, err := ProfileConfig("jwt")
+	require.NoError(t, err)
+	assert.Equal(t, "TEST_WAREHOUSE", config.Warehouse)
+	assert.Equal(t, gosnowflake.AuthTypeJwt, config.Authenticator)
+	assert.True(t, privateKey.Equal(config.PrivateKey))
+	assert.Equal(t, 30*time.Second, config.LoginTimeout)
+	assert.Equal(t, time.Minute, config.RequestTimeout)
+	assert.Equal(t, gosnowflake.ConfigBoolFalse, config.ValidateDefaultParameters)
+	assert.Equal(t, "true", *config.Params["client_session_keep_alive"])
+	assert.Equal(t, "terraform", *config.Params["query_tag"])
+
+	config, err = ProfileConfig("oauth")
+	require.NoError(t, err)
+	assert.Equal(t, gosnowflake.AuthTypeOAuth, config.Authenticator)
+	assert.Equal(t, "token-from-file", config.Token)
+
+	config, err = ProfileConfig("okta")
+	require.NoError(t, err)
+	assert.Equal(t, gosnowflake.AuthTypeOkta, config.Authenticator)
+	assert.Equal(t, "example.okta.com", config.OktaURL.Host)
+
+	config, err = ProfileConfig("unknown")
+	require.NoError(t, err)
+	assert.Nil(t, config)
+}
+
+func TestMergeConfig(t *testing.T) {
+	t.Run("overrides set values", func(t *testing.T) {
+		base := &gosnowflake.Config{Account: "base", User: "base", Password: "base", Warehouse: "base", Params: map[string]*string{"a": String("base"), "b": String("base")}}
+		merge := &gosnowflake.Config{Account: "merge", Role: "merge", LoginTimeout: time.Second, Params: map[string]*string{"b": String("merge")}}
+		config := MergeConfig(base, merge)
+		assert.Equal(t, "merge", config.Account)
+		assert.Equal(t, "base", config.User)
+		assert.Equal(t, "base", config.Password)
+		assert.Equal(t, "merge", config.Role)
+		assert.Equal(t, "base", config.Warehouse)
+		assert.Equal(t, time.Second, config.LoginTimeout)
+		assert.Equal(t, "base", *config.Params["a"])
+		assert.Equal(t, "merge", *config.Params["b"])
+	})
+
+	t.Run("does not mix authentication methods", func(t *testing.T) {
+		base := &gosnowflake.Config{Authenticator: gosnowflake.AuthTypeJwt, PrivateKey: &rsa.PrivateKey{}}
+		merge := &gosnowflake.Config{Password: "password"}
+		config := MergeConfig(base, merge)
+		assert.Equal(t, gosnowflake.AuthTypeSnowflake, config.Authenticator)
+		assert.Nil(t, config.PrivateKey)
+		assert.Equal(t, "password", config.Password)
+	})
+
+	t.Run("nil configs", func(t *testing.T) {
+		config := &gosnowflake.Config{Account: "account"}
+		assert.Same(t, config, MergeConfig(nil, config))
+		assert.Same(t, config, MergeConfig(config, nil))
+	})
+}
+
+func TestToAuthenticatorType(t *testing.T) {
+	authenticator, _, err := ToAuthenticatorType("snowflake_jwt")
+	require.NoError(t, err)
+	assert.Equal(t, gosnowflake.AuthTypeJwt, authenticator)
+
+	authenticator, _, err = ToAuthenticatorType("EXTERNALBROWSER")
+	require.NoError(t, err)
+	assert.Equal(t, gosnowflake.AuthTypeExternalBrowser, authenticator)
+
+	authenticator, oktaURL, err := ToAuthenticatorType("https://example.okta.com")
+	require.NoError(t, err)
+	assert.Equal(t, gosnowflake.AuthTypeOkta, authenticator)
+	assert.Equal(t, "https://example.okta.com", oktaURL.String())
+
+	_, _, err = ToAuthenticatorType("unknown")
+	assert.Error(t, err)
+}
@@ -9,6 +9,7 @@ type ContextFunctions interface {
 	// Session functions.
 	CurrentAccount(ctx context.Context) (string, error)
 	CurrentSession(ctx context.Context) (string, error)
+	CurrentUser(ctx context.Context) (string, error)
 
 	// Session Object functions.
 	CurrentDatabase(ctx context.Context) (string, error)
@@ -45,6 +46,17 @@ func (c *contextFunctions) CurrentSession(ctx context.Context) (string, error) {
 	return s.CurrentSession, nil
 }
 
+func (c *contextFunctions) CurrentUser(ctx context.Context) (string, error) {
+	s := &struct {
+		CurrentUser string `db:"CURRENT_USER"`
+	}{}
+	err := c.client.queryOne(ctx, s, "SELECT CURRENT_USER() as CURRENT_USER")
+	if err != nil {
+		return "", err
+	}
+	return s.CurrentUser, nil
+}
+
 func (c *contextFunctions) CurrentDatabase(ctx context.Context) (string, error) {
 	s := &struct {
 		CurrentDatabase sql.NullString `db:"CURRENT_DATABASE"`
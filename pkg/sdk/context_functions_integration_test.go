@@ -26,6 +26,15 @@ func TestInt_CurrentSession(t *testing.T) {
 	assert.NotEmpty(t, session)
 }
 
+func TestInt_CurrentUser(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	user, err := client.ContextFunctions.CurrentUser(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, user)
+}
+
 func TestInt_CurrentDatabase(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
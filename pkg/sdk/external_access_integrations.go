@@ -0,0 +1,349 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ExternalAccessIntegrations = (*externalAccessIntegrations)(nil)
+
+// ExternalAccessIntegrations describes all the external access integration related methods that the
+// Snowflake API supports.
+type ExternalAccessIntegrations interface {
+	// Create creates a new external access integration.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationCreateOptions) error
+	// Alter modifies an existing external access integration.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationAlterOptions) error
+	// Drop removes an external access integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationDropOptions) error
+	// Show returns a list of external access integrations.
+	Show(ctx context.Context, opts *ExternalAccessIntegrationShowOptions) ([]*ExternalAccessIntegration, error)
+	// ShowByID returns an external access integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error)
+	// Describe returns the details of an external access integration.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error)
+}
+
+// externalAccessIntegrations implements ExternalAccessIntegrations.
+type externalAccessIntegrations struct {
+	client *Client
+}
+
+type ExternalAccessIntegrationCreateOptions struct {
+	create                    bool                    `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace                 *bool                   `ddl:"keyword" db:"OR REPLACE"`
+	externalAccessIntegration bool                    `ddl:"static" db:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists               *bool                   `ddl:"keyword" db:"IF NOT EXISTS"`
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+
+	AllowedNetworkRules                  []SchemaObjectIdentifier  `ddl:"parameter,parentheses" db:"ALLOWED_NETWORK_RULES"`
+	AllowedAPIAuthenticationIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" db:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier  `ddl:"parameter,parentheses" db:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Enabled                              bool                      `ddl:"parameter" db:"ENABLED"`
+	Comment                              *string                   `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *ExternalAccessIntegrationCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OrReplace and IfNotExists cannot both be true")
+	}
+	if !valueSet(opts.AllowedNetworkRules) {
+		return errors.New("AllowedNetworkRules must contain at least one network rule")
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Create(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationCreateOptions) error {
+	if opts == nil {
+		opts = &ExternalAccessIntegrationCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ExternalAccessIntegrationAlterOptions struct {
+	alter                     bool                            `ddl:"static" db:"ALTER"`                       //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                            `ddl:"static" db:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                  *bool                           `ddl:"keyword" db:"IF EXISTS"`
+	name                      AccountObjectIdentifier         `ddl:"identifier"`
+	Set                       *ExternalAccessIntegrationSet   `ddl:"keyword" db:"SET"`
+	Unset                     *ExternalAccessIntegrationUnset `ddl:"keyword" db:"UNSET"`
+}
+
+func (opts *ExternalAccessIntegrationAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ExternalAccessIntegrationSet struct {
+	AllowedNetworkRules                  []SchemaObjectIdentifier  `ddl:"parameter,parentheses" db:"ALLOWED_NETWORK_RULES"`
+	AllowedAPIAuthenticationIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" db:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier  `ddl:"parameter,parentheses" db:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Enabled                              *bool                     `ddl:"parameter" db:"ENABLED"`
+	Comment                              *string                   `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *ExternalAccessIntegrationSet) validate() error {
+	if everyValueNil(v.AllowedNetworkRules, v.AllowedAPIAuthenticationIntegrations, v.AllowedAuthenticationSecrets, v.Enabled, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return nil
+}
+
+type ExternalAccessIntegrationUnset struct {
+	AllowedAPIAuthenticationIntegrations *bool `ddl:"keyword" db:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         *bool `ddl:"keyword" db:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Comment                              *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *ExternalAccessIntegrationUnset) validate() error {
+	if !exactlyOneValueSet(v.AllowedAPIAuthenticationIntegrations, v.AllowedAuthenticationSecrets, v.Comment) {
+		return errors.New("exactly one parameter must be unset")
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Alter(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationAlterOptions) error {
+	if opts == nil {
+		opts = &ExternalAccessIntegrationAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ExternalAccessIntegrationDropOptions struct {
+	drop                      bool                    `ddl:"static" db:"DROP"`                        //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                    `ddl:"static" db:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                  *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *ExternalAccessIntegrationDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *ExternalAccessIntegrationDropOptions) error {
+	if opts == nil {
+		opts = &ExternalAccessIntegrationDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ExternalAccessIntegrationShowOptions represents the options for listing external access integrations.
+type ExternalAccessIntegrationShowOptions struct {
+	show                       bool  `ddl:"static" db:"SHOW"`                         //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegrations bool  `ddl:"static" db:"EXTERNAL ACCESS INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                       *Like `ddl:"keyword" db:"LIKE"`
+}
+
+func (opts *ExternalAccessIntegrationShowOptions) validate() error {
+	return nil
+}
+
+// ExternalAccessIntegration is a user friendly result for a SHOW EXTERNAL ACCESS INTEGRATIONS query.
+type ExternalAccessIntegration struct {
+	Name      string
+	Type      string
+	Category  string
+	Enabled   bool
+	Comment   string
+	CreatedOn time.Time
+}
+
+func (v *ExternalAccessIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// externalAccessIntegrationDBRow is used to decode the result of a SHOW EXTERNAL ACCESS INTEGRATIONS query.
+type externalAccessIntegrationDBRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row externalAccessIntegrationDBRow) toExternalAccessIntegration() *ExternalAccessIntegration {
+	return &ExternalAccessIntegration{
+		Name:      row.Name,
+		Type:      row.Type,
+		Category:  row.Category,
+		Enabled:   row.Enabled,
+		Comment:   row.Comment.String,
+		CreatedOn: row.CreatedOn,
+	}
+}
+
+func (v *externalAccessIntegrations) Show(ctx context.Context, opts *ExternalAccessIntegrationShowOptions) ([]*ExternalAccessIntegration, error) {
+	if opts == nil {
+		opts = &ExternalAccessIntegrationShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []externalAccessIntegrationDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ExternalAccessIntegration, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toExternalAccessIntegration()
+	}
+	return resultList, nil
+}
+
+func (v *externalAccessIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error) {
+	integrations, err := v.Show(ctx, &ExternalAccessIntegrationShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, integration := range integrations {
+		if integration.Name == id.Name() {
+			return integration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type externalAccessIntegrationDescribeOptions struct {
+	describe                  bool                    `ddl:"static" db:"DESCRIBE"`                    //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                    `ddl:"static" db:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *externalAccessIntegrationDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ExternalAccessIntegrationDetails is a user friendly result for a DESCRIBE EXTERNAL ACCESS INTEGRATION query.
+type ExternalAccessIntegrationDetails struct {
+	Enabled                              bool
+	AllowedNetworkRules                  []SchemaObjectIdentifier
+	AllowedAPIAuthenticationIntegrations []AccountObjectIdentifier
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier
+	Comment                              string
+}
+
+type integrationPropertyRow struct {
+	Property        string         `db:"property"`
+	PropertyType    string         `db:"property_type"`
+	PropertyValue   sql.NullString `db:"property_value"`
+	PropertyDefault sql.NullString `db:"property_default"`
+}
+
+// parseSchemaObjectIdentifierList parses a list of fully qualified names as returned by DESCRIBE, e.g. [DB.SCHEMA.RULE1, DB.SCHEMA.RULE2].
+func parseSchemaObjectIdentifierList(s string) []SchemaObjectIdentifier {
+	ids := make([]SchemaObjectIdentifier, 0)
+	for _, item := range parseBracketedList(s) {
+		parts := strings.Split(item, ".")
+		if len(parts) != 3 {
+			continue
+		}
+		ids = append(ids, NewSchemaObjectIdentifier(strings.Trim(parts[0], `"`), strings.Trim(parts[1], `"`), strings.Trim(parts[2], `"`)))
+	}
+	return ids
+}
+
+func (v *externalAccessIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error) {
+	opts := &externalAccessIntegrationDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []integrationPropertyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	details := &ExternalAccessIntegrationDetails{
+		AllowedNetworkRules:                  make([]SchemaObjectIdentifier, 0),
+		AllowedAPIAuthenticationIntegrations: make([]AccountObjectIdentifier, 0),
+		AllowedAuthenticationSecrets:         make([]SchemaObjectIdentifier, 0),
+	}
+	for _, row := range dest {
+		switch row.Property {
+		case "ENABLED":
+			details.Enabled = row.PropertyValue.String == "true"
+		case "ALLOWED_NETWORK_RULES":
+			details.AllowedNetworkRules = parseSchemaObjectIdentifierList(row.PropertyValue.String)
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			for _, name := range parseBracketedList(row.PropertyValue.String) {
+				details.AllowedAPIAuthenticationIntegrations = append(details.AllowedAPIAuthenticationIntegrations, NewAccountObjectIdentifier(name))
+			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			details.AllowedAuthenticationSecrets = parseSchemaObjectIdentifierList(row.PropertyValue.String)
+		case "COMMENT":
+			details.Comment = row.PropertyValue.String
+		}
+	}
+	return details, nil
+}
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrationsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	ruleID := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+	err := client.NetworkRules.Create(ctx, ruleID, &NetworkRuleCreateOptions{
+		Type:      NetworkRuleTypeHostPort,
+		ValueList: []NetworkRuleValue{{Value: "example.com"}},
+		Mode:      NetworkRuleModeEgress,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.NetworkRules.Drop(ctx, ruleID, nil)
+		require.NoError(t, err)
+	})
+
+	id := randomAccountObjectIdentifier(t)
+	err = client.ExternalAccessIntegrations.Create(ctx, id, &ExternalAccessIntegrationCreateOptions{
+		AllowedNetworkRules: []SchemaObjectIdentifier{ruleID},
+		Enabled:             true,
+		Comment:             String("test comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ExternalAccessIntegrations.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	})
+
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), integration.Name)
+	assert.True(t, integration.Enabled)
+
+	err = client.ExternalAccessIntegrations.Alter(ctx, id, &ExternalAccessIntegrationAlterOptions{
+		Set: &ExternalAccessIntegrationSet{
+			Enabled: Bool(false),
+		},
+	})
+	require.NoError(t, err)
+
+	details, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, details.Enabled)
+	assert.Equal(t, []SchemaObjectIdentifier{ruleID}, details.AllowedNetworkRules)
+}
@@ -0,0 +1,148 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegrationCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("validation: no network rules", func(t *testing.T) {
+		opts := &ExternalAccessIntegrationCreateOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with required options", func(t *testing.T) {
+		rule := randomSchemaObjectIdentifier(t)
+		opts := &ExternalAccessIntegrationCreateOptions{
+			name:                id,
+			AllowedNetworkRules: []SchemaObjectIdentifier{rule},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s) ENABLED = false`, id.FullyQualifiedName(), rule.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		rule1 := randomSchemaObjectIdentifier(t)
+		rule2 := randomSchemaObjectIdentifier(t)
+		integration := randomAccountObjectIdentifier(t)
+		secret := randomSchemaObjectIdentifier(t)
+		opts := &ExternalAccessIntegrationCreateOptions{
+			OrReplace:                            Bool(true),
+			name:                                 id,
+			AllowedNetworkRules:                  []SchemaObjectIdentifier{rule1, rule2},
+			AllowedAPIAuthenticationIntegrations: []AccountObjectIdentifier{integration},
+			AllowedAuthenticationSecrets:         []SchemaObjectIdentifier{secret},
+			Enabled:                              true,
+			Comment:                              String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s,%s) ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = true COMMENT = 'test comment'`,
+			id.FullyQualifiedName(), rule1.FullyQualifiedName(), rule2.FullyQualifiedName(), integration.FullyQualifiedName(), secret.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestExternalAccessIntegrationAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		secret := randomSchemaObjectIdentifier(t)
+		opts := &ExternalAccessIntegrationAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &ExternalAccessIntegrationSet{
+				AllowedAuthenticationSecrets: []SchemaObjectIdentifier{secret},
+				Enabled:                      Bool(false),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION IF EXISTS %s SET ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = false`, id.FullyQualifiedName(), secret.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &ExternalAccessIntegrationAlterOptions{
+			name: id,
+			Unset: &ExternalAccessIntegrationUnset{
+				AllowedAuthenticationSecrets: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION %s UNSET ALLOWED_AUTHENTICATION_SECRETS`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &ExternalAccessIntegrationAlterOptions{
+			name: id,
+			Set:  &ExternalAccessIntegrationSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestExternalAccessIntegrationDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &ExternalAccessIntegrationDropOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP EXTERNAL ACCESS INTEGRATION IF EXISTS %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestExternalAccessIntegrationShow(t *testing.T) {
+	t.Run("with like", func(t *testing.T) {
+		opts := &ExternalAccessIntegrationShowOptions{
+			Like: &Like{
+				Pattern: String("test"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test'`
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestExternalAccessIntegrationDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &externalAccessIntegrationDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE EXTERNAL ACCESS INTEGRATION %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestParseSchemaObjectIdentifierList(t *testing.T) {
+	ids := parseSchemaObjectIdentifierList(`[DB.SCHEMA.RULE1, "DB"."SCHEMA"."RULE2"]`)
+	require.Len(t, ids, 2)
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE1"), ids[0])
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "RULE2"), ids[1])
+}
@@ -29,6 +29,87 @@ func NewObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) Object
 	return NewAccountObjectIdentifier(fullyQualifiedName)
 }
 
+// NewObjectIdentifierFromParts returns the identifier of the object named by its database, schema, object and column
+// names, depending on how many names are given. Unlike the constructors, it keeps the names as they are, quotes
+// included.
+func NewObjectIdentifierFromParts(parts ...string) (ObjectIdentifier, error) {
+	for i, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("part %d of the identifier is empty", i+1)
+		}
+	}
+	switch len(parts) {
+	case 1:
+		return AccountObjectIdentifier{name: parts[0]}, nil
+	case 2:
+		return SchemaIdentifier{databaseName: parts[0], schemaName: parts[1]}, nil
+	case 3:
+		return SchemaObjectIdentifier{databaseName: parts[0], schemaName: parts[1], name: parts[2]}, nil
+	case 4:
+		return TableColumnIdentifier{databaseName: parts[0], schemaName: parts[1], tableName: parts[2], columnName: parts[3]}, nil
+	}
+	return nil, fmt.Errorf("an identifier has 1 to 4 parts, got %d", len(parts))
+}
+
+// ParseObjectIdentifier parses a fully qualified name such as "db"."schema"."name" or db.schema.name. Unlike
+// NewObjectIdentifierFromFullyQualifiedName, dots within quoted parts belong to the part.
+func ParseObjectIdentifier(fullyQualifiedName string) (ObjectIdentifier, error) {
+	var parts []string
+	var part strings.Builder
+	quoted := false
+	for i := 0; i < len(fullyQualifiedName); i++ {
+		c := fullyQualifiedName[i]
+		switch {
+		case c == '"' && quoted && i+1 < len(fullyQualifiedName) && fullyQualifiedName[i+1] == '"':
+			// doubled quotes stand for a quote within a quoted part
+			part.WriteByte(c)
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted part in identifier %v", fullyQualifiedName)
+	}
+	parts = append(parts, part.String())
+	id, err := NewObjectIdentifierFromParts(parts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid identifier %v: %w", fullyQualifiedName, err)
+	}
+	return id, nil
+}
+
+// quoteIdentifierPart quotes a name of an identifier, doubling the quotes within it.
+func quoteIdentifierPart(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// trimIdentifierQuotes removes the quotes surrounding a name, if any, and keeps the quotes within it.
+func trimIdentifierQuotes(name string) string {
+	if len(name) >= 2 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+// ObjectIdentifierParts returns the database, schema, object and column names making up the identifier.
+func ObjectIdentifierParts(id ObjectIdentifier) []string {
+	switch v := id.(type) {
+	case SchemaIdentifier:
+		return []string{v.DatabaseName(), v.Name()}
+	case SchemaObjectIdentifier:
+		return []string{v.DatabaseName(), v.SchemaName(), v.Name()}
+	case TableColumnIdentifier:
+		return []string{v.DatabaseName(), v.SchemaName(), v.TableName(), v.Name()}
+	}
+	return []string{id.Name()}
+}
+
 // for objects that live in other accounts
 type ExternalObjectIdentifier struct {
 	objectIdentifier  ObjectIdentifier
@@ -88,8 +169,8 @@ type AccountIdentifier struct {
 
 func NewAccountIdentifier(organizationName, accountName string) AccountIdentifier {
 	return AccountIdentifier{
-		organizationName: strings.Trim(organizationName, `"`),
-		accountName:      strings.Trim(accountName, `"`),
+		organizationName: trimIdentifierQuotes(organizationName),
+		accountName:      trimIdentifierQuotes(accountName),
 	}
 }
 
@@ -122,7 +203,7 @@ func (i AccountObjectIdentifier) FullyQualifiedName() string {
 	if i.name == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"`, i.name)
+	return quoteIdentifierPart(i.name)
 }
 
 type SchemaIdentifier struct {
@@ -132,16 +213,16 @@ type SchemaIdentifier struct {
 
 func NewSchemaIdentifier(databaseName, schemaName string) SchemaIdentifier {
 	return SchemaIdentifier{
-		databaseName: strings.Trim(databaseName, `"`),
-		schemaName:   strings.Trim(schemaName, `"`),
+		databaseName: trimIdentifierQuotes(databaseName),
+		schemaName:   trimIdentifierQuotes(schemaName),
 	}
 }
 
 func NewSchemaIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaIdentifier {
 	parts := strings.Split(fullyQualifiedName, ".")
 	return SchemaIdentifier{
-		databaseName: strings.Trim(parts[0], `"`),
-		schemaName:   strings.Trim(parts[1], `"`),
+		databaseName: trimIdentifierQuotes(parts[0]),
+		schemaName:   trimIdentifierQuotes(parts[1]),
 	}
 }
 
@@ -157,7 +238,7 @@ func (i SchemaIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"`, i.databaseName, i.schemaName)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName)
 }
 
 type SchemaObjectIdentifier struct {
@@ -168,18 +249,18 @@ type SchemaObjectIdentifier struct {
 
 func NewSchemaObjectIdentifier(databaseName, schemaName, name string) SchemaObjectIdentifier {
 	return SchemaObjectIdentifier{
-		databaseName: strings.Trim(databaseName, `"`),
-		schemaName:   strings.Trim(schemaName, `"`),
-		name:         strings.Trim(name, `"`),
+		databaseName: trimIdentifierQuotes(databaseName),
+		schemaName:   trimIdentifierQuotes(schemaName),
+		name:         trimIdentifierQuotes(name),
 	}
 }
 
 func NewSchemaObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaObjectIdentifier {
 	parts := strings.Split(fullyQualifiedName, ".")
 	return SchemaObjectIdentifier{
-		databaseName: strings.Trim(parts[0], `"`),
-		schemaName:   strings.Trim(parts[1], `"`),
-		name:         strings.Trim(parts[2], `"`),
+		databaseName: trimIdentifierQuotes(parts[0]),
+		schemaName:   trimIdentifierQuotes(parts[1]),
+		name:         trimIdentifierQuotes(parts[2]),
 	}
 }
 
@@ -199,7 +280,7 @@ func (i SchemaObjectIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.name == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName) + "." + quoteIdentifierPart(i.name)
 }
 
 type TableColumnIdentifier struct {
@@ -216,10 +297,10 @@ func NewTableColumnIdentifier(databaseName, schemaName, tableName, columnName st
 func NewTableColumnIdentifierFromFullyQualifiedName(fullyQualifiedName string) TableColumnIdentifier {
 	parts := strings.Split(fullyQualifiedName, ".")
 	return TableColumnIdentifier{
-		databaseName: strings.Trim(parts[0], `"`),
-		schemaName:   strings.Trim(parts[1], `"`),
-		tableName:    strings.Trim(parts[2], `"`),
-		columnName:   strings.Trim(parts[3], `"`),
+		databaseName: trimIdentifierQuotes(parts[0]),
+		schemaName:   trimIdentifierQuotes(parts[1]),
+		tableName:    trimIdentifierQuotes(parts[2]),
+		columnName:   trimIdentifierQuotes(parts[3]),
 	}
 }
 
@@ -243,5 +324,5 @@ func (i TableColumnIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.tableName == "" && i.columnName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"."%v"`, i.databaseName, i.schemaName, i.tableName, i.columnName)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName) + "." + quoteIdentifierPart(i.tableName) + "." + quoteIdentifierPart(i.columnName)
 }
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObjectIdentifierFromParts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		parts    []string
+		expected string
+		err      string
+	}{
+		{name: "account object", parts: []string{"db"}, expected: `"db"`},
+		{name: "schema", parts: []string{"db", "schema"}, expected: `"db"."schema"`},
+		{name: "schema object", parts: []string{"db", "schema", "name"}, expected: `"db"."schema"."name"`},
+		{name: "table column", parts: []string{"db", "schema", "table", "column"}, expected: `"db"."schema"."table"."column"`},
+		{name: "quoted parts", parts: []string{`"db"`, `"schema"`, `"name"`}, expected: `"""db"""."""schema"""."""name"""`},
+		{name: "quotes within parts", parts: []string{`d"b`, `sch"ema`, `name"`}, expected: `"d""b"."sch""ema"."name"""`},
+		{name: "no parts", parts: nil, err: "an identifier has 1 to 4 parts, got 0"},
+		{name: "too many parts", parts: []string{"a", "b", "c", "d", "e"}, err: "an identifier has 1 to 4 parts, got 5"},
+		{name: "empty part", parts: []string{"db", "", "name"}, err: "part 2 of the identifier is empty"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := NewObjectIdentifierFromParts(tc.parts...)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, id.FullyQualifiedName())
+		})
+	}
+}
+
+func TestParseObjectIdentifier(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+		err      string
+	}{
+		{name: "account object", input: `"db"`, expected: []string{"db"}},
+		{name: "unquoted schema object", input: "db.schema.name", expected: []string{"db", "schema", "name"}},
+		{name: "quoted schema object", input: `"db"."schema"."name"`, expected: []string{"db", "schema", "name"}},
+		{name: "dots in quoted parts", input: `"db"."my.schema"."my.name"`, expected: []string{"db", "my.schema", "my.name"}},
+		{name: "doubled quotes", input: `"db"."schema"."my""name"`, expected: []string{"db", "schema", `my"name`}},
+		{name: "quoted quotes", input: `"db"."schema"."""name"""`, expected: []string{"db", "schema", `"name"`}},
+		{name: "table column", input: `"db"."schema"."table"."column"`, expected: []string{"db", "schema", "table", "column"}},
+		{name: "unterminated quote", input: `"db"."schema`, err: `unterminated quoted part in identifier "db"."schema`},
+		{name: "empty part", input: `"db".."name"`, err: `invalid identifier "db".."name": part 2 of the identifier is empty`},
+		{name: "too many parts", input: "a.b.c.d.e", err: "invalid identifier a.b.c.d.e: an identifier has 1 to 4 parts, got 5"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := ParseObjectIdentifier(tc.input)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ObjectIdentifierParts(id))
+
+			parsed, err := ParseObjectIdentifier(id.FullyQualifiedName())
+			require.NoError(t, err)
+			assert.Equal(t, id, parsed)
+		})
+	}
+}
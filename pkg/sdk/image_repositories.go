@@ -0,0 +1,260 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ImageRepositories = (*imageRepositories)(nil)
+
+// ImageRepositories describes all the image repository related methods that the
+// Snowflake API supports.
+type ImageRepositories interface {
+	// Create creates a new image repository.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryCreateOptions) error
+	// Alter modifies an existing image repository.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryAlterOptions) error
+	// Drop removes an image repository.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryDropOptions) error
+	// Show returns a list of image repositories.
+	Show(ctx context.Context, opts *ImageRepositoryShowOptions) ([]*ImageRepository, error)
+	// ShowByID returns an image repository by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error)
+}
+
+// imageRepositories implements ImageRepositories.
+type imageRepositories struct {
+	client *Client
+}
+
+type ImageRepositoryCreateOptions struct {
+	create          bool                   `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace       *bool                  `ddl:"keyword" db:"OR REPLACE"`
+	imageRepository bool                   `ddl:"static" db:"IMAGE REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists     *bool                  `ddl:"keyword" db:"IF NOT EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+
+	Comment *string `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *ImageRepositoryCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OrReplace and IfNotExists cannot both be true")
+	}
+	return nil
+}
+
+func (v *imageRepositories) Create(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryCreateOptions) error {
+	if opts == nil {
+		opts = &ImageRepositoryCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ImageRepositoryAlterOptions struct {
+	alter           bool                   `ddl:"static" db:"ALTER"`            //lint:ignore U1000 This is used in the ddl tag
+	imageRepository bool                   `ddl:"static" db:"IMAGE REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists        *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+	Set             *ImageRepositorySet    `ddl:"keyword" db:"SET"`
+	Unset           *ImageRepositoryUnset  `ddl:"keyword" db:"UNSET"`
+}
+
+func (opts *ImageRepositoryAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ImageRepositorySet struct {
+	Comment *string `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *ImageRepositorySet) validate() error {
+	if everyValueNil(v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return nil
+}
+
+type ImageRepositoryUnset struct {
+	Comment *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *ImageRepositoryUnset) validate() error {
+	if everyValueNil(v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *imageRepositories) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryAlterOptions) error {
+	if opts == nil {
+		opts = &ImageRepositoryAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ImageRepositoryDropOptions struct {
+	drop            bool                   `ddl:"static" db:"DROP"`             //lint:ignore U1000 This is used in the ddl tag
+	imageRepository bool                   `ddl:"static" db:"IMAGE REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists        *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *ImageRepositoryDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *imageRepositories) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *ImageRepositoryDropOptions) error {
+	if opts == nil {
+		opts = &ImageRepositoryDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ImageRepositoryShowOptions represents the options for listing image repositories.
+type ImageRepositoryShowOptions struct {
+	show              bool  `ddl:"static" db:"SHOW"`               //lint:ignore U1000 This is used in the ddl tag
+	imageRepositories bool  `ddl:"static" db:"IMAGE REPOSITORIES"` //lint:ignore U1000 This is used in the ddl tag
+	Like              *Like `ddl:"keyword" db:"LIKE"`
+	In                *In   `ddl:"keyword" db:"IN"`
+}
+
+func (opts *ImageRepositoryShowOptions) validate() error {
+	return nil
+}
+
+// ImageRepository is a user friendly result for a SHOW IMAGE REPOSITORIES query.
+type ImageRepository struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	RepositoryURL string
+	Owner         string
+	OwnerRoleType string
+	Comment       string
+}
+
+func (v *ImageRepository) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// imageRepositoryDBRow is used to decode the result of a SHOW IMAGE REPOSITORIES query.
+type imageRepositoryDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	RepositoryURL string         `db:"repository_url"`
+	Owner         string         `db:"owner"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+	Comment       sql.NullString `db:"comment"`
+}
+
+func (row imageRepositoryDBRow) toImageRepository() *ImageRepository {
+	return &ImageRepository{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		RepositoryURL: row.RepositoryURL,
+		Owner:         row.Owner,
+		OwnerRoleType: row.OwnerRoleType.String,
+		Comment:       row.Comment.String,
+	}
+}
+
+func (v *imageRepositories) Show(ctx context.Context, opts *ImageRepositoryShowOptions) ([]*ImageRepository, error) {
+	if opts == nil {
+		opts = &ImageRepositoryShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []imageRepositoryDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ImageRepository, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toImageRepository()
+	}
+	return resultList, nil
+}
+
+func (v *imageRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error) {
+	imageRepositories, err := v.Show(ctx, &ImageRepositoryShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, imageRepository := range imageRepositories {
+		if imageRepository.ID().name == id.Name() {
+			return imageRepository, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ImageRepositoriesCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+	err := client.ImageRepositories.Create(ctx, id, &ImageRepositoryCreateOptions{
+		Comment: String("test comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ImageRepositories.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	})
+
+	imageRepository, err := client.ImageRepositories.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), imageRepository.Name)
+	assert.Equal(t, "test comment", imageRepository.Comment)
+	assert.NotEmpty(t, imageRepository.RepositoryURL)
+
+	err = client.ImageRepositories.Alter(ctx, id, &ImageRepositoryAlterOptions{
+		Unset: &ImageRepositoryUnset{
+			Comment: Bool(true),
+		},
+	})
+	require.NoError(t, err)
+
+	imageRepository, err = client.ImageRepositories.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "", imageRepository.Comment)
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageRepositoryCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &ImageRepositoryCreateOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			Comment:     String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE IMAGE REPOSITORY IF NOT EXISTS %s COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &ImageRepositoryCreateOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestImageRepositoryAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &ImageRepositoryAlterOptions{
+			name: id,
+			Set: &ImageRepositorySet{
+				Comment: String("test comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER IMAGE REPOSITORY %s SET COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &ImageRepositoryAlterOptions{
+			name: id,
+			Unset: &ImageRepositoryUnset{
+				Comment: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER IMAGE REPOSITORY %s UNSET COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestImageRepositoryDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &ImageRepositoryDropOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP IMAGE REPOSITORY %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestImageRepositoryShow(t *testing.T) {
+	t.Run("in schema", func(t *testing.T) {
+		opts := &ImageRepositoryShowOptions{
+			In: &In{
+				Schema: NewSchemaIdentifier("db", "schema"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW IMAGE REPOSITORIES IN SCHEMA "db"."schema"`
+		assert.Equal(t, expected, actual)
+	})
+}
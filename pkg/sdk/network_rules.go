@@ -0,0 +1,392 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ NetworkRules = (*networkRules)(nil)
+
+// NetworkRules describes all the network rule related methods that the
+// Snowflake API supports.
+type NetworkRules interface {
+	// Create creates a new network rule.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleCreateOptions) error
+	// Alter modifies an existing network rule.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleAlterOptions) error
+	// Drop removes a network rule.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleDropOptions) error
+	// Show returns a list of network rules.
+	Show(ctx context.Context, opts *NetworkRuleShowOptions) ([]*NetworkRule, error)
+	// ShowByID returns a network rule by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error)
+	// Describe returns the details of a network rule.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error)
+}
+
+// networkRules implements NetworkRules.
+type networkRules struct {
+	client *Client
+}
+
+type NetworkRuleType string
+
+var (
+	NetworkRuleTypeIPv4        NetworkRuleType = "IPV4"
+	NetworkRuleTypeAWSVPCEID   NetworkRuleType = "AWSVPCEID"
+	NetworkRuleTypeAzureLinkID NetworkRuleType = "AZURELINKID"
+	NetworkRuleTypeHostPort    NetworkRuleType = "HOST_PORT"
+)
+
+type NetworkRuleMode string
+
+var (
+	NetworkRuleModeIngress       NetworkRuleMode = "INGRESS"
+	NetworkRuleModeInternalStage NetworkRuleMode = "INTERNAL_STAGE"
+	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
+)
+
+// NetworkRuleValue is a single entry of the VALUE_LIST of a network rule.
+type NetworkRuleValue struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+type NetworkRuleCreateOptions struct {
+	create      bool                   `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" db:"OR REPLACE"`
+	networkRule bool                   `ddl:"static" db:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	Type      NetworkRuleType    `ddl:"parameter" db:"TYPE"`
+	ValueList []NetworkRuleValue `ddl:"parameter,parentheses" db:"VALUE_LIST"`
+	Mode      NetworkRuleMode    `ddl:"parameter" db:"MODE"`
+	Comment   *string            `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *NetworkRuleCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.Type == "" {
+		return errors.New("Type must be set")
+	}
+	if opts.Mode == "" {
+		return errors.New("Mode must be set")
+	}
+	return validateNetworkRuleTypeMode(opts.Type, opts.Mode)
+}
+
+// validateNetworkRuleTypeMode checks that the rule type is allowed for the given mode.
+func validateNetworkRuleTypeMode(ruleType NetworkRuleType, mode NetworkRuleMode) error {
+	switch mode {
+	case NetworkRuleModeIngress:
+		if ruleType != NetworkRuleTypeIPv4 && ruleType != NetworkRuleTypeAWSVPCEID && ruleType != NetworkRuleTypeAzureLinkID {
+			return fmt.Errorf("Type %s is not supported with Mode %s", ruleType, mode)
+		}
+	case NetworkRuleModeInternalStage:
+		if ruleType != NetworkRuleTypeAWSVPCEID {
+			return fmt.Errorf("Type %s is not supported with Mode %s", ruleType, mode)
+		}
+	case NetworkRuleModeEgress:
+		if ruleType != NetworkRuleTypeHostPort {
+			return fmt.Errorf("Type %s is not supported with Mode %s", ruleType, mode)
+		}
+	default:
+		return fmt.Errorf("Mode must be one of %s, %s, %s", NetworkRuleModeIngress, NetworkRuleModeInternalStage, NetworkRuleModeEgress)
+	}
+	return nil
+}
+
+func (v *networkRules) Create(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleCreateOptions) error {
+	if opts == nil {
+		opts = &NetworkRuleCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type NetworkRuleAlterOptions struct {
+	alter       bool                   `ddl:"static" db:"ALTER"`        //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" db:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Set         *NetworkRuleSet        `ddl:"keyword" db:"SET"`
+	Unset       *NetworkRuleUnset      `ddl:"keyword" db:"UNSET"`
+}
+
+func (opts *NetworkRuleAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NetworkRuleSet struct {
+	ValueList []NetworkRuleValue `ddl:"parameter,parentheses" db:"VALUE_LIST"`
+	Comment   *string            `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *NetworkRuleSet) validate() error {
+	if everyValueNil(v.ValueList, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return nil
+}
+
+type NetworkRuleUnset struct {
+	ValueList *bool `ddl:"keyword" db:"VALUE_LIST"`
+	Comment   *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *NetworkRuleUnset) validate() error {
+	if !exactlyOneValueSet(v.ValueList, v.Comment) {
+		return errors.New("exactly one parameter must be unset")
+	}
+	return nil
+}
+
+func (v *networkRules) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleAlterOptions) error {
+	if opts == nil {
+		opts = &NetworkRuleAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type NetworkRuleDropOptions struct {
+	drop        bool                   `ddl:"static" db:"DROP"`         //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" db:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *NetworkRuleDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *networkRules) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *NetworkRuleDropOptions) error {
+	if opts == nil {
+		opts = &NetworkRuleDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// NetworkRuleShowOptions represents the options for listing network rules.
+type NetworkRuleShowOptions struct {
+	show         bool  `ddl:"static" db:"SHOW"`          //lint:ignore U1000 This is used in the ddl tag
+	networkRules bool  `ddl:"static" db:"NETWORK RULES"` //lint:ignore U1000 This is used in the ddl tag
+	Like         *Like `ddl:"keyword" db:"LIKE"`
+	In           *In   `ddl:"keyword" db:"IN"`
+}
+
+func (opts *NetworkRuleShowOptions) validate() error {
+	return nil
+}
+
+// NetworkRule is a user friendly result for a SHOW NETWORK RULES query.
+type NetworkRule struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Owner              string
+	Comment            string
+	Type               NetworkRuleType
+	Mode               NetworkRuleMode
+	EntriesInValueList int
+}
+
+func (v *NetworkRule) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// networkRuleDBRow is used to decode the result of a SHOW NETWORK RULES query.
+type networkRuleDBRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Owner              string         `db:"owner"`
+	Comment            sql.NullString `db:"comment"`
+	Type               string         `db:"type"`
+	Mode               string         `db:"mode"`
+	EntriesInValueList int            `db:"entries_in_valuelist"`
+	OwnerRoleType      string         `db:"owner_role_type"`
+}
+
+func (row networkRuleDBRow) toNetworkRule() *NetworkRule {
+	return &NetworkRule{
+		CreatedOn:          row.CreatedOn,
+		Name:               row.Name,
+		DatabaseName:       row.DatabaseName,
+		SchemaName:         row.SchemaName,
+		Owner:              row.Owner,
+		Comment:            row.Comment.String,
+		Type:               NetworkRuleType(row.Type),
+		Mode:               NetworkRuleMode(row.Mode),
+		EntriesInValueList: row.EntriesInValueList,
+	}
+}
+
+func (v *networkRules) Show(ctx context.Context, opts *NetworkRuleShowOptions) ([]*NetworkRule, error) {
+	if opts == nil {
+		opts = &NetworkRuleShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []networkRuleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*NetworkRule, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toNetworkRule()
+	}
+	return resultList, nil
+}
+
+func (v *networkRules) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error) {
+	networkRules, err := v.Show(ctx, &NetworkRuleShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, networkRule := range networkRules {
+		if networkRule.ID().name == id.Name() {
+			return networkRule, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type networkRuleDescribeOptions struct {
+	describe    bool                   `ddl:"static" db:"DESCRIBE"`     //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" db:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *networkRuleDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// NetworkRuleDetails is a user friendly result for a DESCRIBE NETWORK RULE query.
+type NetworkRuleDetails struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Comment      string
+	Type         NetworkRuleType
+	Mode         NetworkRuleMode
+	ValueList    []string
+}
+
+type networkRuleDetailsRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        string         `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Type         string         `db:"type"`
+	Mode         string         `db:"mode"`
+	ValueList    sql.NullString `db:"value_list"`
+}
+
+func (row *networkRuleDetailsRow) toNetworkRuleDetails() *NetworkRuleDetails {
+	details := &NetworkRuleDetails{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner,
+		Comment:      row.Comment.String,
+		Type:         NetworkRuleType(row.Type),
+		Mode:         NetworkRuleMode(row.Mode),
+		ValueList:    make([]string, 0),
+	}
+	if row.ValueList.Valid {
+		details.ValueList = parseBracketedList(row.ValueList.String)
+	}
+	return details
+}
+
+func (v *networkRules) Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error) {
+	opts := &networkRuleDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := networkRuleDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toNetworkRuleDetails(), nil
+}
@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_NetworkRulesCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+	err := client.NetworkRules.Create(ctx, id, &NetworkRuleCreateOptions{
+		Type:      NetworkRuleTypeHostPort,
+		ValueList: []NetworkRuleValue{{Value: "example.com"}},
+		Mode:      NetworkRuleModeEgress,
+		Comment:   String("test comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.NetworkRules.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	})
+
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), networkRule.Name)
+	assert.Equal(t, NetworkRuleTypeHostPort, networkRule.Type)
+	assert.Equal(t, NetworkRuleModeEgress, networkRule.Mode)
+	assert.Equal(t, 1, networkRule.EntriesInValueList)
+
+	err = client.NetworkRules.Alter(ctx, id, &NetworkRuleAlterOptions{
+		Set: &NetworkRuleSet{
+			ValueList: []NetworkRuleValue{{Value: "example.com"}, {Value: "example.org:443"}},
+		},
+	})
+	require.NoError(t, err)
+
+	details, err := client.NetworkRules.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"example.com", "example.org:443"}, details.ValueList)
+}
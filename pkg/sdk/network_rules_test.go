@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRuleCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &NetworkRuleCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Type:      NetworkRuleTypeHostPort,
+			ValueList: []NetworkRuleValue{{Value: "example.com"}, {Value: "example.com:443"}},
+			Mode:      NetworkRuleModeEgress,
+			Comment:   String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE NETWORK RULE %s TYPE = HOST_PORT VALUE_LIST = ('example.com','example.com:443') MODE = EGRESS COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: type not allowed for mode", func(t *testing.T) {
+		opts := &NetworkRuleCreateOptions{
+			name: id,
+			Type: NetworkRuleTypeIPv4,
+			Mode: NetworkRuleModeEgress,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing mode", func(t *testing.T) {
+		opts := &NetworkRuleCreateOptions{
+			name: id,
+			Type: NetworkRuleTypeIPv4,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestNetworkRuleAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &NetworkRuleAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &NetworkRuleSet{
+				ValueList: []NetworkRuleValue{{Value: "0.0.0.0/0"}},
+				Comment:   String("test comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER NETWORK RULE IF EXISTS %s SET VALUE_LIST = ('0.0.0.0/0') COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &NetworkRuleAlterOptions{
+			name: id,
+			Unset: &NetworkRuleUnset{
+				ValueList: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER NETWORK RULE %s UNSET VALUE_LIST`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestNetworkRuleDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &NetworkRuleDropOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP NETWORK RULE %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestNetworkRuleShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &NetworkRuleShowOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW NETWORK RULES"
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestNetworkRuleDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &networkRuleDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE NETWORK RULE %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -17,16 +17,22 @@ type ObjectType string
 
 const (
 	ObjectTypeAccountParameter ObjectType = "ACCOUNT PARAMETER"
+	ObjectTypeComputePool      ObjectType = "COMPUTE POOL"
 	ObjectTypeDatabase         ObjectType = "DATABASE"
 	ObjectTypeFailoverGroup    ObjectType = "FAILOVER GROUP"
+	ObjectTypeImageRepository  ObjectType = "IMAGE REPOSITORY"
 	ObjectTypeIntegration      ObjectType = "INTEGRATION"
 	ObjectTypeMaskingPolicy    ObjectType = "MASKING POLICY"
 	ObjectTypeNetworkPolicy    ObjectType = "NETWORK POLICY"
+	ObjectTypeNetworkRule      ObjectType = "NETWORK RULE"
 	ObjectTypePasswordPolicy   ObjectType = "PASSWORD POLICY"
 	ObjectTypeResourceMonitor  ObjectType = "RESOURCE MONITOR"
 	ObjectTypeRole             ObjectType = "ROLE"
 	ObjectTypeSchema           ObjectType = "SCHEMA"
+	ObjectTypeSecret           ObjectType = "SECRET"
+	ObjectTypeService          ObjectType = "SERVICE"
 	ObjectTypeShare            ObjectType = "SHARE"
+	ObjectTypeStreamlit        ObjectType = "STREAMLIT"
 	ObjectTypeUser             ObjectType = "USER"
 	ObjectTypeWarehouse        ObjectType = "WAREHOUSE"
 )
@@ -34,6 +40,8 @@ const (
 func ObjectTypeFromPluralString(s string) ObjectType {
 	// only care about the "ies" endings.
 	switch s {
+	case "IMAGE REPOSITORIES":
+		return ObjectTypeImageRepository
 	case "MASKING POLICIES":
 		return ObjectTypeMaskingPolicy
 	case "NETWORK POLICIES":
@@ -52,6 +60,8 @@ func (o ObjectType) String() string {
 func (o ObjectType) Plural() string {
 	// only care about the "ies" endings.
 	switch o {
+	case ObjectTypeImageRepository:
+		return "IMAGE REPOSITORIES"
 	case ObjectTypeMaskingPolicy:
 		return "MASKING POLICIES"
 	case ObjectTypeNetworkPolicy:
@@ -67,6 +77,7 @@ func (o ObjectType) Plural() string {
 func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentifier {
 	accountIdentifiers := []ObjectType{
 		ObjectTypeAccountParameter,
+		ObjectTypeComputePool,
 		ObjectTypeDatabase,
 		ObjectTypeFailoverGroup,
 		ObjectTypeIntegration,
@@ -0,0 +1,251 @@
+package sdk
+
+import (
+	"database/sql"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// readCaches holds the read caches enabled with EnableReadCache, keyed by connection.
+var readCaches sync.Map
+
+// EnableReadCache enables the read cache for the clients and legacy reads using db. The results of SHOW statements are
+// kept for the lifetime of db, which for the provider is a single plan, refresh or apply, and are invalidated by any
+// write that names the same object.
+func EnableReadCache(db *sql.DB) {
+	readCaches.LoadOrStore(db, newReadCache())
+}
+
+// InvalidateReadCache drops the cached results that the write stmt may have made stale. It does nothing when the read
+// cache is not enabled for db.
+func InvalidateReadCache(db *sql.DB, stmt string) {
+	if cache := readCacheFor(db); cache != nil {
+		cache.invalidate(stmt)
+	}
+}
+
+// SelectCached runs stmt against db and scans the rows into dest, which is expected to be a pointer to a slice of
+// structs. SHOW statements are served from the read cache when it is enabled for db. Unlike the client, driver errors
+// are returned as they are, for the legacy reads that inspect them.
+func SelectCached(db *sql.DB, dest interface{}, stmt string) error {
+	cache := readCacheFor(db)
+	if cache.load(stmt, dest) {
+		return nil
+	}
+	log.Print("[DEBUG] query stmt ", stmt)
+	if err := sqlx.NewDb(db, "snowflake").Unsafe().Select(dest, stmt); err != nil {
+		return err
+	}
+	cache.store(stmt, dest)
+	return nil
+}
+
+func readCacheFor(db *sql.DB) *readCache {
+	if cache, ok := readCaches.Load(db); ok {
+		return cache.(*readCache)
+	}
+	return nil
+}
+
+type readCacheEntry struct {
+	names []string
+	rows  reflect.Value
+}
+
+// readCache keeps the rows returned by SHOW statements, keyed by normalized statement. A nil cache never hits.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]readCacheEntry
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: make(map[string]readCacheEntry)}
+}
+
+// load copies the cached rows of stmt into dest and reports whether there were any.
+func (c *readCache) load(stmt string, dest interface{}) bool {
+	if c == nil || !isCacheable(stmt) {
+		return false
+	}
+	c.mu.Lock()
+	entry, ok := c.entries[normalizeStatement(stmt)]
+	c.mu.Unlock()
+	if !ok {
+		return false
+	}
+	v := reflect.ValueOf(dest).Elem()
+	if v.Type() != entry.rows.Type() {
+		return false
+	}
+	log.Print("[DEBUG] read cache hit ", stmt)
+	v.Set(copySlice(entry.rows))
+	return true
+}
+
+// store keeps a copy of the rows of stmt scanned into dest.
+func (c *readCache) store(stmt string, dest interface{}) {
+	if c == nil || !isCacheable(stmt) {
+		return
+	}
+	v := reflect.ValueOf(dest).Elem()
+	if v.Kind() != reflect.Slice {
+		return
+	}
+	key := normalizeStatement(stmt)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = readCacheEntry{names: scopedStatementNames(key, showKeywords), rows: copySlice(v)}
+}
+
+// invalidate drops the entries naming an object that the write stmt names, or an object containing or contained in
+// one of them, and the entries that name no object at all. Dropping too much only costs another read.
+func (c *readCache) invalidate(stmt string) {
+	if c == nil {
+		return
+	}
+	names := scopedStatementNames(normalizeStatement(stmt), nil)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if len(entry.names) == 0 || namesOverlap(entry.names, names) {
+			log.Print("[DEBUG] read cache invalidated ", key)
+			delete(c.entries, key)
+		}
+	}
+}
+
+func copySlice(v reflect.Value) reflect.Value {
+	copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(copied, v)
+	return copied
+}
+
+func isCacheable(stmt string) bool {
+	return strings.HasPrefix(normalizeStatement(stmt), "SHOW ")
+}
+
+// normalizeStatement collapses whitespace and upper cases everything outside quotes, so that statements differing
+// only in formatting share their cached rows.
+func normalizeStatement(stmt string) string {
+	var b strings.Builder
+	var quote rune
+	space := false
+	for _, r := range strings.TrimSpace(stmt) {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			b.WriteRune(r)
+			continue
+		case r == '\'' || r == '"':
+			quote = r
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		}
+		if space {
+			b.WriteRune(' ')
+			space = false
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return strings.TrimRight(b.String(), "; ")
+}
+
+// showKeywords are the words of SHOW statements that do not name objects.
+var showKeywords = map[string]bool{
+	"SHOW": true, "TERSE": true, "GRANTS": true, "FUTURE": true, "ON": true, "IN": true, "TO": true, "OF": true,
+	"LIKE": true, "STARTS": true, "WITH": true, "LIMIT": true, "FROM": true, "HISTORY": true,
+	"ACCOUNT": true, "DATABASE": true, "SCHEMA": true, "ROLE": true, "DATABASE_ROLE": true, "SHARE": true,
+	"APPLICATION": true, "USER": true, "TABLE": true, "TABLES": true, "VIEW": true, "VIEWS": true, "OBJECTS": true,
+	"PRIMARY": true, "KEYS": true, "COLUMNS": true,
+}
+
+// statementNames returns the object names and string literals of a normalized statement, with the parts of qualified
+// names unquoted and joined by dots. Words in keywords are left out.
+func statementNames(stmt string, keywords map[string]bool) []string {
+	var names []string
+	var parts []string
+	var part strings.Builder
+	endPart := func() {
+		parts = append(parts, part.String())
+		part.Reset()
+	}
+	endName := func() {
+		if part.Len() > 0 {
+			endPart()
+		}
+		name := strings.Join(parts, ".")
+		if name != "" && !(len(parts) == 1 && keywords[name]) {
+			names = append(names, name)
+		}
+		parts = nil
+	}
+	runes := []rune(stmt)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"' || r == '\'':
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				part.WriteRune(runes[i])
+			}
+			if r == '\'' {
+				endName()
+			}
+		case r == '.':
+			endPart()
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$':
+			part.WriteRune(r)
+		default:
+			endName()
+		}
+	}
+	endName()
+	return names
+}
+
+// accountScope names the account itself among the names of statements on the account, such as SHOW GRANTS ON ACCOUNT,
+// GRANT ... ON ACCOUNT or ALTER ACCOUNT, which otherwise name no object. It cannot be the name of an object.
+const accountScope = "<ACCOUNT>"
+
+// scopedStatementNames returns the statementNames of a normalized statement, with accountScope for statements on the
+// account.
+func scopedStatementNames(stmt string, keywords map[string]bool) []string {
+	names := statementNames(stmt, keywords)
+	if isAccountScoped(stmt) {
+		names = append(names, accountScope)
+	}
+	return names
+}
+
+// isAccountScoped reports whether a normalized statement reads or changes the account itself: its grants, parameters
+// or settings.
+func isAccountScoped(stmt string) bool {
+	words := strings.Fields(stmt)
+	if len(words) >= 2 && words[0] == "ALTER" && words[1] == "ACCOUNT" {
+		return true
+	}
+	for i := 1; i < len(words); i++ {
+		if words[i] == "ACCOUNT" && (words[i-1] == "ON" || words[i-1] == "IN") {
+			return true
+		}
+	}
+	return false
+}
+
+func namesOverlap(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y || strings.HasPrefix(x, y+".") || strings.HasPrefix(y, x+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type readCacheRow struct {
+	Name string `db:"name"`
+}
+
+func newReadCacheMock(t *testing.T, enabled bool) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	if enabled {
+		EnableReadCache(db)
+	}
+	t.Cleanup(func() {
+		readCaches.Delete(db)
+		db.Close()
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	return db, mock
+}
+
+func TestReadCache(t *testing.T) {
+	ctx := context.Background()
+	show := `SHOW GRANTS ON TABLE "DB"."SCHEMA"."TABLE"`
+
+	t.Run("serves repeated show statements from the cache", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a").AddRow("b"))
+		client := NewClientFromDB(db)
+
+		var first []readCacheRow
+		require.NoError(t, client.query(ctx, &first, show))
+		var second []readCacheRow
+		require.NoError(t, client.query(ctx, &second, "show  grants on table \"DB\".\"SCHEMA\".\"TABLE\";"))
+
+		assert.Equal(t, []readCacheRow{{Name: "a"}, {Name: "b"}}, second)
+		second[0].Name = "changed"
+		assert.Equal(t, "a", first[0].Name)
+	})
+
+	t.Run("invalidates on writes to the same object", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectExec(`GRANT SELECT ON TABLE DB.SCHEMA."TABLE" TO ROLE R`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a").AddRow("b"))
+		client := NewClientFromDB(db)
+
+		var rows []readCacheRow
+		require.NoError(t, client.query(ctx, &rows, show))
+		_, err := client.exec(ctx, `GRANT SELECT ON TABLE DB.SCHEMA."TABLE" TO ROLE R`)
+		require.NoError(t, err)
+		require.NoError(t, client.query(ctx, &rows, show))
+		assert.Len(t, rows, 2)
+	})
+
+	t.Run("keeps results of other objects on writes", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectExec(`GRANT SELECT ON TABLE "DB"."SCHEMA"."OTHER" TO ROLE R`).WillReturnResult(sqlmock.NewResult(0, 0))
+		client := NewClientFromDB(db)
+
+		var rows []readCacheRow
+		require.NoError(t, client.query(ctx, &rows, show))
+		_, err := client.exec(ctx, `GRANT SELECT ON TABLE "DB"."SCHEMA"."OTHER" TO ROLE R`)
+		require.NoError(t, err)
+		require.NoError(t, client.query(ctx, &rows, show))
+	})
+
+	t.Run("invalidates account grants on account writes", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		showAccount := `SHOW GRANTS ON ACCOUNT`
+		mock.ExpectQuery(showAccount).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectExec(`GRANT CREATE DATABASE ON ACCOUNT TO ROLE "R"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(showAccount).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a").AddRow("b"))
+		mock.ExpectExec(`REVOKE CREATE DATABASE ON ACCOUNT FROM ROLE "R"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(showAccount).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		client := NewClientFromDB(db)
+
+		var rows []readCacheRow
+		require.NoError(t, client.query(ctx, &rows, showAccount))
+		_, err := client.exec(ctx, `GRANT CREATE DATABASE ON ACCOUNT TO ROLE "R"`)
+		require.NoError(t, err)
+		require.NoError(t, client.query(ctx, &rows, showAccount))
+		assert.Len(t, rows, 2)
+		_, err = client.exec(ctx, `REVOKE CREATE DATABASE ON ACCOUNT FROM ROLE "R"`)
+		require.NoError(t, err)
+		require.NoError(t, client.query(ctx, &rows, showAccount))
+		assert.Len(t, rows, 1)
+	})
+
+	t.Run("invalidates account parameters on account alters", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		showParameters := `SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT`
+		mock.ExpectQuery(showParameters).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectQuery(showParameters).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("b"))
+
+		var rows []readCacheRow
+		require.NoError(t, SelectCached(db, &rows, showParameters))
+		InvalidateReadCache(db, `ALTER ACCOUNT SET TIMEZONE = 'UTC'`)
+		require.NoError(t, SelectCached(db, &rows, showParameters))
+		assert.Equal(t, "b", rows[0].Name)
+	})
+
+	t.Run("invalidates entries naming no object on any write", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		showGrants := `SHOW GRANTS`
+		mock.ExpectQuery(showGrants).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectQuery(showGrants).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+
+		var rows []readCacheRow
+		require.NoError(t, SelectCached(db, &rows, showGrants))
+		InvalidateReadCache(db, `CREATE ROLE "R"`)
+		require.NoError(t, SelectCached(db, &rows, showGrants))
+	})
+
+	t.Run("invalidates on legacy writes", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		showFuture := `SHOW FUTURE GRANTS IN SCHEMA "DB"."SCHEMA"`
+		mock.ExpectQuery(showFuture).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectQuery(showFuture).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+
+		var rows []readCacheRow
+		require.NoError(t, SelectCached(db, &rows, showFuture))
+		InvalidateReadCache(db, `GRANT SELECT ON FUTURE TABLES IN SCHEMA "DB"."SCHEMA" TO ROLE "R"`)
+		require.NoError(t, SelectCached(db, &rows, showFuture))
+	})
+
+	t.Run("does not cache other statements", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		mock.ExpectQuery("SELECT CURRENT_ROLE() AS NAME").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectQuery("SELECT CURRENT_ROLE() AS NAME").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		client := NewClientFromDB(db)
+
+		var rows []readCacheRow
+		require.NoError(t, client.query(ctx, &rows, "SELECT CURRENT_ROLE() AS NAME"))
+		require.NoError(t, client.query(ctx, &rows, "SELECT CURRENT_ROLE() AS NAME"))
+	})
+
+	t.Run("does not cache when disabled", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, false)
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+
+		var rows []readCacheRow
+		require.NoError(t, SelectCached(db, &rows, show))
+		require.NoError(t, SelectCached(db, &rows, show))
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		db, mock := newReadCacheMock(t, true)
+		queryErr := errors.New("SQL compilation error: Table 'TABLE' does not exist or not authorized.")
+		mock.ExpectQuery(show).WillReturnError(queryErr)
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+
+		var rows []readCacheRow
+		assert.ErrorIs(t, SelectCached(db, &rows, show), queryErr)
+		require.NoError(t, SelectCached(db, &rows, show))
+		assert.Len(t, rows, 1)
+	})
+}
+
+func TestNormalizeStatement(t *testing.T) {
+	assert.Equal(t, `SHOW TABLES LIKE 'my_table' IN SCHEMA "db"."Schema"`, normalizeStatement(" show tables\n  like 'my_table'   in schema \"db\".\"Schema\" ;"))
+}
+
+func TestStatementNames(t *testing.T) {
+	assert.Equal(t, []string{"DB.SCHEMA.TABLE"}, statementNames(`SHOW GRANTS ON TABLE "DB"."SCHEMA"."TABLE"`, showKeywords))
+	assert.Equal(t, []string{"my_table", "db.Schema"}, statementNames(`SHOW TABLES LIKE 'my_table' IN SCHEMA "db"."Schema"`, showKeywords))
+	assert.Equal(t, []string{"GRANT", "USAGE", "ON", "DATABASE", "DB", "TO", "ROLE", "R"}, statementNames(`GRANT USAGE ON DATABASE DB TO ROLE "R"`, nil))
+}
+
+func TestIsAccountScoped(t *testing.T) {
+	assert.True(t, isAccountScoped(`SHOW GRANTS ON ACCOUNT`))
+	assert.True(t, isAccountScoped(`SHOW PARAMETERS IN ACCOUNT`))
+	assert.True(t, isAccountScoped(`GRANT MONITOR USAGE ON ACCOUNT TO ROLE "R"`))
+	assert.True(t, isAccountScoped(`ALTER ACCOUNT SET TIMEZONE = 'UTC'`))
+	assert.False(t, isAccountScoped(`SHOW GRANTS ON DATABASE "ACCOUNT"`))
+	assert.False(t, isAccountScoped(`CREATE ACCOUNT A ADMIN_NAME = U`))
+}
+
+func TestNamesOverlap(t *testing.T) {
+	assert.True(t, namesOverlap([]string{"DB.SCHEMA"}, []string{"DB.SCHEMA.TABLE"}))
+	assert.True(t, namesOverlap([]string{"DB.SCHEMA.TABLE"}, []string{"DB"}))
+	assert.False(t, namesOverlap([]string{"DB.SCHEMA.TABLE"}, []string{"DB.SCHEMA.TABLE2"}))
+	assert.False(t, namesOverlap([]string{"DB.SCHEMA"}, []string{"DB.SCHEMA2"}))
+}
@@ -0,0 +1,490 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ResourceMonitors = (*resourceMonitors)(nil)
+
+// ResourceMonitors describes all the resource monitor related methods that the
+// Snowflake API supports.
+type ResourceMonitors interface {
+	// Create creates a new resource monitor.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *ResourceMonitorCreateOptions) error
+	// Alter modifies an existing resource monitor.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *ResourceMonitorAlterOptions) error
+	// Drop removes a resource monitor.
+	Drop(ctx context.Context, id AccountObjectIdentifier) error
+	// Show returns a list of resource monitors.
+	Show(ctx context.Context, opts *ResourceMonitorShowOptions) ([]*ResourceMonitor, error)
+	// ShowByID returns a resource monitor by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ResourceMonitor, error)
+	// SetOnAccount sets the resource monitor on the current account.
+	SetOnAccount(ctx context.Context, id AccountObjectIdentifier) error
+	// UnsetOnAccount removes the resource monitor set on the current account.
+	UnsetOnAccount(ctx context.Context) error
+	// ValidateNotifyUsers checks that the users to be notified exist.
+	ValidateNotifyUsers(ctx context.Context, users []NotifyUser) error
+}
+
+// resourceMonitors implements ResourceMonitors.
+type resourceMonitors struct {
+	client *Client
+}
+
+type Frequency string
+
+var (
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyYearly  Frequency = "YEARLY"
+	FrequencyNever   Frequency = "NEVER"
+)
+
+type TriggerAction string
+
+var (
+	TriggerActionSuspend          TriggerAction = "SUSPEND"
+	TriggerActionSuspendImmediate TriggerAction = "SUSPEND_IMMEDIATE"
+	TriggerActionNotify           TriggerAction = "NOTIFY"
+)
+
+type ResourceMonitorLevel string
+
+var (
+	ResourceMonitorLevelAccount   ResourceMonitorLevel = "ACCOUNT"
+	ResourceMonitorLevelWarehouse ResourceMonitorLevel = "WAREHOUSE"
+)
+
+// TriggerDefinition is a single ON <threshold> PERCENT DO <action> entry of the TRIGGERS of a resource monitor.
+type TriggerDefinition struct {
+	Threshold     int           `ddl:"parameter,no_equals" db:"ON"`
+	TriggerAction TriggerAction `ddl:"parameter,no_equals" db:"PERCENT DO"`
+}
+
+// NotifyUser is a single entry of the NOTIFY_USERS of a resource monitor.
+type NotifyUser struct {
+	Name string `ddl:"keyword,single_quotes"`
+}
+
+// validateTriggers checks that the thresholds are positive and that there is at most one suspend and one suspend
+// immediate trigger, which is all Snowflake allows.
+func validateTriggers(triggers []TriggerDefinition) error {
+	actions := map[TriggerAction]int{}
+	for _, trigger := range triggers {
+		if trigger.Threshold <= 0 {
+			return fmt.Errorf("Threshold of %s trigger must be greater than 0", trigger.TriggerAction)
+		}
+		switch trigger.TriggerAction {
+		case TriggerActionSuspend, TriggerActionSuspendImmediate, TriggerActionNotify:
+		default:
+			return fmt.Errorf("TriggerAction must be one of %s, %s, %s", TriggerActionSuspend, TriggerActionSuspendImmediate, TriggerActionNotify)
+		}
+		actions[trigger.TriggerAction]++
+	}
+	if actions[TriggerActionSuspend] > 1 || actions[TriggerActionSuspendImmediate] > 1 {
+		return errors.New("at most one SUSPEND and one SUSPEND_IMMEDIATE trigger can be defined")
+	}
+	return nil
+}
+
+type ResourceMonitorWith struct {
+	CreditQuota    *int                `ddl:"parameter" db:"CREDIT_QUOTA"`
+	Frequency      *Frequency          `ddl:"parameter" db:"FREQUENCY"`
+	StartTimestamp *string             `ddl:"parameter,single_quotes" db:"START_TIMESTAMP"`
+	EndTimestamp   *string             `ddl:"parameter,single_quotes" db:"END_TIMESTAMP"`
+	NotifyUsers    []NotifyUser        `ddl:"parameter,parentheses" db:"NOTIFY_USERS"`
+	Triggers       []TriggerDefinition `ddl:"keyword,no_comma" db:"TRIGGERS"`
+}
+
+type ResourceMonitorCreateOptions struct {
+	create          bool                    `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace       *bool                   `ddl:"keyword" db:"OR REPLACE"`
+	resourceMonitor bool                    `ddl:"static" db:"RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
+	name            AccountObjectIdentifier `ddl:"identifier"`
+	With            *ResourceMonitorWith    `ddl:"keyword" db:"WITH"`
+}
+
+func (opts *ResourceMonitorCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if valueSet(opts.With) {
+		if valueSet(opts.With.Frequency) != valueSet(opts.With.StartTimestamp) {
+			return errors.New("Frequency and StartTimestamp must be set together")
+		}
+		if err := validateTriggers(opts.With.Triggers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *resourceMonitors) Create(ctx context.Context, id AccountObjectIdentifier, opts *ResourceMonitorCreateOptions) error {
+	if opts == nil {
+		opts = &ResourceMonitorCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ResourceMonitorAlterOptions struct {
+	alter           bool                    `ddl:"static" db:"ALTER"`            //lint:ignore U1000 This is used in the ddl tag
+	resourceMonitor bool                    `ddl:"static" db:"RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists        *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name            AccountObjectIdentifier `ddl:"identifier"`
+	Set             *ResourceMonitorSet     `ddl:"keyword" db:"SET"`
+	// Triggers replaces all triggers of the resource monitor.
+	Triggers []TriggerDefinition `ddl:"keyword,no_comma" db:"TRIGGERS"`
+}
+
+func (opts *ResourceMonitorAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueNil(opts.Set, opts.Triggers) {
+		return errors.New("at least one of Set, Triggers must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	return validateTriggers(opts.Triggers)
+}
+
+type ResourceMonitorSet struct {
+	CreditQuota    *int         `ddl:"parameter" db:"CREDIT_QUOTA"`
+	Frequency      *Frequency   `ddl:"parameter" db:"FREQUENCY"`
+	StartTimestamp *string      `ddl:"parameter,single_quotes" db:"START_TIMESTAMP"`
+	EndTimestamp   *string      `ddl:"parameter,single_quotes" db:"END_TIMESTAMP"`
+	NotifyUsers    []NotifyUser `ddl:"parameter,parentheses" db:"NOTIFY_USERS"`
+}
+
+func (v *ResourceMonitorSet) validate() error {
+	if everyValueNil(v.CreditQuota, v.Frequency, v.StartTimestamp, v.EndTimestamp, v.NotifyUsers) {
+		return errors.New("must set at least one parameter")
+	}
+	if valueSet(v.Frequency) != valueSet(v.StartTimestamp) {
+		return errors.New("Frequency and StartTimestamp must be set together")
+	}
+	return nil
+}
+
+func (v *resourceMonitors) Alter(ctx context.Context, id AccountObjectIdentifier, opts *ResourceMonitorAlterOptions) error {
+	if opts == nil {
+		opts = &ResourceMonitorAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type resourceMonitorDropOptions struct {
+	drop            bool                    `ddl:"static" db:"DROP"`             //lint:ignore U1000 This is used in the ddl tag
+	resourceMonitor bool                    `ddl:"static" db:"RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
+	name            AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *resourceMonitorDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *resourceMonitors) Drop(ctx context.Context, id AccountObjectIdentifier) error {
+	opts := &resourceMonitorDropOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ResourceMonitorShowOptions represents the options for listing resource monitors.
+type ResourceMonitorShowOptions struct {
+	show             bool  `ddl:"static" db:"SHOW"`              //lint:ignore U1000 This is used in the ddl tag
+	resourceMonitors bool  `ddl:"static" db:"RESOURCE MONITORS"` //lint:ignore U1000 This is used in the ddl tag
+	Like             *Like `ddl:"keyword" db:"LIKE"`
+}
+
+func (opts *ResourceMonitorShowOptions) validate() error {
+	return nil
+}
+
+// ResourceMonitor is a user friendly result for a SHOW RESOURCE MONITORS query.
+type ResourceMonitor struct {
+	Name               string
+	CreditQuota        float64
+	UsedCredits        float64
+	RemainingCredits   float64
+	Level              ResourceMonitorLevel
+	Frequency          Frequency
+	StartTime          string
+	EndTime            string
+	NotifyAt           []int
+	SuspendAt          *int
+	SuspendImmediateAt *int
+	CreatedOn          time.Time
+	Owner              string
+	Comment            string
+	NotifyUsers        []string
+}
+
+func (v *ResourceMonitor) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// Triggers returns the triggers of the resource monitor.
+func (v *ResourceMonitor) Triggers() []TriggerDefinition {
+	triggers := make([]TriggerDefinition, 0, len(v.NotifyAt)+2)
+	for _, threshold := range v.NotifyAt {
+		triggers = append(triggers, TriggerDefinition{Threshold: threshold, TriggerAction: TriggerActionNotify})
+	}
+	if v.SuspendAt != nil {
+		triggers = append(triggers, TriggerDefinition{Threshold: *v.SuspendAt, TriggerAction: TriggerActionSuspend})
+	}
+	if v.SuspendImmediateAt != nil {
+		triggers = append(triggers, TriggerDefinition{Threshold: *v.SuspendImmediateAt, TriggerAction: TriggerActionSuspendImmediate})
+	}
+	return triggers
+}
+
+// resourceMonitorDBRow is used to decode the result of a SHOW RESOURCE MONITORS query.
+type resourceMonitorDBRow struct {
+	Name               string         `db:"name"`
+	CreditQuota        sql.NullString `db:"credit_quota"`
+	UsedCredits        sql.NullString `db:"used_credits"`
+	RemainingCredits   sql.NullString `db:"remaining_credits"`
+	Level              sql.NullString `db:"level"`
+	Frequency          sql.NullString `db:"frequency"`
+	StartTime          sql.NullString `db:"start_time"`
+	EndTime            sql.NullString `db:"end_time"`
+	NotifyAt           sql.NullString `db:"notify_at"`
+	SuspendAt          sql.NullString `db:"suspend_at"`
+	SuspendImmediateAt sql.NullString `db:"suspend_immediately_at"`
+	CreatedOn          time.Time      `db:"created_on"`
+	Owner              string         `db:"owner"`
+	Comment            sql.NullString `db:"comment"`
+	NotifyUsers        sql.NullString `db:"notify_users"`
+}
+
+func (row *resourceMonitorDBRow) toResourceMonitor() (*ResourceMonitor, error) {
+	rm := &ResourceMonitor{
+		Name:        row.Name,
+		Level:       ResourceMonitorLevel(row.Level.String),
+		Frequency:   Frequency(row.Frequency.String),
+		StartTime:   row.StartTime.String,
+		EndTime:     row.EndTime.String,
+		CreatedOn:   row.CreatedOn,
+		Owner:       row.Owner,
+		Comment:     row.Comment.String,
+		NotifyUsers: make([]string, 0),
+	}
+	var err error
+	if rm.CreditQuota, err = parseCredits(row.CreditQuota); err != nil {
+		return nil, err
+	}
+	if rm.UsedCredits, err = parseCredits(row.UsedCredits); err != nil {
+		return nil, err
+	}
+	if rm.RemainingCredits, err = parseCredits(row.RemainingCredits); err != nil {
+		return nil, err
+	}
+	if rm.NotifyAt, err = parseTriggerThresholds(row.NotifyAt); err != nil {
+		return nil, err
+	}
+	suspendAt, err := parseTriggerThresholds(row.SuspendAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(suspendAt) > 0 {
+		rm.SuspendAt = &suspendAt[0]
+	}
+	suspendImmediateAt, err := parseTriggerThresholds(row.SuspendImmediateAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(suspendImmediateAt) > 0 {
+		rm.SuspendImmediateAt = &suspendImmediateAt[0]
+	}
+	if row.NotifyUsers.Valid && row.NotifyUsers.String != "" {
+		for _, user := range strings.Split(row.NotifyUsers.String, ",") {
+			rm.NotifyUsers = append(rm.NotifyUsers, strings.TrimSpace(user))
+		}
+	}
+	return rm, nil
+}
+
+func parseCredits(s sql.NullString) (float64, error) {
+	if !s.Valid || s.String == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s.String, 64)
+}
+
+// parseTriggerThresholds converts the thresholds returned by SHOW RESOURCE MONITORS (a comma separated list of
+// percentages, e.g. 50%,75%) into ints.
+func parseTriggerThresholds(s sql.NullString) ([]int, error) {
+	thresholds := make([]int, 0)
+	if !s.Valid || s.String == "" {
+		return thresholds, nil
+	}
+	for _, part := range strings.Split(s.String, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(part), "%"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid trigger threshold %s: %w", part, err)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return thresholds, nil
+}
+
+func (v *resourceMonitors) Show(ctx context.Context, opts *ResourceMonitorShowOptions) ([]*ResourceMonitor, error) {
+	if opts == nil {
+		opts = &ResourceMonitorShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []resourceMonitorDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ResourceMonitor, len(dest))
+	for i, row := range dest {
+		row := row
+		resultList[i], err = row.toResourceMonitor()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+func (v *resourceMonitors) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ResourceMonitor, error) {
+	resourceMonitors, err := v.Show(ctx, &ResourceMonitorShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceMonitor := range resourceMonitors {
+		if resourceMonitor.Name == id.Name() {
+			return resourceMonitor, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// resourceMonitorAccountOptions sets or removes the resource monitor of the current account.
+type resourceMonitorAccountOptions struct {
+	alterAccount    bool                     `ddl:"static" db:"ALTER ACCOUNT SET"` //lint:ignore U1000 This is used in the ddl tag
+	ResourceMonitor *AccountObjectIdentifier `ddl:"identifier,equals" db:"RESOURCE_MONITOR"`
+	Unset           *bool                    `ddl:"keyword" db:"RESOURCE_MONITOR = NULL"`
+}
+
+func (opts *resourceMonitorAccountOptions) validate() error {
+	if !exactlyOneValueSet(opts.ResourceMonitor, opts.Unset) {
+		return errors.New("exactly one of ResourceMonitor, Unset must be set")
+	}
+	return nil
+}
+
+func (v *resourceMonitors) alterAccount(ctx context.Context, opts *resourceMonitorAccountOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *resourceMonitors) SetOnAccount(ctx context.Context, id AccountObjectIdentifier) error {
+	return v.alterAccount(ctx, &resourceMonitorAccountOptions{ResourceMonitor: &id})
+}
+
+func (v *resourceMonitors) UnsetOnAccount(ctx context.Context) error {
+	return v.alterAccount(ctx, &resourceMonitorAccountOptions{Unset: Bool(true)})
+}
+
+// notifyUserShowOptions lists the users matching the name of a user to be notified.
+type notifyUserShowOptions struct {
+	show bool  `ddl:"static" db:"SHOW USERS"` //lint:ignore U1000 This is used in the ddl tag
+	Like *Like `ddl:"keyword" db:"LIKE"`
+}
+
+type notifyUserDBRow struct {
+	Name string `db:"name"`
+}
+
+func (v *resourceMonitors) ValidateNotifyUsers(ctx context.Context, users []NotifyUser) error {
+	var missing []string
+	for _, user := range users {
+		opts := &notifyUserShowOptions{
+			Like: &Like{Pattern: String(user.Name)},
+		}
+		sql, err := structToSQL(opts)
+		if err != nil {
+			return err
+		}
+		dest := []notifyUserDBRow{}
+		if err := v.client.query(ctx, &dest, sql); err != nil {
+			return err
+		}
+		found := false
+		for _, row := range dest {
+			if strings.EqualFold(row.Name, user.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, user.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("users to notify do not exist or are not authorized: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
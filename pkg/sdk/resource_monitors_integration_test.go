@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ResourceMonitorsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.ResourceMonitors.Create(ctx, id, &ResourceMonitorCreateOptions{
+		With: &ResourceMonitorWith{
+			CreditQuota: Int(100),
+			Triggers: []TriggerDefinition{
+				{Threshold: 50, TriggerAction: TriggerActionNotify},
+				{Threshold: 100, TriggerAction: TriggerActionSuspend},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ResourceMonitors.Drop(ctx, id)
+		require.NoError(t, err)
+	})
+
+	resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), resourceMonitor.Name)
+	assert.Equal(t, 100.0, resourceMonitor.CreditQuota)
+	assert.Equal(t, []int{50}, resourceMonitor.NotifyAt)
+	require.NotNil(t, resourceMonitor.SuspendAt)
+	assert.Equal(t, 100, *resourceMonitor.SuspendAt)
+
+	err = client.ResourceMonitors.Alter(ctx, id, &ResourceMonitorAlterOptions{
+		Set: &ResourceMonitorSet{
+			CreditQuota: Int(200),
+		},
+		Triggers: []TriggerDefinition{
+			{Threshold: 90, TriggerAction: TriggerActionSuspendImmediate},
+		},
+	})
+	require.NoError(t, err)
+
+	resourceMonitor, err = client.ResourceMonitors.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 200.0, resourceMonitor.CreditQuota)
+	assert.Equal(t, []TriggerDefinition{{Threshold: 90, TriggerAction: TriggerActionSuspendImmediate}}, resourceMonitor.Triggers())
+}
+
+func TestInt_ResourceMonitorsValidateNotifyUsers(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	currentUser, err := client.ContextFunctions.CurrentUser(ctx)
+	require.NoError(t, err)
+
+	err = client.ResourceMonitors.ValidateNotifyUsers(ctx, []NotifyUser{{Name: currentUser}})
+	require.NoError(t, err)
+
+	err = client.ResourceMonitors.ValidateNotifyUsers(ctx, []NotifyUser{{Name: currentUser}, {Name: randomString(t)}})
+	assert.ErrorContains(t, err, "do not exist")
+}
@@ -0,0 +1,196 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceMonitorCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("myresourcemonitor")
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &ResourceMonitorCreateOptions{
+			name: id,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE RESOURCE MONITOR "myresourcemonitor"`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &ResourceMonitorCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			With: &ResourceMonitorWith{
+				CreditQuota:    Int(100),
+				Frequency:      &FrequencyMonthly,
+				StartTimestamp: String("IMMEDIATELY"),
+				EndTimestamp:   String("2030-01-01 00:00"),
+				NotifyUsers:    []NotifyUser{{Name: "alice"}, {Name: "bob"}},
+				Triggers: []TriggerDefinition{
+					{Threshold: 50, TriggerAction: TriggerActionNotify},
+					{Threshold: 90, TriggerAction: TriggerActionSuspend},
+					{Threshold: 100, TriggerAction: TriggerActionSuspendImmediate},
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE RESOURCE MONITOR "myresourcemonitor" WITH CREDIT_QUOTA = 100 FREQUENCY = MONTHLY START_TIMESTAMP = 'IMMEDIATELY' END_TIMESTAMP = '2030-01-01 00:00' NOTIFY_USERS = ('alice','bob') TRIGGERS ON 50 PERCENT DO NOTIFY ON 90 PERCENT DO SUSPEND ON 100 PERCENT DO SUSPEND_IMMEDIATE`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: frequency without start timestamp", func(t *testing.T) {
+		opts := &ResourceMonitorCreateOptions{
+			name: id,
+			With: &ResourceMonitorWith{
+				Frequency: &FrequencyDaily,
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: two suspend triggers", func(t *testing.T) {
+		opts := &ResourceMonitorCreateOptions{
+			name: id,
+			With: &ResourceMonitorWith{
+				Triggers: []TriggerDefinition{
+					{Threshold: 80, TriggerAction: TriggerActionSuspend},
+					{Threshold: 90, TriggerAction: TriggerActionSuspend},
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: non positive threshold", func(t *testing.T) {
+		opts := &ResourceMonitorCreateOptions{
+			name: id,
+			With: &ResourceMonitorWith{
+				Triggers: []TriggerDefinition{
+					{Threshold: 0, TriggerAction: TriggerActionNotify},
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestResourceMonitorAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("myresourcemonitor")
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &ResourceMonitorAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &ResourceMonitorSet{
+				CreditQuota: Int(200),
+				NotifyUsers: []NotifyUser{{Name: "alice"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER RESOURCE MONITOR IF EXISTS "myresourcemonitor" SET CREDIT_QUOTA = 200 NOTIFY_USERS = ('alice')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with triggers", func(t *testing.T) {
+		opts := &ResourceMonitorAlterOptions{
+			name: id,
+			Triggers: []TriggerDefinition{
+				{Threshold: 75, TriggerAction: TriggerActionNotify},
+				{Threshold: 100, TriggerAction: TriggerActionSuspend},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER RESOURCE MONITOR "myresourcemonitor" TRIGGERS ON 75 PERCENT DO NOTIFY ON 100 PERCENT DO SUSPEND`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: nothing to alter", func(t *testing.T) {
+		opts := &ResourceMonitorAlterOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestResourceMonitorDrop(t *testing.T) {
+	opts := &resourceMonitorDropOptions{
+		name: NewAccountObjectIdentifier("myresourcemonitor"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP RESOURCE MONITOR "myresourcemonitor"`, actual)
+}
+
+func TestResourceMonitorShow(t *testing.T) {
+	opts := &ResourceMonitorShowOptions{
+		Like: &Like{Pattern: String("myresourcemonitor")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW RESOURCE MONITORS LIKE 'myresourcemonitor'`, actual)
+}
+
+func TestResourceMonitorAccount(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		id := NewAccountObjectIdentifier("myresourcemonitor")
+		opts := &resourceMonitorAccountOptions{ResourceMonitor: &id}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ACCOUNT SET RESOURCE_MONITOR = "myresourcemonitor"`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &resourceMonitorAccountOptions{Unset: Bool(true)}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ACCOUNT SET RESOURCE_MONITOR = NULL`, actual)
+	})
+
+	t.Run("validation: neither set nor unset", func(t *testing.T) {
+		opts := &resourceMonitorAccountOptions{}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestResourceMonitorDBRow(t *testing.T) {
+	row := resourceMonitorDBRow{
+		Name:               "myresourcemonitor",
+		CreditQuota:        sql.NullString{String: "100.00", Valid: true},
+		UsedCredits:        sql.NullString{String: "12.50", Valid: true},
+		Level:              sql.NullString{String: "WAREHOUSE", Valid: true},
+		NotifyAt:           sql.NullString{String: "50%,75%", Valid: true},
+		SuspendAt:          sql.NullString{String: "100%", Valid: true},
+		SuspendImmediateAt: sql.NullString{},
+		NotifyUsers:        sql.NullString{String: "ALICE, BOB", Valid: true},
+	}
+	rm, err := row.toResourceMonitor()
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, rm.CreditQuota)
+	assert.Equal(t, 12.5, rm.UsedCredits)
+	assert.Equal(t, ResourceMonitorLevelWarehouse, rm.Level)
+	assert.Equal(t, []int{50, 75}, rm.NotifyAt)
+	assert.Nil(t, rm.SuspendImmediateAt)
+	assert.Equal(t, []string{"ALICE", "BOB"}, rm.NotifyUsers)
+	assert.Equal(t, []TriggerDefinition{
+		{Threshold: 50, TriggerAction: TriggerActionNotify},
+		{Threshold: 75, TriggerAction: TriggerActionNotify},
+		{Threshold: 100, TriggerAction: TriggerActionSuspend},
+	}, rm.Triggers())
+
+	row.NotifyAt = sql.NullString{String: "fifty%", Valid: true}
+	_, err = row.toResourceMonitor()
+	assert.Error(t, err)
+}
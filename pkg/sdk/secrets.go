@@ -0,0 +1,421 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Secrets = (*secrets)(nil)
+
+// Secrets describes all the secret related methods that the
+// Snowflake API supports.
+type Secrets interface {
+	// Create creates a new secret.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *SecretCreateOptions) error
+	// Alter modifies an existing secret.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *SecretAlterOptions) error
+	// Drop removes a secret.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *SecretDropOptions) error
+	// Show returns a list of secrets.
+	Show(ctx context.Context, opts *SecretShowOptions) ([]*Secret, error)
+	// ShowByID returns a secret by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error)
+	// Describe returns the details of a secret.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error)
+}
+
+// secrets implements Secrets.
+type secrets struct {
+	client *Client
+}
+
+type SecretType string
+
+var (
+	SecretTypePassword      SecretType = "PASSWORD"
+	SecretTypeGenericString SecretType = "GENERIC_STRING"
+	SecretTypeOAuth2        SecretType = "OAUTH2"
+)
+
+// OAuthScope is a single scope passed in the OAUTH_SCOPES list of an OAUTH2 secret.
+type OAuthScope struct {
+	Scope string `ddl:"keyword,single_quotes"`
+}
+
+type SecretCreateOptions struct {
+	create      bool                   `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" db:"OR REPLACE"`
+	secret      bool                   `ddl:"static" db:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" db:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	Type SecretType `ddl:"parameter" db:"TYPE"`
+
+	// PASSWORD secrets
+	Username *string `ddl:"parameter,single_quotes" db:"USERNAME"`
+	Password *string `ddl:"parameter,single_quotes" db:"PASSWORD"`
+
+	// GENERIC_STRING secrets
+	SecretString *string `ddl:"parameter,single_quotes" db:"SECRET_STRING"`
+
+	// OAUTH2 secrets
+	APIAuthentication           AccountObjectIdentifier `ddl:"identifier,equals" db:"API_AUTHENTICATION"`
+	OAuthScopes                 []OAuthScope            `ddl:"parameter,parentheses" db:"OAUTH_SCOPES"`
+	OAuthRefreshToken           *string                 `ddl:"parameter,single_quotes" db:"OAUTH_REFRESH_TOKEN"`
+	OAuthRefreshTokenExpiryTime *string                 `ddl:"parameter,single_quotes" db:"OAUTH_REFRESH_TOKEN_EXPIRY_TIME"`
+
+	Comment *string `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *SecretCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OrReplace and IfNotExists cannot both be true")
+	}
+	switch opts.Type {
+	case SecretTypePassword:
+		if !everyValueSet(opts.Username, opts.Password) {
+			return errors.New("Username and Password must be set for PASSWORD secrets")
+		}
+		if anyValueSet(opts.SecretString, opts.APIAuthentication, opts.OAuthScopes, opts.OAuthRefreshToken, opts.OAuthRefreshTokenExpiryTime) {
+			return errors.New("only Username and Password can be set for PASSWORD secrets")
+		}
+	case SecretTypeGenericString:
+		if !valueSet(opts.SecretString) {
+			return errors.New("SecretString must be set for GENERIC_STRING secrets")
+		}
+		if anyValueSet(opts.Username, opts.Password, opts.APIAuthentication, opts.OAuthScopes, opts.OAuthRefreshToken, opts.OAuthRefreshTokenExpiryTime) {
+			return errors.New("only SecretString can be set for GENERIC_STRING secrets")
+		}
+	case SecretTypeOAuth2:
+		if !valueSet(opts.APIAuthentication) {
+			return errors.New("APIAuthentication must be set for OAUTH2 secrets")
+		}
+		if anyValueSet(opts.Username, opts.Password, opts.SecretString) {
+			return errors.New("Username, Password and SecretString cannot be set for OAUTH2 secrets")
+		}
+		if everyValueSet(opts.OAuthScopes, opts.OAuthRefreshToken) {
+			return errors.New("OAuthScopes and OAuthRefreshToken cannot both be set")
+		}
+		if valueSet(opts.OAuthRefreshTokenExpiryTime) && !valueSet(opts.OAuthRefreshToken) {
+			return errors.New("OAuthRefreshTokenExpiryTime can only be set with OAuthRefreshToken")
+		}
+	default:
+		return fmt.Errorf("Type must be one of %s, %s, %s", SecretTypePassword, SecretTypeGenericString, SecretTypeOAuth2)
+	}
+	return nil
+}
+
+func (v *secrets) Create(ctx context.Context, id SchemaObjectIdentifier, opts *SecretCreateOptions) error {
+	if opts == nil {
+		opts = &SecretCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type SecretAlterOptions struct {
+	alter    bool                   `ddl:"static" db:"ALTER"`  //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" db:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Set      *SecretSet             `ddl:"keyword" db:"SET"`
+	Unset    *SecretUnset           `ddl:"keyword" db:"UNSET"`
+}
+
+func (opts *SecretAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SecretSet struct {
+	Username                    *string      `ddl:"parameter,single_quotes" db:"USERNAME"`
+	Password                    *string      `ddl:"parameter,single_quotes" db:"PASSWORD"`
+	SecretString                *string      `ddl:"parameter,single_quotes" db:"SECRET_STRING"`
+	OAuthScopes                 []OAuthScope `ddl:"parameter,parentheses" db:"OAUTH_SCOPES"`
+	OAuthRefreshToken           *string      `ddl:"parameter,single_quotes" db:"OAUTH_REFRESH_TOKEN"`
+	OAuthRefreshTokenExpiryTime *string      `ddl:"parameter,single_quotes" db:"OAUTH_REFRESH_TOKEN_EXPIRY_TIME"`
+	Comment                     *string      `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *SecretSet) validate() error {
+	if everyValueNil(v.Username, v.Password, v.SecretString, v.OAuthScopes, v.OAuthRefreshToken, v.OAuthRefreshTokenExpiryTime, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	if everyValueSet(v.OAuthScopes, v.OAuthRefreshToken) {
+		return errors.New("OAuthScopes and OAuthRefreshToken cannot both be set")
+	}
+	return nil
+}
+
+type SecretUnset struct {
+	Comment *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *SecretUnset) validate() error {
+	if !valueSet(v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *secrets) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *SecretAlterOptions) error {
+	if opts == nil {
+		opts = &SecretAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type SecretDropOptions struct {
+	drop     bool                   `ddl:"static" db:"DROP"`   //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" db:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *SecretDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *secrets) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *SecretDropOptions) error {
+	if opts == nil {
+		opts = &SecretDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// SecretShowOptions represents the options for listing secrets.
+type SecretShowOptions struct {
+	show    bool  `ddl:"static" db:"SHOW"`    //lint:ignore U1000 This is used in the ddl tag
+	secrets bool  `ddl:"static" db:"SECRETS"` //lint:ignore U1000 This is used in the ddl tag
+	Like    *Like `ddl:"keyword" db:"LIKE"`
+	In      *In   `ddl:"keyword" db:"IN"`
+}
+
+func (opts *SecretShowOptions) validate() error {
+	return nil
+}
+
+// Secret is a user friendly result for a SHOW SECRETS query.
+type Secret struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Comment      string
+	SecretType   SecretType
+	OAuthScopes  []string
+}
+
+func (v *Secret) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// secretDBRow is used to decode the result of a SHOW SECRETS query.
+type secretDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         string         `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	SecretType    string         `db:"secret_type"`
+	OAuthScopes   sql.NullString `db:"oauth_scopes"`
+	OwnerRoleType string         `db:"owner_role_type"`
+}
+
+func (row secretDBRow) toSecret() *Secret {
+	secret := &Secret{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner,
+		SecretType:   SecretType(row.SecretType),
+	}
+	if row.Comment.Valid {
+		secret.Comment = row.Comment.String
+	}
+	if row.OAuthScopes.Valid {
+		secret.OAuthScopes = parseBracketedList(row.OAuthScopes.String)
+	}
+	return secret
+}
+
+func (v *secrets) Show(ctx context.Context, opts *SecretShowOptions) ([]*Secret, error) {
+	if opts == nil {
+		opts = &SecretShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []secretDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Secret, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSecret()
+	}
+	return resultList, nil
+}
+
+func (v *secrets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error) {
+	secrets, err := v.Show(ctx, &SecretShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.ID().name == id.Name() {
+			return secret, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type secretDescribeOptions struct {
+	describe bool                   `ddl:"static" db:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" db:"SECRET"`   //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *secretDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// SecretDetails is a user friendly result for a DESCRIBE SECRET query.
+// Sensitive values (passwords, secret strings, tokens) are never returned by Snowflake.
+type SecretDetails struct {
+	CreatedOn                   time.Time
+	Name                        string
+	DatabaseName                string
+	SchemaName                  string
+	Owner                       string
+	Comment                     string
+	SecretType                  SecretType
+	Username                    string
+	OAuthAccessTokenExpiryTime  string
+	OAuthRefreshTokenExpiryTime string
+	OAuthScopes                 []string
+	IntegrationName             string
+}
+
+type secretDetailsRow struct {
+	CreatedOn                   time.Time      `db:"created_on"`
+	Name                        string         `db:"name"`
+	DatabaseName                string         `db:"database_name"`
+	SchemaName                  string         `db:"schema_name"`
+	Owner                       string         `db:"owner"`
+	Comment                     sql.NullString `db:"comment"`
+	SecretType                  string         `db:"secret_type"`
+	Username                    sql.NullString `db:"username"`
+	OAuthAccessTokenExpiryTime  sql.NullString `db:"oauth_access_token_expiry_time"`
+	OAuthRefreshTokenExpiryTime sql.NullString `db:"oauth_refresh_token_expiry_time"`
+	OAuthScopes                 sql.NullString `db:"oauth_scopes"`
+	IntegrationName             sql.NullString `db:"integration_name"`
+}
+
+func (row *secretDetailsRow) toSecretDetails() *SecretDetails {
+	details := &SecretDetails{
+		CreatedOn:                   row.CreatedOn,
+		Name:                        row.Name,
+		DatabaseName:                row.DatabaseName,
+		SchemaName:                  row.SchemaName,
+		Owner:                       row.Owner,
+		Comment:                     row.Comment.String,
+		SecretType:                  SecretType(row.SecretType),
+		Username:                    row.Username.String,
+		OAuthAccessTokenExpiryTime:  row.OAuthAccessTokenExpiryTime.String,
+		OAuthRefreshTokenExpiryTime: row.OAuthRefreshTokenExpiryTime.String,
+		IntegrationName:             row.IntegrationName.String,
+	}
+	if row.OAuthScopes.Valid {
+		details.OAuthScopes = parseBracketedList(row.OAuthScopes.String)
+	}
+	return details
+}
+
+func (v *secrets) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error) {
+	opts := &secretDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := secretDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toSecretDetails(), nil
+}
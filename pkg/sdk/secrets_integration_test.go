@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SecretsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("generic string secret", func(t *testing.T) {
+		id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+		err := client.Secrets.Create(ctx, id, &SecretCreateOptions{
+			Type:         SecretTypeGenericString,
+			SecretString: String(randomString(t)),
+			Comment:      String("test comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Secrets.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+
+		secret, err := client.Secrets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), secret.Name)
+		assert.Equal(t, SecretTypeGenericString, secret.SecretType)
+		assert.Equal(t, "test comment", secret.Comment)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, SecretTypeGenericString, details.SecretType)
+	})
+
+	t.Run("password secret", func(t *testing.T) {
+		id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+		err := client.Secrets.Create(ctx, id, &SecretCreateOptions{
+			Type:     SecretTypePassword,
+			Username: String("admin"),
+			Password: String(randomString(t)),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Secrets.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+
+		err = client.Secrets.Alter(ctx, id, &SecretAlterOptions{
+			Set: &SecretSet{
+				Username: String("other"),
+			},
+		})
+		require.NoError(t, err)
+
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "other", details.Username)
+	})
+}
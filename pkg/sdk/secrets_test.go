@@ -0,0 +1,224 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("password secret", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Type:      SecretTypePassword,
+			Username:  String("admin"),
+			Password:  String("secret"),
+			Comment:   String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE SECRET %s TYPE = PASSWORD USERNAME = 'admin' PASSWORD = 'secret' COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("generic string secret", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			name:         id,
+			IfNotExists:  Bool(true),
+			Type:         SecretTypeGenericString,
+			SecretString: String("value"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET IF NOT EXISTS %s TYPE = GENERIC_STRING SECRET_STRING = 'value'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("oauth2 secret with scopes", func(t *testing.T) {
+		integration := randomAccountObjectIdentifier(t)
+		opts := &SecretCreateOptions{
+			name:              id,
+			Type:              SecretTypeOAuth2,
+			APIAuthentication: integration,
+			OAuthScopes:       []OAuthScope{{Scope: "read"}, {Scope: "write"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('read','write')`, id.FullyQualifiedName(), integration.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("oauth2 secret with refresh token", func(t *testing.T) {
+		integration := randomAccountObjectIdentifier(t)
+		opts := &SecretCreateOptions{
+			name:                        id,
+			Type:                        SecretTypeOAuth2,
+			APIAuthentication:           integration,
+			OAuthRefreshToken:           String("token"),
+			OAuthRefreshTokenExpiryTime: String("2030-01-01 00:00:00"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2030-01-01 00:00:00'`, id.FullyQualifiedName(), integration.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: missing type", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: password secret without password", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			name:     id,
+			Type:     SecretTypePassword,
+			Username: String("admin"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: generic string secret with username", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			name:         id,
+			Type:         SecretTypeGenericString,
+			SecretString: String("value"),
+			Username:     String("admin"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: oauth2 secret with scopes and refresh token", func(t *testing.T) {
+		opts := &SecretCreateOptions{
+			name:              id,
+			Type:              SecretTypeOAuth2,
+			APIAuthentication: randomAccountObjectIdentifier(t),
+			OAuthScopes:       []OAuthScope{{Scope: "read"}},
+			OAuthRefreshToken: String("token"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSecretAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &SecretAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &SecretSet{
+				Username: String("admin"),
+				Password: String("secret"),
+				Comment:  String("test comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET IF EXISTS %s SET USERNAME = 'admin' PASSWORD = 'secret' COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set oauth scopes", func(t *testing.T) {
+		opts := &SecretAlterOptions{
+			name: id,
+			Set: &SecretSet{
+				OAuthScopes: []OAuthScope{{Scope: "read"}},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET %s SET OAUTH_SCOPES = ('read')`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &SecretAlterOptions{
+			name: id,
+			Unset: &SecretUnset{
+				Comment: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET %s UNSET COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: set and unset", func(t *testing.T) {
+		opts := &SecretAlterOptions{
+			name:  id,
+			Set:   &SecretSet{Comment: String("c")},
+			Unset: &SecretUnset{Comment: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSecretDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &SecretDropOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP SECRET IF EXISTS %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSecretShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &SecretShowOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW SECRETS"
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with like and in", func(t *testing.T) {
+		opts := &SecretShowOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`SHOW SECRETS LIKE '%s' IN SCHEMA "%s"."%s"`, id.Name(), id.DatabaseName(), id.SchemaName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSecretDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &secretDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE SECRET %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
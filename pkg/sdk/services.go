@@ -0,0 +1,427 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Services = (*services)(nil)
+
+// Services describes all the Snowpark Container Services service related methods that the
+// Snowflake API supports.
+type Services interface {
+	// Create creates a new service.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceCreateOptions) error
+	// Alter modifies an existing service.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceAlterOptions) error
+	// Drop removes a service.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceDropOptions) error
+	// Show returns a list of services.
+	Show(ctx context.Context, opts *ServiceShowOptions) ([]*Service, error)
+	// ShowByID returns a service by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error)
+	// Describe returns the details of a service, including its specification.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*ServiceDetails, error)
+}
+
+// services implements Services.
+type services struct {
+	client *Client
+}
+
+// ServiceSpecification is the source of a service specification. Either a specification file
+// on a stage (Stage and SpecificationFile) or an inline YAML Specification must be set.
+type ServiceSpecification struct {
+	// Stage is the stage location holding the specification file, e.g. @db.schema.stage/path.
+	Stage             *string `ddl:"parameter,no_equals" db:"FROM"`
+	SpecificationFile *string `ddl:"parameter,single_quotes" db:"SPECIFICATION_FILE"`
+	Specification     *string `ddl:"parameter,no_equals,dollar_quotes" db:"FROM SPECIFICATION"`
+}
+
+func (v *ServiceSpecification) validate() error {
+	if !exactlyOneValueSet(v.SpecificationFile, v.Specification) {
+		return errors.New("exactly one of SpecificationFile, Specification must be set")
+	}
+	if valueSet(v.SpecificationFile) && !valueSet(v.Stage) {
+		return errors.New("Stage must be set when SpecificationFile is set")
+	}
+	if valueSet(v.Specification) && valueSet(v.Stage) {
+		return errors.New("Stage cannot be set when Specification is set")
+	}
+	return nil
+}
+
+type ServiceCreateOptions struct {
+	create      bool                    `ddl:"static" db:"CREATE"`  //lint:ignore U1000 This is used in the ddl tag
+	service     bool                    `ddl:"static" db:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" db:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier  `ddl:"identifier"`
+	ComputePool AccountObjectIdentifier `ddl:"identifier" db:"IN COMPUTE POOL"`
+
+	Specification              *ServiceSpecification     `ddl:"keyword"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" db:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	AutoResume                 *bool                     `ddl:"parameter" db:"AUTO_RESUME"`
+	MinInstances               *int                      `ddl:"parameter" db:"MIN_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" db:"MAX_INSTANCES"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" db:"QUERY_WAREHOUSE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *ServiceCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validObjectidentifier(opts.ComputePool) {
+		return errors.New("ComputePool must be set")
+	}
+	if !valueSet(opts.Specification) {
+		return errors.New("Specification must be set")
+	}
+	if err := opts.Specification.validate(); err != nil {
+		return err
+	}
+	return validateServiceInstances(opts.MinInstances, opts.MaxInstances)
+}
+
+func validateServiceInstances(minInstances *int, maxInstances *int) error {
+	if valueSet(minInstances) && !validateIntGreaterThanOrEqual(*minInstances, 1) {
+		return errors.New("MinInstances must be greater than or equal to 1")
+	}
+	if valueSet(maxInstances) && !validateIntGreaterThanOrEqual(*maxInstances, 1) {
+		return errors.New("MaxInstances must be greater than or equal to 1")
+	}
+	if everyValueSet(minInstances, maxInstances) && !validateIntGreaterThanOrEqual(*maxInstances, *minInstances) {
+		return errors.New("MaxInstances must be greater than or equal to MinInstances")
+	}
+	return nil
+}
+
+func (v *services) Create(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceCreateOptions) error {
+	if opts == nil {
+		opts = &ServiceCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ServiceAlterOptions struct {
+	alter    bool                   `ddl:"static" db:"ALTER"`   //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" db:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+
+	Suspend       *bool                 `ddl:"keyword" db:"SUSPEND"`
+	Resume        *bool                 `ddl:"keyword" db:"RESUME"`
+	Specification *ServiceSpecification `ddl:"keyword"`
+	Set           *ServiceSet           `ddl:"keyword" db:"SET"`
+	Unset         *ServiceUnset         `ddl:"list,no_parentheses" db:"UNSET"`
+}
+
+func (opts *ServiceAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Specification, opts.Set, opts.Unset) {
+		return errors.New("exactly one of Suspend, Resume, Specification, Set, Unset must be set")
+	}
+	if valueSet(opts.Specification) {
+		if err := opts.Specification.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ServiceSet struct {
+	MinInstances               *int                      `ddl:"parameter" db:"MIN_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" db:"MAX_INSTANCES"`
+	AutoResume                 *bool                     `ddl:"parameter" db:"AUTO_RESUME"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" db:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" db:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *ServiceSet) validate() error {
+	if everyValueNil(v.MinInstances, v.MaxInstances, v.AutoResume, v.QueryWarehouse, v.ExternalAccessIntegrations, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return validateServiceInstances(v.MinInstances, v.MaxInstances)
+}
+
+type ServiceUnset struct {
+	MinInstances               *bool `ddl:"keyword" db:"MIN_INSTANCES"`
+	MaxInstances               *bool `ddl:"keyword" db:"MAX_INSTANCES"`
+	AutoResume                 *bool `ddl:"keyword" db:"AUTO_RESUME"`
+	QueryWarehouse             *bool `ddl:"keyword" db:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations *bool `ddl:"keyword" db:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *ServiceUnset) validate() error {
+	if everyValueNil(v.MinInstances, v.MaxInstances, v.AutoResume, v.QueryWarehouse, v.ExternalAccessIntegrations, v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *services) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceAlterOptions) error {
+	if opts == nil {
+		opts = &ServiceAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ServiceDropOptions struct {
+	drop     bool                   `ddl:"static" db:"DROP"`    //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" db:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Force    *bool                  `ddl:"keyword" db:"FORCE"`
+}
+
+func (opts *ServiceDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *services) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *ServiceDropOptions) error {
+	if opts == nil {
+		opts = &ServiceDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ServiceShowOptions represents the options for listing services.
+type ServiceShowOptions struct {
+	show     bool  `ddl:"static" db:"SHOW"`     //lint:ignore U1000 This is used in the ddl tag
+	services bool  `ddl:"static" db:"SERVICES"` //lint:ignore U1000 This is used in the ddl tag
+	Like     *Like `ddl:"keyword" db:"LIKE"`
+	In       *In   `ddl:"keyword" db:"IN"`
+}
+
+func (opts *ServiceShowOptions) validate() error {
+	return nil
+}
+
+type ServiceStatus string
+
+const (
+	ServiceStatusPending    ServiceStatus = "PENDING"
+	ServiceStatusRunning    ServiceStatus = "RUNNING"
+	ServiceStatusFailed     ServiceStatus = "FAILED"
+	ServiceStatusDone       ServiceStatus = "DONE"
+	ServiceStatusSuspending ServiceStatus = "SUSPENDING"
+	ServiceStatusSuspended  ServiceStatus = "SUSPENDED"
+	ServiceStatusDeleting   ServiceStatus = "DELETING"
+	ServiceStatusDeleted    ServiceStatus = "DELETED"
+)
+
+// Service is a user friendly result for a SHOW SERVICES query.
+type Service struct {
+	Name                       string
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ComputePool                string
+	Status                     ServiceStatus
+	DNSName                    string
+	MinInstances               int
+	MaxInstances               int
+	AutoResume                 bool
+	ExternalAccessIntegrations []string
+	CreatedOn                  time.Time
+	UpdatedOn                  time.Time
+	ResumedOn                  time.Time
+	Comment                    string
+	OwnerRoleType              string
+	QueryWarehouse             string
+}
+
+func (v *Service) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// serviceDBRow is used to decode the result of a SHOW SERVICES query.
+type serviceDBRow struct {
+	Name                       string         `db:"name"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      string         `db:"owner"`
+	ComputePool                string         `db:"compute_pool"`
+	Status                     sql.NullString `db:"status"`
+	DNSName                    sql.NullString `db:"dns_name"`
+	MinInstances               int            `db:"min_instances"`
+	MaxInstances               int            `db:"max_instances"`
+	AutoResume                 bool           `db:"auto_resume"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	CreatedOn                  time.Time      `db:"created_on"`
+	UpdatedOn                  sql.NullTime   `db:"updated_on"`
+	ResumedOn                  sql.NullTime   `db:"resumed_on"`
+	Comment                    sql.NullString `db:"comment"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+}
+
+func (row serviceDBRow) toService() *Service {
+	service := &Service{
+		Name:                       row.Name,
+		DatabaseName:               row.DatabaseName,
+		SchemaName:                 row.SchemaName,
+		Owner:                      row.Owner,
+		ComputePool:                row.ComputePool,
+		Status:                     ServiceStatus(row.Status.String),
+		DNSName:                    row.DNSName.String,
+		MinInstances:               row.MinInstances,
+		MaxInstances:               row.MaxInstances,
+		AutoResume:                 row.AutoResume,
+		ExternalAccessIntegrations: make([]string, 0),
+		CreatedOn:                  row.CreatedOn,
+		Comment:                    row.Comment.String,
+		OwnerRoleType:              row.OwnerRoleType.String,
+		QueryWarehouse:             row.QueryWarehouse.String,
+	}
+	if row.ExternalAccessIntegrations.Valid {
+		service.ExternalAccessIntegrations = parseBracketedList(row.ExternalAccessIntegrations.String)
+	}
+	if row.UpdatedOn.Valid {
+		service.UpdatedOn = row.UpdatedOn.Time
+	}
+	if row.ResumedOn.Valid {
+		service.ResumedOn = row.ResumedOn.Time
+	}
+	return service
+}
+
+func (v *services) Show(ctx context.Context, opts *ServiceShowOptions) ([]*Service, error) {
+	if opts == nil {
+		opts = &ServiceShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []serviceDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Service, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toService()
+	}
+	return resultList, nil
+}
+
+func (v *services) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error) {
+	services, err := v.Show(ctx, &ServiceShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		if service.ID().name == id.Name() {
+			return service, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type serviceDescribeOptions struct {
+	describe bool                   `ddl:"static" db:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" db:"SERVICE"`  //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *serviceDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ServiceDetails is a user friendly result for a DESCRIBE SERVICE query.
+type ServiceDetails struct {
+	Service
+	Spec string
+}
+
+type serviceDetailsRow struct {
+	serviceDBRow
+	Spec sql.NullString `db:"spec"`
+}
+
+func (row *serviceDetailsRow) toServiceDetails() *ServiceDetails {
+	return &ServiceDetails{
+		Service: *row.serviceDBRow.toService(),
+		Spec:    row.Spec.String,
+	}
+}
+
+func (v *services) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ServiceDetails, error) {
+	opts := &serviceDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := serviceDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toServiceDetails(), nil
+}
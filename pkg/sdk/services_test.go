@@ -0,0 +1,228 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+	pool := randomAccountObjectIdentifier(t)
+
+	t.Run("from specification file", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			name:        id,
+			ComputePool: pool,
+			Specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec.yaml"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SERVICE %s IN COMPUTE POOL %s FROM @db.schema.specs SPECIFICATION_FILE = 'spec.yaml'`, id.FullyQualifiedName(), pool.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with inline specification and complete options", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			ComputePool: pool,
+			Specification: &ServiceSpecification{
+				Specification: String("spec:\n  containers:\n  - name: main\n    image: /db/schema/repo/image:latest\n"),
+			},
+			ExternalAccessIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("eai")},
+			AutoResume:                 Bool(true),
+			MinInstances:               Int(1),
+			MaxInstances:               Int(2),
+			QueryWarehouse:             NewAccountObjectIdentifier("wh"),
+			Comment:                    String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE SERVICE IF NOT EXISTS %s IN COMPUTE POOL %s FROM SPECIFICATION $$spec:\n  containers:\n  - name: main\n    image: /db/schema/repo/image:latest\n$$ EXTERNAL_ACCESS_INTEGRATIONS = (\"eai\") AUTO_RESUME = true MIN_INSTANCES = 1 MAX_INSTANCES = 2 QUERY_WAREHOUSE = \"wh\" COMMENT = 'test comment'", id.FullyQualifiedName(), pool.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: both specification sources", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			name:        id,
+			ComputePool: pool,
+			Specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec.yaml"),
+				Specification:     String("spec: {}"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: specification file without stage", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			name:        id,
+			ComputePool: pool,
+			Specification: &ServiceSpecification{
+				SpecificationFile: String("spec.yaml"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing specification", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			name:        id,
+			ComputePool: pool,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: max instances lower than min instances", func(t *testing.T) {
+		opts := &ServiceCreateOptions{
+			name:        id,
+			ComputePool: pool,
+			Specification: &ServiceSpecification{
+				Specification: String("spec: {}"),
+			},
+			MinInstances: Int(3),
+			MaxInstances: Int(2),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestServiceAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			name:    id,
+			Suspend: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SERVICE %s SUSPEND`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Resume:   Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SERVICE IF EXISTS %s RESUME`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("from specification file", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			name: id,
+			Specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec.yaml"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SERVICE %s FROM @db.schema.specs SPECIFICATION_FILE = 'spec.yaml'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			name: id,
+			Set: &ServiceSet{
+				MinInstances: Int(1),
+				MaxInstances: Int(3),
+				Comment:      String("test comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SERVICE %s SET MIN_INSTANCES = 1 MAX_INSTANCES = 3 COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			name: id,
+			Unset: &ServiceUnset{
+				QueryWarehouse: Bool(true),
+				Comment:        Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SERVICE %s UNSET QUERY_WAREHOUSE,COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: suspend and resume", func(t *testing.T) {
+		opts := &ServiceAlterOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Resume:  Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestServiceDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with force", func(t *testing.T) {
+		opts := &ServiceDropOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Force:    Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP SERVICE IF EXISTS %s FORCE`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestServiceShow(t *testing.T) {
+	t.Run("with like and in", func(t *testing.T) {
+		opts := &ServiceShowOptions{
+			Like: &Like{
+				Pattern: String("svc"),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier("db", "schema"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW SERVICES LIKE 'svc' IN SCHEMA "db"."schema"`
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestServiceDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &serviceDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE SERVICE %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
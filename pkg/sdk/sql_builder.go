@@ -14,6 +14,7 @@ const (
 	parenModifierType   modifierType = "paren"
 	reverseModifierType modifierType = "reverse"
 	equalsModifierType  modifierType = "equals"
+	commaModifierType   modifierType = "comma"
 )
 
 type modifier interface {
@@ -26,6 +27,7 @@ const (
 	NoQuotes     quoteModifier = "no_quotes"
 	DoubleQuotes quoteModifier = "double_quotes"
 	SingleQuotes quoteModifier = "single_quotes"
+	DollarQuotes quoteModifier = "dollar_quotes"
 )
 
 func (qm quoteModifier) Modify(v any) string {
@@ -41,6 +43,9 @@ func (qm quoteModifier) Modify(v any) string {
 		// replace all single quotes with \'
 		escapedString := strings.ReplaceAll(s, qm.String(), `\'`)
 		return fmt.Sprintf(`%v%v%v`, qm.String(), escapedString, qm.String())
+	case DollarQuotes:
+		// https://docs.snowflake.com/en/sql-reference/data-types-text#dollar-quoted-string-constants
+		return fmt.Sprintf(`%v%v%v`, qm.String(), s, qm.String())
 	default:
 		return s
 	}
@@ -54,6 +59,8 @@ func (qm quoteModifier) String() string {
 		return `"`
 	case SingleQuotes:
 		return `'`
+	case DollarQuotes:
+		return `$$`
 	default:
 		return ""
 	}
@@ -103,6 +110,20 @@ func (rm reverseModifier) Modify(v any) string {
 	}
 }
 
+type commaModifier string
+
+const (
+	Comma   commaModifier = "comma"
+	NoComma commaModifier = "no_comma"
+)
+
+func (cm commaModifier) Modify(_ any) string {
+	if cm == NoComma {
+		return " "
+	}
+	return ","
+}
+
 type equalsModifier string
 
 const (
@@ -135,6 +156,8 @@ func (b *sqlBuilder) getModifier(tag reflect.StructTag, tagName string, modType
 				return equalsModifier(trimmedS)
 			case reverseModifierType:
 				return reverseModifier(trimmedS)
+			case commaModifierType:
+				return commaModifier(trimmedS)
 			}
 		}
 	}
@@ -318,7 +341,7 @@ func (b sqlBuilder) parseFieldSlice(field reflect.StructField, value reflect.Val
 	}
 	clauses = append(clauses, sqlListClause{
 		clauses: listClauses,
-		sep:     ",",
+		sep:     b.getModifier(field.Tag, "ddl", commaModifierType, Comma).(commaModifier).Modify(nil),
 		pm:      b.getModifier(field.Tag, "ddl", parenModifierType, NoParentheses).(parenModifier),
 	})
 	sClause := b.renderStaticClause(clauses...)
@@ -308,6 +308,18 @@ func TestEqualsModifier(t *testing.T) {
 	})
 }
 
+func TestCommaModifier(t *testing.T) {
+	t.Run("test comma modifier", func(t *testing.T) {
+		result := Comma.Modify(nil)
+		assert.Equal(t, `,`, result)
+	})
+
+	t.Run("test no comma modifier", func(t *testing.T) {
+		result := NoComma.Modify(nil)
+		assert.Equal(t, ` `, result)
+	})
+}
+
 func TestParenModifier(t *testing.T) {
 	t.Run("test paren modifier", func(t *testing.T) {
 		result := Parentheses.Modify("example")
@@ -341,6 +353,11 @@ func TestQuoteModifier(t *testing.T) {
 		assert.Equal(t, `'example'`, result)
 	})
 
+	t.Run("test dollar quotes modifier", func(t *testing.T) {
+		result := DollarQuotes.Modify("it's an example")
+		assert.Equal(t, `$$it's an example$$`, result)
+	})
+
 	t.Run("test unknown modifier", func(t *testing.T) {
 		result := quoteModifier("unknown").Modify("example")
 		assert.Equal(t, `example`, result)
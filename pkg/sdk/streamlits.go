@@ -0,0 +1,348 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Streamlits = (*streamlits)(nil)
+
+// Streamlits describes all the streamlit related methods that the
+// Snowflake API supports.
+type Streamlits interface {
+	// Create creates a new streamlit.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitCreateOptions) error
+	// Alter modifies an existing streamlit.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitAlterOptions) error
+	// Drop removes a streamlit.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitDropOptions) error
+	// Show returns a list of streamlits.
+	Show(ctx context.Context, opts *StreamlitShowOptions) ([]*Streamlit, error)
+	// ShowByID returns a streamlit by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error)
+	// Describe returns the details of a streamlit.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetails, error)
+}
+
+// streamlits implements Streamlits.
+type streamlits struct {
+	client *Client
+}
+
+type StreamlitCreateOptions struct {
+	create      bool                   `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" db:"OR REPLACE"`
+	streamlit   bool                   `ddl:"static" db:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" db:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// RootLocation is the stage location holding the application files, e.g. @db.schema.stage/app.
+	RootLocation   string                  `ddl:"parameter,single_quotes" db:"ROOT_LOCATION"`
+	MainFile       string                  `ddl:"parameter,single_quotes" db:"MAIN_FILE"`
+	QueryWarehouse AccountObjectIdentifier `ddl:"identifier,equals" db:"QUERY_WAREHOUSE"`
+	Title          *string                 `ddl:"parameter,single_quotes" db:"TITLE"`
+	Comment        *string                 `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (opts *StreamlitCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OrReplace and IfNotExists cannot both be true")
+	}
+	if opts.RootLocation == "" {
+		return errors.New("RootLocation must be set")
+	}
+	if opts.MainFile == "" {
+		return errors.New("MainFile must be set")
+	}
+	return nil
+}
+
+func (v *streamlits) Create(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitCreateOptions) error {
+	if opts == nil {
+		opts = &StreamlitCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type StreamlitAlterOptions struct {
+	alter     bool                   `ddl:"static" db:"ALTER"`     //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" db:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists  *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+	NewName   SchemaObjectIdentifier `ddl:"identifier" db:"RENAME TO"`
+	Set       *StreamlitSet          `ddl:"keyword" db:"SET"`
+	Unset     *StreamlitUnset        `ddl:"list,no_parentheses" db:"UNSET"`
+}
+
+func (opts *StreamlitAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type StreamlitSet struct {
+	RootLocation   *string                 `ddl:"parameter,single_quotes" db:"ROOT_LOCATION"`
+	MainFile       *string                 `ddl:"parameter,single_quotes" db:"MAIN_FILE"`
+	QueryWarehouse AccountObjectIdentifier `ddl:"identifier,equals" db:"QUERY_WAREHOUSE"`
+	Title          *string                 `ddl:"parameter,single_quotes" db:"TITLE"`
+	Comment        *string                 `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *StreamlitSet) validate() error {
+	if everyValueNil(v.RootLocation, v.MainFile, v.QueryWarehouse, v.Title, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return nil
+}
+
+type StreamlitUnset struct {
+	QueryWarehouse *bool `ddl:"keyword" db:"QUERY_WAREHOUSE"`
+	Title          *bool `ddl:"keyword" db:"TITLE"`
+	Comment        *bool `ddl:"keyword" db:"COMMENT"`
+}
+
+func (v *StreamlitUnset) validate() error {
+	if everyValueNil(v.QueryWarehouse, v.Title, v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *streamlits) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitAlterOptions) error {
+	if opts == nil {
+		opts = &StreamlitAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type StreamlitDropOptions struct {
+	drop      bool                   `ddl:"static" db:"DROP"`      //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" db:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists  *bool                  `ddl:"keyword" db:"IF EXISTS"`
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *StreamlitDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *streamlits) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *StreamlitDropOptions) error {
+	if opts == nil {
+		opts = &StreamlitDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// StreamlitShowOptions represents the options for listing streamlits.
+type StreamlitShowOptions struct {
+	show       bool  `ddl:"static" db:"SHOW"`       //lint:ignore U1000 This is used in the ddl tag
+	streamlits bool  `ddl:"static" db:"STREAMLITS"` //lint:ignore U1000 This is used in the ddl tag
+	Like       *Like `ddl:"keyword" db:"LIKE"`
+	In         *In   `ddl:"keyword" db:"IN"`
+}
+
+func (opts *StreamlitShowOptions) validate() error {
+	return nil
+}
+
+// Streamlit is a user friendly result for a SHOW STREAMLITS query.
+type Streamlit struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	Title          string
+	Owner          string
+	Comment        string
+	QueryWarehouse string
+	URLID          string
+	OwnerRoleType  string
+}
+
+func (v *Streamlit) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+// streamlitDBRow is used to decode the result of a SHOW STREAMLITS query.
+type streamlitDBRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	Title          sql.NullString `db:"title"`
+	Owner          string         `db:"owner"`
+	Comment        sql.NullString `db:"comment"`
+	QueryWarehouse sql.NullString `db:"query_warehouse"`
+	URLID          string         `db:"url_id"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row streamlitDBRow) toStreamlit() *Streamlit {
+	return &Streamlit{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		Title:          row.Title.String,
+		Owner:          row.Owner,
+		Comment:        row.Comment.String,
+		QueryWarehouse: row.QueryWarehouse.String,
+		URLID:          row.URLID,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *streamlits) Show(ctx context.Context, opts *StreamlitShowOptions) ([]*Streamlit, error) {
+	if opts == nil {
+		opts = &StreamlitShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []streamlitDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Streamlit, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toStreamlit()
+	}
+	return resultList, nil
+}
+
+func (v *streamlits) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error) {
+	streamlits, err := v.Show(ctx, &StreamlitShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, streamlit := range streamlits {
+		if streamlit.ID().name == id.Name() {
+			return streamlit, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type streamlitDescribeOptions struct {
+	describe  bool                   `ddl:"static" db:"DESCRIBE"`  //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" db:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *streamlitDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// StreamlitDetails is a user friendly result for a DESCRIBE STREAMLIT query.
+type StreamlitDetails struct {
+	Name           string
+	Title          string
+	RootLocation   string
+	MainFile       string
+	QueryWarehouse string
+	URLID          string
+}
+
+type streamlitDetailsRow struct {
+	Name           string         `db:"name"`
+	Title          sql.NullString `db:"title"`
+	RootLocation   string         `db:"root_location"`
+	MainFile       string         `db:"main_file"`
+	QueryWarehouse sql.NullString `db:"query_warehouse"`
+	URLID          string         `db:"url_id"`
+}
+
+func (row *streamlitDetailsRow) toStreamlitDetails() *StreamlitDetails {
+	return &StreamlitDetails{
+		Name:           row.Name,
+		Title:          row.Title.String,
+		RootLocation:   row.RootLocation,
+		MainFile:       row.MainFile,
+		QueryWarehouse: row.QueryWarehouse.String,
+		URLID:          row.URLID,
+	}
+}
+
+func (v *streamlits) Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetails, error) {
+	opts := &streamlitDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := streamlitDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toStreamlitDetails(), nil
+}
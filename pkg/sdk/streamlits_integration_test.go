@@ -0,0 +1,52 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StreamlitsCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	stageID := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE STAGE %s", stageID.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t))
+	rootLocation := fmt.Sprintf("@%s", stageID.FullyQualifiedName())
+	err = client.Streamlits.Create(ctx, id, &StreamlitCreateOptions{
+		RootLocation: rootLocation,
+		MainFile:     "streamlit_app.py",
+		Comment:      String("test comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Streamlits.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	})
+
+	streamlit, err := client.Streamlits.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), streamlit.Name)
+	assert.Equal(t, "test comment", streamlit.Comment)
+
+	err = client.Streamlits.Alter(ctx, id, &StreamlitAlterOptions{
+		Set: &StreamlitSet{
+			MainFile: String("app.py"),
+		},
+	})
+	require.NoError(t, err)
+
+	details, err := client.Streamlits.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "app.py", details.MainFile)
+}
@@ -0,0 +1,148 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamlitCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only required options", func(t *testing.T) {
+		opts := &StreamlitCreateOptions{
+			name:         id,
+			RootLocation: "@db.schema.stage/app",
+			MainFile:     "streamlit_app.py",
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE STREAMLIT %s ROOT_LOCATION = '@db.schema.stage/app' MAIN_FILE = 'streamlit_app.py'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &StreamlitCreateOptions{
+			OrReplace:      Bool(true),
+			name:           id,
+			RootLocation:   "@db.schema.stage/app",
+			MainFile:       "streamlit_app.py",
+			QueryWarehouse: NewAccountObjectIdentifier("wh"),
+			Title:          String("My app"),
+			Comment:        String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE STREAMLIT %s ROOT_LOCATION = '@db.schema.stage/app' MAIN_FILE = 'streamlit_app.py' QUERY_WAREHOUSE = "wh" TITLE = 'My app' COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: missing main file", func(t *testing.T) {
+		opts := &StreamlitCreateOptions{
+			name:         id,
+			RootLocation: "@db.schema.stage/app",
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStreamlitAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &StreamlitAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &StreamlitSet{
+				MainFile:       String("app.py"),
+				QueryWarehouse: NewAccountObjectIdentifier("wh"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER STREAMLIT IF EXISTS %s SET MAIN_FILE = 'app.py' QUERY_WAREHOUSE = "wh"`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &StreamlitAlterOptions{
+			name: id,
+			Unset: &StreamlitUnset{
+				QueryWarehouse: Bool(true),
+				Comment:        Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER STREAMLIT %s UNSET QUERY_WAREHOUSE,COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), randomString(t))
+		opts := &StreamlitAlterOptions{
+			name:    id,
+			NewName: newID,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER STREAMLIT %s RENAME TO %s`, id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: rename and set", func(t *testing.T) {
+		opts := &StreamlitAlterOptions{
+			name:    id,
+			NewName: randomSchemaObjectIdentifier(t),
+			Set: &StreamlitSet{
+				Comment: String("test comment"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStreamlitDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &StreamlitDropOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DROP STREAMLIT %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestStreamlitShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &StreamlitShowOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW STREAMLITS"
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestStreamlitDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &streamlitDescribeOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`DESCRIBE STREAMLIT %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
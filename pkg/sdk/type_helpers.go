@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"strconv"
+	"strings"
 )
 
 // String returns a pointer to the given string.
@@ -27,3 +28,16 @@ func toInt(s string) int {
 	}
 	return i
 }
+
+// parseBracketedList turns a list returned by Snowflake in the form of [a, b] or ["a","b"] into a slice.
+func parseBracketedList(s string) []string {
+	s = strings.Trim(strings.TrimSpace(s), "[]")
+	items := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.Trim(strings.TrimSpace(item), `"'`)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,660 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Users = (*users)(nil)
+
+// Users describes all the user related methods that the Snowflake API supports.
+type Users interface {
+	// Create creates a new user.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *UserCreateOptions) error
+	// Alter modifies an existing user.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *UserAlterOptions) error
+	// Drop removes a user.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *UserDropOptions) error
+	// Show returns a list of users.
+	Show(ctx context.Context, opts *UserShowOptions) ([]*User, error)
+	// ShowByID returns a user by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error)
+	// Describe returns the details of a user.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*UserDetails, error)
+	// ShowParameters returns the parameters of a user.
+	ShowParameters(ctx context.Context, id AccountObjectIdentifier) ([]*UserParameter, error)
+}
+
+// users implements Users.
+type users struct {
+	client *Client
+}
+
+type UserType string
+
+var (
+	UserTypeService       UserType = "SERVICE"
+	UserTypeLegacyService UserType = "LEGACY_SERVICE"
+	UserTypePerson        UserType = "PERSON"
+)
+
+// AllUserTypes lists the types a user can have.
+var AllUserTypes = []UserType{UserTypeService, UserTypeLegacyService, UserTypePerson}
+
+// SecondaryRole is a single entry of the DEFAULT_SECONDARY_ROLES of a user.
+type SecondaryRole struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+// UserObjectProperties are the properties of a user that can be set on creation and with ALTER USER ... SET.
+type UserObjectProperties struct {
+	Password              *string         `ddl:"parameter,single_quotes" db:"PASSWORD"`
+	LoginName             *string         `ddl:"parameter,single_quotes" db:"LOGIN_NAME"`
+	DisplayName           *string         `ddl:"parameter,single_quotes" db:"DISPLAY_NAME"`
+	FirstName             *string         `ddl:"parameter,single_quotes" db:"FIRST_NAME"`
+	MiddleName            *string         `ddl:"parameter,single_quotes" db:"MIDDLE_NAME"`
+	LastName              *string         `ddl:"parameter,single_quotes" db:"LAST_NAME"`
+	Email                 *string         `ddl:"parameter,single_quotes" db:"EMAIL"`
+	MustChangePassword    *bool           `ddl:"parameter" db:"MUST_CHANGE_PASSWORD"`
+	Disabled              *bool           `ddl:"parameter" db:"DISABLED"`
+	DaysToExpiry          *int            `ddl:"parameter" db:"DAYS_TO_EXPIRY"`
+	MinsToUnlock          *int            `ddl:"parameter" db:"MINS_TO_UNLOCK"`
+	DefaultWarehouse      *string         `ddl:"parameter,single_quotes" db:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace      *string         `ddl:"parameter,single_quotes" db:"DEFAULT_NAMESPACE"`
+	DefaultRole           *string         `ddl:"parameter,single_quotes" db:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles []SecondaryRole `ddl:"parameter,parentheses" db:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA       *int            `ddl:"parameter" db:"MINS_TO_BYPASS_MFA"`
+	RSAPublicKey          *string         `ddl:"parameter,single_quotes" db:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2         *string         `ddl:"parameter,single_quotes" db:"RSA_PUBLIC_KEY_2"`
+	Type                  *UserType       `ddl:"parameter" db:"TYPE"`
+	Comment               *string         `ddl:"parameter,single_quotes" db:"COMMENT"`
+}
+
+func (v *UserObjectProperties) validate() error {
+	if valueSet(v.DaysToExpiry) && !validateIntGreaterThanOrEqual(*v.DaysToExpiry, 0) {
+		return errors.New("DaysToExpiry must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToUnlock) && !validateIntGreaterThanOrEqual(*v.MinsToUnlock, 0) {
+		return errors.New("MinsToUnlock must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToBypassMFA) && !validateIntGreaterThanOrEqual(*v.MinsToBypassMFA, 0) {
+		return errors.New("MinsToBypassMFA must be greater than or equal to 0")
+	}
+	if valueSet(v.Type) {
+		if err := validateUserType(*v.Type); err != nil {
+			return err
+		}
+		if *v.Type == UserTypeService && anyValueSet(v.Password, v.MustChangePassword) {
+			return fmt.Errorf("Password and MustChangePassword cannot be set on %s users", UserTypeService)
+		}
+	}
+	return nil
+}
+
+// validateUserType checks that the user type is one of the known types.
+func validateUserType(userType UserType) error {
+	for _, t := range AllUserTypes {
+		if userType == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("Type must be one of %s, %s, %s", UserTypeService, UserTypeLegacyService, UserTypePerson)
+}
+
+// UserObjectParams are the object parameters of a user.
+type UserObjectParams struct {
+	NetworkPolicy *string `ddl:"parameter,single_quotes" db:"NETWORK_POLICY"`
+}
+
+// UserSessionParams are the session parameters that can be set on a user.
+type UserSessionParams struct {
+	Timezone *string `ddl:"parameter,single_quotes" db:"TIMEZONE"`
+	QueryTag *string `ddl:"parameter,single_quotes" db:"QUERY_TAG"`
+}
+
+type UserCreateOptions struct {
+	create      bool                    `ddl:"static" db:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                   `ddl:"keyword" db:"OR REPLACE"`
+	user        bool                    `ddl:"static" db:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" db:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	ObjectProperties *UserObjectProperties `ddl:"keyword"`
+	ObjectParams     *UserObjectParams     `ddl:"keyword"`
+	SessionParams    *UserSessionParams    `ddl:"keyword"`
+	Tag              []TagAssociation      `ddl:"keyword,parentheses" db:"TAG"`
+}
+
+func (opts *UserCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OrReplace and IfNotExists cannot both be true")
+	}
+	if valueSet(opts.ObjectProperties) {
+		if err := opts.ObjectProperties.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *UserCreateOptions) error {
+	if opts == nil {
+		opts = &UserCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type UserAlterOptions struct {
+	alter    bool                    `ddl:"static" db:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" db:"USER"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+
+	NewName AccountObjectIdentifier `ddl:"identifier" db:"RENAME TO"`
+	Set     *UserSet                `ddl:"keyword" db:"SET"`
+	Unset   *UserUnset              `ddl:"list,no_parentheses" db:"UNSET"`
+}
+
+func (opts *UserAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type UserSet struct {
+	ObjectProperties *UserObjectProperties `ddl:"keyword"`
+	ObjectParams     *UserObjectParams     `ddl:"keyword"`
+	SessionParams    *UserSessionParams    `ddl:"keyword"`
+}
+
+func (v *UserSet) validate() error {
+	if everyValueNil(v.ObjectProperties, v.ObjectParams, v.SessionParams) {
+		return errors.New("at least one of ObjectProperties, ObjectParams, SessionParams must be set")
+	}
+	if valueSet(v.ObjectProperties) {
+		return v.ObjectProperties.validate()
+	}
+	return nil
+}
+
+type UserUnset struct {
+	// Object properties
+	Password              *bool `ddl:"keyword" db:"PASSWORD"`
+	LoginName             *bool `ddl:"keyword" db:"LOGIN_NAME"`
+	DisplayName           *bool `ddl:"keyword" db:"DISPLAY_NAME"`
+	FirstName             *bool `ddl:"keyword" db:"FIRST_NAME"`
+	MiddleName            *bool `ddl:"keyword" db:"MIDDLE_NAME"`
+	LastName              *bool `ddl:"keyword" db:"LAST_NAME"`
+	Email                 *bool `ddl:"keyword" db:"EMAIL"`
+	MustChangePassword    *bool `ddl:"keyword" db:"MUST_CHANGE_PASSWORD"`
+	Disabled              *bool `ddl:"keyword" db:"DISABLED"`
+	DaysToExpiry          *bool `ddl:"keyword" db:"DAYS_TO_EXPIRY"`
+	MinsToUnlock          *bool `ddl:"keyword" db:"MINS_TO_UNLOCK"`
+	DefaultWarehouse      *bool `ddl:"keyword" db:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace      *bool `ddl:"keyword" db:"DEFAULT_NAMESPACE"`
+	DefaultRole           *bool `ddl:"keyword" db:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles *bool `ddl:"keyword" db:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA       *bool `ddl:"keyword" db:"MINS_TO_BYPASS_MFA"`
+	RSAPublicKey          *bool `ddl:"keyword" db:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2         *bool `ddl:"keyword" db:"RSA_PUBLIC_KEY_2"`
+	Type                  *bool `ddl:"keyword" db:"TYPE"`
+	Comment               *bool `ddl:"keyword" db:"COMMENT"`
+
+	// Object params
+	NetworkPolicy *bool `ddl:"keyword" db:"NETWORK_POLICY"`
+
+	// Session params
+	Timezone *bool `ddl:"keyword" db:"TIMEZONE"`
+	QueryTag *bool `ddl:"keyword" db:"QUERY_TAG"`
+}
+
+func (v *UserUnset) validate() error {
+	if !anyValueSet(v.Password, v.LoginName, v.DisplayName, v.FirstName, v.MiddleName, v.LastName, v.Email,
+		v.MustChangePassword, v.Disabled, v.DaysToExpiry, v.MinsToUnlock, v.DefaultWarehouse, v.DefaultNamespace,
+		v.DefaultRole, v.DefaultSecondaryRoles, v.MinsToBypassMFA, v.RSAPublicKey, v.RSAPublicKey2, v.Type, v.Comment,
+		v.NetworkPolicy, v.Timezone, v.QueryTag) {
+		return errors.New("at least one property or parameter must be unset")
+	}
+	return nil
+}
+
+func (v *users) Alter(ctx context.Context, id AccountObjectIdentifier, opts *UserAlterOptions) error {
+	if opts == nil {
+		opts = &UserAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type UserDropOptions struct {
+	drop     bool                    `ddl:"static" db:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" db:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" db:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *UserDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier, opts *UserDropOptions) error {
+	if opts == nil {
+		opts = &UserDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// UserShowOptions represents the options for listing users.
+type UserShowOptions struct {
+	show       bool    `ddl:"static" db:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse      *bool   `ddl:"keyword" db:"TERSE"`
+	users      bool    `ddl:"static" db:"USERS"` //lint:ignore U1000 This is used in the ddl tag
+	Like       *Like   `ddl:"keyword" db:"LIKE"`
+	StartsWith *string `ddl:"parameter,no_equals,single_quotes" db:"STARTS WITH"`
+	Limit      *int    `ddl:"parameter,no_equals" db:"LIMIT"`
+	From       *string `ddl:"parameter,no_equals,single_quotes" db:"FROM"`
+}
+
+func (opts *UserShowOptions) validate() error {
+	if valueSet(opts.From) && !valueSet(opts.Limit) {
+		return errors.New("From can only be set with Limit")
+	}
+	return nil
+}
+
+// User is a user friendly result for a SHOW USERS query.
+type User struct {
+	Name                  string
+	CreatedOn             time.Time
+	LoginName             string
+	DisplayName           string
+	FirstName             string
+	LastName              string
+	Email                 string
+	MinsToUnlock          int
+	DaysToExpiry          int
+	Comment               string
+	Disabled              bool
+	MustChangePassword    bool
+	SnowflakeLock         bool
+	DefaultWarehouse      string
+	DefaultNamespace      string
+	DefaultRole           string
+	DefaultSecondaryRoles []string
+	ExtAuthnDuo           bool
+	ExtAuthnUID           string
+	MinsToBypassMFA       int
+	Owner                 string
+	LastSuccessLogin      time.Time
+	ExpiresAtTime         time.Time
+	LockedUntilTime       time.Time
+	HasPassword           bool
+	HasRSAPublicKey       bool
+	Type                  UserType
+	HasMFA                bool
+}
+
+func (v *User) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// userDBRow is used to decode the result of a SHOW USERS query.
+type userDBRow struct {
+	Name                  string         `db:"name"`
+	CreatedOn             time.Time      `db:"created_on"`
+	LoginName             sql.NullString `db:"login_name"`
+	DisplayName           sql.NullString `db:"display_name"`
+	FirstName             sql.NullString `db:"first_name"`
+	LastName              sql.NullString `db:"last_name"`
+	Email                 sql.NullString `db:"email"`
+	MinsToUnlock          sql.NullString `db:"mins_to_unlock"`
+	DaysToExpiry          sql.NullString `db:"days_to_expiry"`
+	Comment               sql.NullString `db:"comment"`
+	Disabled              sql.NullString `db:"disabled"`
+	MustChangePassword    sql.NullString `db:"must_change_password"`
+	SnowflakeLock         sql.NullString `db:"snowflake_lock"`
+	DefaultWarehouse      sql.NullString `db:"default_warehouse"`
+	DefaultNamespace      sql.NullString `db:"default_namespace"`
+	DefaultRole           sql.NullString `db:"default_role"`
+	DefaultSecondaryRoles sql.NullString `db:"default_secondary_roles"`
+	ExtAuthnDuo           sql.NullString `db:"ext_authn_duo"`
+	ExtAuthnUID           sql.NullString `db:"ext_authn_uid"`
+	MinsToBypassMFA       sql.NullString `db:"mins_to_bypass_mfa"`
+	Owner                 sql.NullString `db:"owner"`
+	LastSuccessLogin      sql.NullTime   `db:"last_success_login"`
+	ExpiresAtTime         sql.NullTime   `db:"expires_at_time"`
+	LockedUntilTime       sql.NullTime   `db:"locked_until_time"`
+	HasPassword           sql.NullString `db:"has_password"`
+	HasRSAPublicKey       sql.NullString `db:"has_rsa_public_key"`
+	Type                  sql.NullString `db:"type"`
+	HasMFA                sql.NullString `db:"has_mfa"`
+}
+
+func (row userDBRow) toUser() *User {
+	return &User{
+		Name:                  row.Name,
+		CreatedOn:             row.CreatedOn,
+		LoginName:             row.LoginName.String,
+		DisplayName:           row.DisplayName.String,
+		FirstName:             row.FirstName.String,
+		LastName:              row.LastName.String,
+		Email:                 row.Email.String,
+		MinsToUnlock:          parseUserInt(row.MinsToUnlock.String),
+		DaysToExpiry:          parseUserInt(row.DaysToExpiry.String),
+		Comment:               row.Comment.String,
+		Disabled:              parseUserBool(row.Disabled.String),
+		MustChangePassword:    parseUserBool(row.MustChangePassword.String),
+		SnowflakeLock:         parseUserBool(row.SnowflakeLock.String),
+		DefaultWarehouse:      row.DefaultWarehouse.String,
+		DefaultNamespace:      row.DefaultNamespace.String,
+		DefaultRole:           row.DefaultRole.String,
+		DefaultSecondaryRoles: parseBracketedList(row.DefaultSecondaryRoles.String),
+		ExtAuthnDuo:           parseUserBool(row.ExtAuthnDuo.String),
+		ExtAuthnUID:           row.ExtAuthnUID.String,
+		MinsToBypassMFA:       parseUserInt(row.MinsToBypassMFA.String),
+		Owner:                 row.Owner.String,
+		LastSuccessLogin:      row.LastSuccessLogin.Time,
+		ExpiresAtTime:         row.ExpiresAtTime.Time,
+		LockedUntilTime:       row.LockedUntilTime.Time,
+		HasPassword:           parseUserBool(row.HasPassword.String),
+		HasRSAPublicKey:       parseUserBool(row.HasRSAPublicKey.String),
+		Type:                  UserType(strings.ToUpper(row.Type.String)),
+		HasMFA:                parseUserBool(row.HasMFA.String),
+	}
+}
+
+// parseUserInt parses the numeric columns of users, which are empty or "null" when not set. DAYS_TO_EXPIRY is
+// returned with a fractional part, which is truncated.
+func parseUserInt(s string) int {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0
+	}
+	return int(f)
+}
+
+// parseUserBool parses the boolean columns of users.
+func parseUserBool(s string) bool {
+	b, err := strconv.ParseBool(strings.TrimSpace(s))
+	return err == nil && b
+}
+
+func (v *users) Show(ctx context.Context, opts *UserShowOptions) ([]*User, error) {
+	if opts == nil {
+		opts = &UserShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []userDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*User, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toUser()
+	}
+	return resultList, nil
+}
+
+func (v *users) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error) {
+	users, err := v.Show(ctx, &UserShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.ID().name == id.Name() {
+			return user, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type userDescribeOptions struct {
+	describe bool                    `ddl:"static" db:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" db:"USER"`     //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *userDescribeOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// UserDetails is a user friendly result for a DESCRIBE USER query.
+type UserDetails struct {
+	Name                  *StringProperty
+	Comment               *StringProperty
+	DisplayName           *StringProperty
+	Type                  *StringProperty
+	LoginName             *StringProperty
+	FirstName             *StringProperty
+	MiddleName            *StringProperty
+	LastName              *StringProperty
+	Email                 *StringProperty
+	Password              *StringProperty
+	MustChangePassword    *StringProperty
+	Disabled              *StringProperty
+	SnowflakeLock         *StringProperty
+	SnowflakeSupport      *StringProperty
+	DaysToExpiry          *StringProperty
+	MinsToUnlock          *StringProperty
+	DefaultWarehouse      *StringProperty
+	DefaultNamespace      *StringProperty
+	DefaultRole           *StringProperty
+	DefaultSecondaryRoles *StringProperty
+	ExtAuthnDuo           *StringProperty
+	ExtAuthnUID           *StringProperty
+	MinsToBypassMFA       *StringProperty
+	RSAPublicKeyFp        *StringProperty
+	RSAPublicKey2Fp       *StringProperty
+	PasswordLastSetTime   *StringProperty
+}
+
+func userDetailsFromRows(rows []propertyRow) *UserDetails {
+	v := &UserDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "NAME":
+			v.Name = row.toStringProperty()
+		case "COMMENT":
+			v.Comment = row.toStringProperty()
+		case "DISPLAY_NAME":
+			v.DisplayName = row.toStringProperty()
+		case "TYPE":
+			v.Type = row.toStringProperty()
+		case "LOGIN_NAME":
+			v.LoginName = row.toStringProperty()
+		case "FIRST_NAME":
+			v.FirstName = row.toStringProperty()
+		case "MIDDLE_NAME":
+			v.MiddleName = row.toStringProperty()
+		case "LAST_NAME":
+			v.LastName = row.toStringProperty()
+		case "EMAIL":
+			v.Email = row.toStringProperty()
+		case "PASSWORD":
+			v.Password = row.toStringProperty()
+		case "MUST_CHANGE_PASSWORD":
+			v.MustChangePassword = row.toStringProperty()
+		case "DISABLED":
+			v.Disabled = row.toStringProperty()
+		case "SNOWFLAKE_LOCK":
+			v.SnowflakeLock = row.toStringProperty()
+		case "SNOWFLAKE_SUPPORT":
+			v.SnowflakeSupport = row.toStringProperty()
+		case "DAYS_TO_EXPIRY":
+			v.DaysToExpiry = row.toStringProperty()
+		case "MINS_TO_UNLOCK":
+			v.MinsToUnlock = row.toStringProperty()
+		case "DEFAULT_WAREHOUSE":
+			v.DefaultWarehouse = row.toStringProperty()
+		case "DEFAULT_NAMESPACE":
+			v.DefaultNamespace = row.toStringProperty()
+		case "DEFAULT_ROLE":
+			v.DefaultRole = row.toStringProperty()
+		case "DEFAULT_SECONDARY_ROLES":
+			v.DefaultSecondaryRoles = row.toStringProperty()
+		case "EXT_AUTHN_DUO":
+			v.ExtAuthnDuo = row.toStringProperty()
+		case "EXT_AUTHN_UID":
+			v.ExtAuthnUID = row.toStringProperty()
+		case "MINS_TO_BYPASS_MFA":
+			v.MinsToBypassMFA = row.toStringProperty()
+		case "RSA_PUBLIC_KEY_FP":
+			v.RSAPublicKeyFp = row.toStringProperty()
+		case "RSA_PUBLIC_KEY_2_FP":
+			v.RSAPublicKey2Fp = row.toStringProperty()
+		case "PASSWORD_LAST_SET_TIME":
+			v.PasswordLastSetTime = row.toStringProperty()
+		}
+	}
+	return v
+}
+
+func (v *users) Describe(ctx context.Context, id AccountObjectIdentifier) (*UserDetails, error) {
+	opts := &userDescribeOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []propertyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return userDetailsFromRows(dest), nil
+}
+
+type userShowParametersOptions struct {
+	show       bool                    `ddl:"static" db:"SHOW"`          //lint:ignore U1000 This is used in the ddl tag
+	parameters bool                    `ddl:"static" db:"PARAMETERS IN"` //lint:ignore U1000 This is used in the ddl tag
+	user       bool                    `ddl:"static" db:"USER"`          //lint:ignore U1000 This is used in the ddl tag
+	name       AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (v *userShowParametersOptions) validate() error {
+	if !validObjectidentifier(v.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// UserParameter is a user friendly result for a SHOW PARAMETERS IN USER query.
+type UserParameter struct {
+	Key          string
+	Value        string
+	DefaultValue string
+	Level        string
+	Description  string
+}
+
+// userParameterRow is used to decode the result of a SHOW PARAMETERS IN USER query.
+type userParameterRow struct {
+	Key          string         `db:"key"`
+	Value        sql.NullString `db:"value"`
+	DefaultValue sql.NullString `db:"default"`
+	Level        sql.NullString `db:"level"`
+	Description  sql.NullString `db:"description"`
+}
+
+func (row userParameterRow) toUserParameter() *UserParameter {
+	return &UserParameter{
+		Key:          row.Key,
+		Value:        row.Value.String,
+		DefaultValue: row.DefaultValue.String,
+		Level:        row.Level.String,
+		Description:  row.Description.String,
+	}
+}
+
+func (v *users) ShowParameters(ctx context.Context, id AccountObjectIdentifier) ([]*UserParameter, error) {
+	opts := &userShowParametersOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []userParameterRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*UserParameter, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toUserParameter()
+	}
+	return resultList, nil
+}
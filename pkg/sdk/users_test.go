@@ -0,0 +1,227 @@
+package sdk
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &UserCreateOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE USER %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &UserCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			ObjectProperties: &UserObjectProperties{
+				Password:              String("secret"),
+				LoginName:             String("login"),
+				MustChangePassword:    Bool(true),
+				DaysToExpiry:          Int(30),
+				MinsToUnlock:          Int(5),
+				DefaultSecondaryRoles: []SecondaryRole{{Value: "ALL"}},
+				MinsToBypassMFA:       Int(10),
+				Type:                  &UserTypePerson,
+				Comment:               String("comment"),
+			},
+			ObjectParams: &UserObjectParams{
+				NetworkPolicy: String("policy"),
+			},
+			SessionParams: &UserSessionParams{
+				Timezone: String("Europe/Warsaw"),
+				QueryTag: String("tag"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE USER %s PASSWORD = 'secret' LOGIN_NAME = 'login' MUST_CHANGE_PASSWORD = true DAYS_TO_EXPIRY = 30 MINS_TO_UNLOCK = 5 DEFAULT_SECONDARY_ROLES = ('ALL') MINS_TO_BYPASS_MFA = 10 TYPE = PERSON COMMENT = 'comment' NETWORK_POLICY = 'policy' TIMEZONE = 'Europe/Warsaw' QUERY_TAG = 'tag'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: password on a service user", func(t *testing.T) {
+		opts := &UserCreateOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Password: String("secret"),
+				Type:     &UserTypeService,
+			},
+		}
+		assert.EqualError(t, opts.validate(), "Password and MustChangePassword cannot be set on SERVICE users")
+	})
+
+	t.Run("validation: unknown type", func(t *testing.T) {
+		userType := UserType("ROBOT")
+		opts := &UserCreateOptions{
+			name:             id,
+			ObjectProperties: &UserObjectProperties{Type: &userType},
+		}
+		assert.EqualError(t, opts.validate(), "Type must be one of SERVICE, LEGACY_SERVICE, PERSON")
+	})
+}
+
+func TestUserAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("validation: no option", func(t *testing.T) {
+		opts := &UserAlterOptions{
+			name: id,
+		}
+		assert.EqualError(t, opts.validate(), "exactly one of NewName, Set, Unset must be set")
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := randomAccountObjectIdentifier(t)
+		opts := &UserAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  newID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &UserAlterOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Type:         &UserTypeService,
+					MinsToUnlock: Int(0),
+				},
+				SessionParams: &UserSessionParams{
+					QueryTag: String("tag"),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s SET MINS_TO_UNLOCK = 0 TYPE = SERVICE QUERY_TAG = 'tag'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &UserAlterOptions{
+			name: id,
+			Unset: &UserUnset{
+				Type:          Bool(true),
+				NetworkPolicy: Bool(true),
+				Timezone:      Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s UNSET TYPE,NETWORK_POLICY,TIMEZONE", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := &UserAlterOptions{
+			name:  id,
+			Unset: &UserUnset{},
+		}
+		assert.EqualError(t, opts.validate(), "at least one property or parameter must be unset")
+	})
+}
+
+func TestUserDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &UserDropOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("DROP USER IF EXISTS %s", id.FullyQualifiedName()), actual)
+}
+
+func TestUserShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&UserShowOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW USERS", actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &UserShowOptions{
+			Terse:      Bool(true),
+			Like:       &Like{Pattern: String("user%")},
+			StartsWith: String("user"),
+			Limit:      Int(10),
+			From:       String("user_a"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW TERSE USERS LIKE 'user%' STARTS WITH 'user' LIMIT 10 FROM 'user_a'", actual)
+	})
+
+	t.Run("validation: from without limit", func(t *testing.T) {
+		opts := &UserShowOptions{From: String("user_a")}
+		assert.EqualError(t, opts.validate(), "From can only be set with Limit")
+	})
+}
+
+func TestUserDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	actual, err := structToSQL(&userDescribeOptions{name: id})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("DESCRIBE USER %s", id.FullyQualifiedName()), actual)
+
+	actual, err = structToSQL(&userShowParametersOptions{name: id})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("SHOW PARAMETERS IN USER %s", id.FullyQualifiedName()), actual)
+}
+
+func TestUserDBRowToUser(t *testing.T) {
+	createdOn := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+	row := userDBRow{
+		Name:                  "user",
+		CreatedOn:             createdOn,
+		MinsToUnlock:          sql.NullString{String: "null", Valid: true},
+		DaysToExpiry:          sql.NullString{String: "29.99", Valid: true},
+		MinsToBypassMFA:       sql.NullString{String: "5", Valid: true},
+		Disabled:              sql.NullString{String: "false", Valid: true},
+		HasPassword:           sql.NullString{String: "true", Valid: true},
+		DefaultSecondaryRoles: sql.NullString{String: `["ALL"]`, Valid: true},
+		Type:                  sql.NullString{String: "service", Valid: true},
+	}
+	user := row.toUser()
+	assert.Equal(t, "user", user.Name)
+	assert.Equal(t, createdOn, user.CreatedOn)
+	assert.Equal(t, 0, user.MinsToUnlock)
+	assert.Equal(t, 29, user.DaysToExpiry)
+	assert.Equal(t, 5, user.MinsToBypassMFA)
+	assert.False(t, user.Disabled)
+	assert.True(t, user.HasPassword)
+	assert.Equal(t, []string{"ALL"}, user.DefaultSecondaryRoles)
+	assert.Equal(t, UserTypeService, user.Type)
+}
+
+func TestUserDetailsFromRows(t *testing.T) {
+	details := userDetailsFromRows([]propertyRow{
+		{Property: "NAME", Value: "user"},
+		{Property: "TYPE", Value: "SERVICE"},
+		{Property: "MINS_TO_UNLOCK", Value: "null"},
+		{Property: "RSA_PUBLIC_KEY_FP", Value: "SHA256:fp"},
+	})
+	assert.Equal(t, "user", details.Name.Value)
+	assert.Equal(t, "SERVICE", details.Type.Value)
+	assert.Equal(t, "", details.MinsToUnlock.Value)
+	assert.Equal(t, "SHA256:fp", details.RSAPublicKeyFp.Value)
+	assert.Nil(t, details.Email)
+}
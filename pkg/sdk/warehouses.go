@@ -22,6 +22,12 @@ type Warehouses interface {
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error)
 	// Describe returns the details of a warehouse.
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*WarehouseDetails, error)
+	// Suspend suspends a warehouse.
+	Suspend(ctx context.Context, id AccountObjectIdentifier) error
+	// Resume resumes a warehouse. With ifSuspended, resuming a warehouse that is already running is not an error.
+	Resume(ctx context.Context, id AccountObjectIdentifier, ifSuspended bool) error
+	// AbortAllQueries aborts all the queries running or queued on a warehouse.
+	AbortAllQueries(ctx context.Context, id AccountObjectIdentifier) error
 }
 
 var _ Warehouses = (*warehouses)(nil)
@@ -52,6 +58,33 @@ var (
 	WarehouseSizeX6Large  WarehouseSize = "X6LARGE"
 )
 
+type WarehouseResourceConstraint string
+
+var (
+	WarehouseResourceConstraintMemory1X     WarehouseResourceConstraint = "MEMORY_1X"
+	WarehouseResourceConstraintMemory1Xx86  WarehouseResourceConstraint = "MEMORY_1X_x86"
+	WarehouseResourceConstraintMemory16X    WarehouseResourceConstraint = "MEMORY_16X"
+	WarehouseResourceConstraintMemory16Xx86 WarehouseResourceConstraint = "MEMORY_16X_x86"
+	WarehouseResourceConstraintMemory64X    WarehouseResourceConstraint = "MEMORY_64X"
+	WarehouseResourceConstraintMemory64Xx86 WarehouseResourceConstraint = "MEMORY_64X_x86"
+)
+
+// validateWarehouseTypeAndSize checks that the size and resource constraint are supported by the warehouse type.
+// Unset values are not checked, since they fall back to the current or default values of the warehouse.
+func validateWarehouseTypeAndSize(warehouseType *WarehouseType, size *WarehouseSize, constraint *WarehouseResourceConstraint) error {
+	snowparkOptimized := valueSet(warehouseType) && *warehouseType == WarehouseTypeSnowparkOptimized
+	if snowparkOptimized && valueSet(size) {
+		switch *size {
+		case WarehouseSizeXSmall, WarehouseSizeSmall, WarehouseSizeX5Large, WarehouseSizeX6Large:
+			return fmt.Errorf("WarehouseSize %s is not supported by %s warehouses", *size, WarehouseTypeSnowparkOptimized)
+		}
+	}
+	if valueSet(constraint) && valueSet(warehouseType) && !snowparkOptimized {
+		return fmt.Errorf("ResourceConstraint can only be set on %s warehouses", WarehouseTypeSnowparkOptimized)
+	}
+	return nil
+}
+
 type ScalingPolicy string
 
 var (
@@ -67,18 +100,19 @@ type WarehouseCreateOptions struct {
 	name        AccountObjectIdentifier `ddl:"identifier"`
 
 	// Object properties
-	WarehouseType                   *WarehouseType `ddl:"parameter,single_quotes" db:"WAREHOUSE_TYPE"`
-	WarehouseSize                   *WarehouseSize `ddl:"parameter,single_quotes" db:"WAREHOUSE_SIZE"`
-	MaxClusterCount                 *int           `ddl:"parameter" db:"MAX_CLUSTER_COUNT"`
-	MinClusterCount                 *int           `ddl:"parameter" db:"MIN_CLUSTER_COUNT"`
-	ScalingPolicy                   *ScalingPolicy `ddl:"parameter,single_quotes" db:"SCALING_POLICY"`
-	AutoSuspend                     *int           `ddl:"parameter" db:"AUTO_SUSPEND"`
-	AutoResume                      *bool          `ddl:"parameter" db:"AUTO_RESUME"`
-	InitiallySuspended              *bool          `ddl:"parameter" db:"INITIALLY_SUSPENDED"`
-	ResourceMonitor                 *string        `ddl:"parameter,double_quotes" db:"RESOURCE_MONITOR"`
-	Comment                         *string        `ddl:"parameter,single_quotes" db:"COMMENT"`
-	EnableQueryAcceleration         *bool          `ddl:"parameter" db:"ENABLE_QUERY_ACCELERATION"`
-	QueryAccelerationMaxScaleFactor *int           `ddl:"parameter" db:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
+	WarehouseType                   *WarehouseType               `ddl:"parameter,single_quotes" db:"WAREHOUSE_TYPE"`
+	WarehouseSize                   *WarehouseSize               `ddl:"parameter,single_quotes" db:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *WarehouseResourceConstraint `ddl:"parameter,single_quotes" db:"RESOURCE_CONSTRAINT"`
+	MaxClusterCount                 *int                         `ddl:"parameter" db:"MAX_CLUSTER_COUNT"`
+	MinClusterCount                 *int                         `ddl:"parameter" db:"MIN_CLUSTER_COUNT"`
+	ScalingPolicy                   *ScalingPolicy               `ddl:"parameter,single_quotes" db:"SCALING_POLICY"`
+	AutoSuspend                     *int                         `ddl:"parameter" db:"AUTO_SUSPEND"`
+	AutoResume                      *bool                        `ddl:"parameter" db:"AUTO_RESUME"`
+	InitiallySuspended              *bool                        `ddl:"parameter" db:"INITIALLY_SUSPENDED"`
+	ResourceMonitor                 *string                      `ddl:"parameter,double_quotes" db:"RESOURCE_MONITOR"`
+	Comment                         *string                      `ddl:"parameter,single_quotes" db:"COMMENT"`
+	EnableQueryAcceleration         *bool                        `ddl:"parameter" db:"ENABLE_QUERY_ACCELERATION"`
+	QueryAccelerationMaxScaleFactor *int                         `ddl:"parameter" db:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
 
 	// Object params
 	MaxConcurrencyLevel             *int             `ddl:"parameter" db:"MAX_CONCURRENCY_LEVEL"`
@@ -103,6 +137,12 @@ func (opts *WarehouseCreateOptions) validate() error {
 	if valueSet(opts.QueryAccelerationMaxScaleFactor) && !validateIntInRange(*opts.QueryAccelerationMaxScaleFactor, 0, 100) {
 		return fmt.Errorf("QueryAccelerationMaxScaleFactor must be between 0 and 100")
 	}
+	if valueSet(opts.ResourceConstraint) && !valueSet(opts.WarehouseType) {
+		return fmt.Errorf("ResourceConstraint can only be set on %s warehouses", WarehouseTypeSnowparkOptimized)
+	}
+	if err := validateWarehouseTypeAndSize(opts.WarehouseType, opts.WarehouseSize, opts.ResourceConstraint); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -175,18 +215,19 @@ func (opts *WarehouseAlterOptions) validate() error {
 
 type WarehouseSet struct {
 	// Object properties
-	WarehouseType                   *WarehouseType          `ddl:"parameter,single_quotes" db:"WAREHOUSE_TYPE"`
-	WarehouseSize                   *WarehouseSize          `ddl:"parameter,single_quotes" db:"WAREHOUSE_SIZE"`
-	WaitForCompletion               *bool                   `ddl:"parameter" db:"WAIT_FOR_COMPLETION"`
-	MaxClusterCount                 *int                    `ddl:"parameter" db:"MAX_CLUSTER_COUNT"`
-	MinClusterCount                 *int                    `ddl:"parameter" db:"MIN_CLUSTER_COUNT"`
-	ScalingPolicy                   *ScalingPolicy          `ddl:"parameter,single_quotes" db:"SCALING_POLICY"`
-	AutoSuspend                     *int                    `ddl:"parameter" db:"AUTO_SUSPEND"`
-	AutoResume                      *bool                   `ddl:"parameter" db:"AUTO_RESUME"`
-	ResourceMonitor                 AccountObjectIdentifier `ddl:"identifier,equals" db:"RESOURCE_MONITOR"`
-	Comment                         *string                 `ddl:"parameter,single_quotes" db:"COMMENT"`
-	EnableQueryAcceleration         *bool                   `ddl:"parameter" db:"ENABLE_QUERY_ACCELERATION"`
-	QueryAccelerationMaxScaleFactor *int                    `ddl:"parameter" db:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
+	WarehouseType                   *WarehouseType               `ddl:"parameter,single_quotes" db:"WAREHOUSE_TYPE"`
+	WarehouseSize                   *WarehouseSize               `ddl:"parameter,single_quotes" db:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *WarehouseResourceConstraint `ddl:"parameter,single_quotes" db:"RESOURCE_CONSTRAINT"`
+	WaitForCompletion               *bool                        `ddl:"parameter" db:"WAIT_FOR_COMPLETION"`
+	MaxClusterCount                 *int                         `ddl:"parameter" db:"MAX_CLUSTER_COUNT"`
+	MinClusterCount                 *int                         `ddl:"parameter" db:"MIN_CLUSTER_COUNT"`
+	ScalingPolicy                   *ScalingPolicy               `ddl:"parameter,single_quotes" db:"SCALING_POLICY"`
+	AutoSuspend                     *int                         `ddl:"parameter" db:"AUTO_SUSPEND"`
+	AutoResume                      *bool                        `ddl:"parameter" db:"AUTO_RESUME"`
+	ResourceMonitor                 AccountObjectIdentifier      `ddl:"identifier,equals" db:"RESOURCE_MONITOR"`
+	Comment                         *string                      `ddl:"parameter,single_quotes" db:"COMMENT"`
+	EnableQueryAcceleration         *bool                        `ddl:"parameter" db:"ENABLE_QUERY_ACCELERATION"`
+	QueryAccelerationMaxScaleFactor *int                         `ddl:"parameter" db:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
 
 	// Object params
 	MaxConcurrencyLevel             *int `ddl:"parameter" db:"MAX_CONCURRENCY_LEVEL"`
@@ -220,6 +261,9 @@ func (v *WarehouseSet) validate() error {
 	if valueSet(v.Tag) && !everyValueNil(v.AutoResume, v.EnableQueryAcceleration, v.MaxClusterCount, v.MinClusterCount, v.AutoSuspend, v.QueryAccelerationMaxScaleFactor) {
 		return fmt.Errorf("Tag cannot be set with any other Set parameter")
 	}
+	if err := validateWarehouseTypeAndSize(v.WarehouseType, v.WarehouseSize, v.ResourceConstraint); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -227,6 +271,7 @@ type WarehouseUnset struct {
 	// Object properties
 	WarehouseType                   *bool `ddl:"keyword" db:"WAREHOUSE_TYPE"`
 	WarehouseSize                   *bool `ddl:"keyword" db:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *bool `ddl:"keyword" db:"RESOURCE_CONSTRAINT"`
 	WaitForCompletion               *bool `ddl:"keyword" db:"WAIT_FOR_COMPLETION"`
 	MaxClusterCount                 *bool `ddl:"keyword" db:"MAX_CLUSTER_COUNT"`
 	MinClusterCount                 *bool `ddl:"keyword" db:"MIN_CLUSTER_COUNT"`
@@ -268,6 +313,28 @@ func (c *warehouses) Alter(ctx context.Context, id AccountObjectIdentifier, opts
 	return err
 }
 
+func (c *warehouses) Suspend(ctx context.Context, id AccountObjectIdentifier) error {
+	return c.Alter(ctx, id, &WarehouseAlterOptions{
+		Suspend: Bool(true),
+	})
+}
+
+func (c *warehouses) Resume(ctx context.Context, id AccountObjectIdentifier, ifSuspended bool) error {
+	opts := &WarehouseAlterOptions{
+		Resume: Bool(true),
+	}
+	if ifSuspended {
+		opts.IfSuspended = Bool(true)
+	}
+	return c.Alter(ctx, id, opts)
+}
+
+func (c *warehouses) AbortAllQueries(ctx context.Context, id AccountObjectIdentifier) error {
+	return c.Alter(ctx, id, &WarehouseAlterOptions{
+		AbortAllQueries: Bool(true),
+	})
+}
+
 type WarehouseDropOptions struct {
 	drop      bool                    `ddl:"static" db:"DROP"`      //lint:ignore U1000 This is used in the ddl tag
 	warehouse bool                    `ddl:"static" db:"WAREHOUSE"` //lint:ignore U1000 This is used in the ddl tag
@@ -350,40 +417,42 @@ type Warehouse struct {
 	QueryAccelerationMaxScaleFactor int
 	ResourceMonitor                 string
 	ScalingPolicy                   ScalingPolicy
+	ResourceConstraint              WarehouseResourceConstraint
 }
 
 type warehouseDBRow struct {
-	Name                            string        `db:"name"`
-	State                           string        `db:"state"`
-	Type                            string        `db:"type"`
-	Size                            string        `db:"size"`
-	MinClusterCount                 int           `db:"min_cluster_count"`
-	MaxClusterCount                 int           `db:"max_cluster_count"`
-	StartedClusters                 int           `db:"started_clusters"`
-	Running                         int           `db:"running"`
-	Queued                          int           `db:"queued"`
-	IsDefault                       string        `db:"is_default"`
-	IsCurrent                       string        `db:"is_current"`
-	AutoSuspend                     sql.NullInt64 `db:"auto_suspend"`
-	AutoResume                      bool          `db:"auto_resume"`
-	Available                       string        `db:"available"`
-	Provisioning                    string        `db:"provisioning"`
-	Quiescing                       string        `db:"quiescing"`
-	Other                           string        `db:"other"`
-	CreatedOn                       time.Time     `db:"created_on"`
-	ResumedOn                       time.Time     `db:"resumed_on"`
-	UpdatedOn                       time.Time     `db:"updated_on"`
-	Owner                           string        `db:"owner"`
-	Comment                         string        `db:"comment"`
-	EnableQueryAcceleration         bool          `db:"enable_query_acceleration"`
-	QueryAccelerationMaxScaleFactor int           `db:"query_acceleration_max_scale_factor"`
-	ResourceMonitor                 string        `db:"resource_monitor"`
-	Actives                         string        `db:"actives"`
-	Pendings                        string        `db:"pendings"`
-	Failed                          string        `db:"failed"`
-	Suspended                       string        `db:"suspended"`
-	UUID                            string        `db:"uuid"`
-	ScalingPolicy                   string        `db:"scaling_policy"`
+	Name                            string         `db:"name"`
+	State                           string         `db:"state"`
+	Type                            string         `db:"type"`
+	Size                            string         `db:"size"`
+	MinClusterCount                 int            `db:"min_cluster_count"`
+	MaxClusterCount                 int            `db:"max_cluster_count"`
+	StartedClusters                 int            `db:"started_clusters"`
+	Running                         int            `db:"running"`
+	Queued                          int            `db:"queued"`
+	IsDefault                       string         `db:"is_default"`
+	IsCurrent                       string         `db:"is_current"`
+	AutoSuspend                     sql.NullInt64  `db:"auto_suspend"`
+	AutoResume                      bool           `db:"auto_resume"`
+	Available                       string         `db:"available"`
+	Provisioning                    string         `db:"provisioning"`
+	Quiescing                       string         `db:"quiescing"`
+	Other                           string         `db:"other"`
+	CreatedOn                       time.Time      `db:"created_on"`
+	ResumedOn                       time.Time      `db:"resumed_on"`
+	UpdatedOn                       time.Time      `db:"updated_on"`
+	Owner                           string         `db:"owner"`
+	Comment                         string         `db:"comment"`
+	EnableQueryAcceleration         bool           `db:"enable_query_acceleration"`
+	QueryAccelerationMaxScaleFactor int            `db:"query_acceleration_max_scale_factor"`
+	ResourceMonitor                 string         `db:"resource_monitor"`
+	Actives                         string         `db:"actives"`
+	Pendings                        string         `db:"pendings"`
+	Failed                          string         `db:"failed"`
+	Suspended                       string         `db:"suspended"`
+	UUID                            string         `db:"uuid"`
+	ScalingPolicy                   string         `db:"scaling_policy"`
+	ResourceConstraint              sql.NullString `db:"resource_constraint"`
 }
 
 func (row warehouseDBRow) toWarehouse() *Warehouse {
@@ -425,6 +494,9 @@ func (row warehouseDBRow) toWarehouse() *Warehouse {
 	if row.AutoSuspend.Valid {
 		wh.AutoSuspend = int(row.AutoSuspend.Int64)
 	}
+	if row.ResourceConstraint.Valid {
+		wh.ResourceConstraint = WarehouseResourceConstraint(row.ResourceConstraint.String)
+	}
 	return wh
 }
 
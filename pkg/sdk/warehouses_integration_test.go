@@ -539,3 +539,41 @@ func TestInt_WarehouseDrop(t *testing.T) {
 		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
 	})
 }
+
+func TestInt_WarehouseLifecycle(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("suspend & resume", func(t *testing.T) {
+		warehouse, warehouseCleanup := createWarehouse(t, client)
+		t.Cleanup(warehouseCleanup)
+
+		err := client.Warehouses.Suspend(ctx, warehouse.ID())
+		require.NoError(t, err)
+		result, err := client.Warehouses.ShowByID(ctx, warehouse.ID())
+		require.NoError(t, err)
+		assert.Contains(t, []WarehouseState{WarehouseStateSuspended, WarehouseStateSuspending}, result.State)
+
+		err = client.Warehouses.Resume(ctx, warehouse.ID(), false)
+		require.NoError(t, err)
+		result, err = client.Warehouses.ShowByID(ctx, warehouse.ID())
+		require.NoError(t, err)
+		assert.Contains(t, []WarehouseState{WarehouseStateStarted, WarehouseStateResuming}, result.State)
+
+		// resuming a running warehouse only succeeds with ifSuspended
+		err = client.Warehouses.Resume(ctx, warehouse.ID(), true)
+		require.NoError(t, err)
+	})
+
+	t.Run("abort all queries", func(t *testing.T) {
+		warehouse, warehouseCleanup := createWarehouse(t, client)
+		t.Cleanup(warehouseCleanup)
+
+		err := client.Warehouses.AbortAllQueries(ctx, warehouse.ID())
+		require.NoError(t, err)
+		result, err := client.Warehouses.ShowByID(ctx, warehouse.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Running)
+		assert.Equal(t, 0, result.Queued)
+	})
+}
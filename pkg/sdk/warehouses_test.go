@@ -61,6 +61,59 @@ func TestWarehouseCreate(t *testing.T) {
 		expected := `CREATE OR REPLACE WAREHOUSE IF NOT EXISTS "completewarehouse" WAREHOUSE_TYPE = 'STANDARD' WAREHOUSE_SIZE = 'X4LARGE' MAX_CLUSTER_COUNT = 8 MIN_CLUSTER_COUNT = 3 SCALING_POLICY = 'ECONOMY' AUTO_SUSPEND = 1000 AUTO_RESUME = true INITIALLY_SUSPENDED = false RESOURCE_MONITOR = "myresmon" COMMENT = 'hello' ENABLE_QUERY_ACCELERATION = true QUERY_ACCELERATION_MAX_SCALE_FACTOR = 62 MAX_CONCURRENCY_LEVEL = 7 STATEMENT_QUEUED_TIMEOUT_IN_SECONDS = 29 STATEMENT_TIMEOUT_IN_SECONDS = 89 TAG (` + tag1.FullyQualifiedName() + ` = 'v1',` + tag2.FullyQualifiedName() + ` = 'v2')`
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("with snowpark-optimized type and resource constraint", func(t *testing.T) {
+		opts := &WarehouseCreateOptions{
+			name:               NewAccountObjectIdentifier("snowparkwarehouse"),
+			WarehouseType:      &WarehouseTypeSnowparkOptimized,
+			WarehouseSize:      &WarehouseSizeMedium,
+			ResourceConstraint: &WarehouseResourceConstraintMemory16X,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE WAREHOUSE "snowparkwarehouse" WAREHOUSE_TYPE = 'SNOWPARK-OPTIMIZED' WAREHOUSE_SIZE = 'MEDIUM' RESOURCE_CONSTRAINT = 'MEMORY_16X'`
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestWarehouseCreateValidate(t *testing.T) {
+	id := NewAccountObjectIdentifier("mywarehouse")
+
+	t.Run("snowpark-optimized with supported size", func(t *testing.T) {
+		opts := &WarehouseCreateOptions{
+			name:               id,
+			WarehouseType:      &WarehouseTypeSnowparkOptimized,
+			WarehouseSize:      &WarehouseSizeMedium,
+			ResourceConstraint: &WarehouseResourceConstraintMemory1X,
+		}
+		require.NoError(t, opts.validate())
+	})
+
+	t.Run("snowpark-optimized with x-small size", func(t *testing.T) {
+		opts := &WarehouseCreateOptions{
+			name:          id,
+			WarehouseType: &WarehouseTypeSnowparkOptimized,
+			WarehouseSize: &WarehouseSizeXSmall,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("resource constraint on standard warehouse", func(t *testing.T) {
+		opts := &WarehouseCreateOptions{
+			name:               id,
+			WarehouseType:      &WarehouseTypeStandard,
+			ResourceConstraint: &WarehouseResourceConstraintMemory16X,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("resource constraint without type", func(t *testing.T) {
+		opts := &WarehouseCreateOptions{
+			name:               id,
+			ResourceConstraint: &WarehouseResourceConstraintMemory16X,
+		}
+		assert.Error(t, opts.validate())
+	})
 }
 
 func TestWarehouseAlter(t *testing.T) {
@@ -83,6 +136,28 @@ func TestWarehouseAlter(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("with set resource constraint", func(t *testing.T) {
+		opts := &WarehouseAlterOptions{
+			name: NewAccountObjectIdentifier("mywarehouse"),
+			Set: &WarehouseSet{
+				WarehouseType:      &WarehouseTypeSnowparkOptimized,
+				ResourceConstraint: &WarehouseResourceConstraintMemory64Xx86,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER WAREHOUSE "mywarehouse" SET WAREHOUSE_TYPE = 'SNOWPARK-OPTIMIZED' RESOURCE_CONSTRAINT = 'MEMORY_64X_x86'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set snowpark-optimized type and x-small size", func(t *testing.T) {
+		set := &WarehouseSet{
+			WarehouseType: &WarehouseTypeSnowparkOptimized,
+			WarehouseSize: &WarehouseSizeXSmall,
+		}
+		assert.Error(t, set.validate())
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		opts := &WarehouseAlterOptions{
 			Set: &WarehouseSet{
@@ -138,7 +138,7 @@ func (b *AccountBuilder) Create() (*Account, error) {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%s'`, EscapeString(b.comment)))
 	}
 
-	_, err := b.db.Exec(q.String())
+	err := Exec(b.db, q.String())
 	if err != nil {
 		return nil, err
 	}
@@ -149,15 +149,13 @@ func (b *AccountBuilder) Create() (*Account, error) {
 // Rename returns the SQL query that will rename the account.
 func (b *AccountBuilder) Rename(newName string) error {
 	stmt := fmt.Sprintf(`ALTER ACCOUNT %s RENAME TO %s`, b.name, EscapeString(newName))
-	_, err := b.db.Exec(stmt)
-	return err
+	return Exec(b.db, stmt)
 }
 
 // SetComment returns the SQL query that will set the comment on the account.
 func (b *AccountBuilder) SetComment(comment string) error {
 	stmt := fmt.Sprintf(`COMMENT ON ACCOUNT %s IS '%s'`, b.name, EscapeString(comment))
-	_, err := b.db.Exec(stmt)
-	return err
+	return Exec(b.db, stmt)
 }
 
 type Account struct {
@@ -194,6 +194,11 @@ func (builder *AlertBuilder) Resume() string {
 	return fmt.Sprintf(`ALTER ALERT %v RESUME`, builder.QualifiedName())
 }
 
+// LastEvaluation returns the sql that will select the most recent non-scheduled evaluation of the alert from the alert history.
+func (builder *AlertBuilder) LastEvaluation() string {
+	return fmt.Sprintf(`SELECT "STATE" AS "state", "SCHEDULED_TIME" AS "scheduled_time", "COMPLETED_TIME" AS "completed_time", "SQL_ERROR_MESSAGE" AS "error_message" FROM TABLE("%v".INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => '%v')) WHERE "SCHEMA_NAME" = '%v' AND "STATE" <> 'SCHEDULED' ORDER BY "SCHEDULED_TIME" DESC LIMIT 1`, EscapeString(builder.db), EscapeString(builder.name), EscapeString(builder.schema))
+}
+
 // Drop returns the sql that will remove the alert.
 func (builder *AlertBuilder) Drop() string {
 	return fmt.Sprintf(`DROP ALERT %v`, builder.QualifiedName())
@@ -254,6 +259,93 @@ func (t *Alert) IsSuspended() bool {
 	return strings.ToLower(t.State) == "suspended"
 }
 
+// AlertEvaluation represents a single evaluation of an alert in the alert history.
+type AlertEvaluation struct {
+	State         string         `db:"state"`
+	ScheduledTime sql.NullString `db:"scheduled_time"`
+	CompletedTime sql.NullString `db:"completed_time"`
+	ErrorMessage  sql.NullString `db:"error_message"`
+}
+
+// ScanAlertEvaluation turns a sql row into an alert evaluation object.
+func ScanAlertEvaluation(row *sqlx.Row) (*AlertEvaluation, error) {
+	e := &AlertEvaluation{}
+	err := row.StructScan(e)
+	return e, err
+}
+
+// SendEmailAction returns the alert action that sends an email through the given email notification integration.
+func SendEmailAction(integration string, recipients []string, subject string, body string) string {
+	return fmt.Sprintf(`CALL SYSTEM$SEND_EMAIL('%v', '%v', '%v', '%v')`, EscapeString(integration), EscapeString(strings.Join(recipients, ",")), EscapeString(subject), EscapeString(body))
+}
+
+// EmailAction is an alert action sending an email through SYSTEM$SEND_EMAIL.
+type EmailAction struct {
+	Integration string
+	Recipients  []string
+	Subject     string
+	Body        string
+}
+
+// ParseSendEmailAction parses an alert action rendered by SendEmailAction. It returns false for any other action.
+func ParseSendEmailAction(action string) (*EmailAction, bool) {
+	const prefix = `CALL SYSTEM$SEND_EMAIL(`
+	action = strings.TrimSuffix(strings.TrimSpace(action), ";")
+	if len(action) < len(prefix) || !strings.EqualFold(action[:len(prefix)], prefix) || !strings.HasSuffix(action, ")") {
+		return nil, false
+	}
+	rest := strings.TrimSpace(action[len(prefix) : len(action)-1])
+
+	var args []string
+	for rest != "" {
+		if len(args) > 0 {
+			if rest[0] != ',' {
+				return nil, false
+			}
+			rest = strings.TrimSpace(rest[1:])
+		}
+		arg, n, ok := scanStringLiteral(rest)
+		if !ok {
+			return nil, false
+		}
+		args = append(args, arg)
+		rest = strings.TrimSpace(rest[n:])
+	}
+	if len(args) != 4 {
+		return nil, false
+	}
+	return &EmailAction{
+		Integration: args[0],
+		Recipients:  strings.Split(args[1], ","),
+		Subject:     args[2],
+		Body:        args[3],
+	}, true
+}
+
+// scanStringLiteral reads the single quoted string literal at the start of s, returning its unescaped value and the
+// number of bytes it spans. Both backslash escapes and doubled single quotes are accepted.
+func scanStringLiteral(s string) (string, int, bool) {
+	if s == "" || s[0] != '\'' {
+		return "", 0, false
+	}
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			value.WriteByte(s[i])
+		case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+			value.WriteByte('\'')
+		case s[i] == '\'':
+			return value.String(), i + 1, true
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return "", 0, false
+}
+
 // ScanAlert turns a sql row into an alert object.
 func ScanAlert(row *sqlx.Row) (*Alert, error) {
 	t := &Alert{}
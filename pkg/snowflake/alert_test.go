@@ -0,0 +1,55 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertCreateOnNewData(t *testing.T) {
+	r := require.New(t)
+	b := NewAlertBuilder("test_alert", "test_db", "test_schema")
+	b.WithWarehouse("test_wh")
+	b.WithCondition("SELECT * FROM errors")
+	b.WithAction("SELECT 1")
+	r.Equal(`CREATE ALERT "test_db"."test_schema"."test_alert" WAREHOUSE = "test_wh" IF (EXISTS ( SELECT * FROM errors )) THEN SELECT 1`, b.Create())
+}
+
+func TestAlertLastEvaluation(t *testing.T) {
+	r := require.New(t)
+	b := NewAlertBuilder("test_alert", "test_db", "test_schema")
+	r.Equal(`SELECT "STATE" AS "state", "SCHEDULED_TIME" AS "scheduled_time", "COMPLETED_TIME" AS "completed_time", "SQL_ERROR_MESSAGE" AS "error_message" FROM TABLE("test_db".INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => 'test_alert')) WHERE "SCHEMA_NAME" = 'test_schema' AND "STATE" <> 'SCHEDULED' ORDER BY "SCHEDULED_TIME" DESC LIMIT 1`, b.LastEvaluation())
+}
+
+func TestSendEmailAction(t *testing.T) {
+	r := require.New(t)
+	r.Equal(`CALL SYSTEM$SEND_EMAIL('my_integration', 'a@example.com,b@example.com', 'Alert', 'It\'s broken')`, SendEmailAction("my_integration", []string{"a@example.com", "b@example.com"}, "Alert", "It's broken"))
+}
+
+func TestParseSendEmailAction(t *testing.T) {
+	testCases := []struct {
+		action   string
+		expected *EmailAction
+	}{
+		{
+			action:   SendEmailAction("my_integration", []string{"a@example.com", "b@example.com"}, "Alert", `It's \ broken`),
+			expected: &EmailAction{Integration: "my_integration", Recipients: []string{"a@example.com", "b@example.com"}, Subject: "Alert", Body: `It's \ broken`},
+		},
+		{
+			action:   `call system$send_email('my_integration','a@example.com','It''s','body');`,
+			expected: &EmailAction{Integration: "my_integration", Recipients: []string{"a@example.com"}, Subject: "It's", Body: "body"},
+		},
+		{action: `SELECT 1`},
+		{action: `CALL SYSTEM$SEND_EMAIL('my_integration', 'a@example.com', 'subject')`},
+		{action: `CALL SYSTEM$SEND_EMAIL('my_integration', 'a@example.com', 'subject', CURRENT_TIMESTAMP())`},
+		{action: `CALL SYSTEM$SEND_EMAIL('my_integration', 'a@example.com', 'subject', 'unterminated)`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.action, func(t *testing.T) {
+			r := require.New(t)
+			emailAction, ok := ParseSendEmailAction(tc.action)
+			r.Equal(tc.expected != nil, ok)
+			r.Equal(tc.expected, emailAction)
+		})
+	}
+}
@@ -1,36 +1,127 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 func Exec(db *sql.DB, query string) error {
 	log.Print("[DEBUG] exec stmt ", query)
 
 	_, err := db.Exec(query)
+	sdk.InvalidateReadCache(db, query)
 	return err
 }
 
 func ExecMulti(db *sql.DB, queries []string) error {
 	log.Print("[DEBUG] exec stmts ", queries)
+	defer func() {
+		for _, query := range queries {
+			sdk.InvalidateReadCache(db, query)
+		}
+	}()
 
 	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
 
-	for _, query := range queries {
+	for i, query := range queries {
 		_, err = tx.Exec(query)
 		if err != nil {
-			return tx.Rollback()
+			err = fmt.Errorf("error executing statement %d of %d %q err = %w", i+1, len(queries), query, err)
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				return fmt.Errorf("%w, rollback failed err = %v", err, rollbackErr)
+			}
+			return err
 		}
 	}
 	return tx.Commit()
 }
 
+// BatchStatement is a statement of a batch, with the statements undoing it should a later statement of the batch fail.
+type BatchStatement struct {
+	Statement string
+	Undo      []string
+}
+
+// ExecBatch runs the statements of a batch, in a single multi-statement request when db connects to Snowflake.
+// Snowflake commits statements such as GRANT on their own, so a failed batch is rolled back by undoing the statements
+// that were applied before the failing one. The statements of a batch must be idempotent: when a multi-statement
+// request fails, they are run again one by one to find the failing statement.
+func ExecBatch(db *sql.DB, batch []BatchStatement) error {
+	return execBatch(db, batch, snowflakedb.SupportsMultiStatements(db))
+}
+
+func execBatch(db *sql.DB, batch []BatchStatement, multiStatement bool) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	if len(batch) > 1 && multiStatement {
+		err := execMultiStatement(db, batch)
+		if err == nil {
+			return nil
+		}
+		log.Printf("[DEBUG] batch of %d statements failed, running them one by one err = %v", len(batch), err)
+	}
+
+	for i, s := range batch {
+		if err := Exec(db, s.Statement); err != nil {
+			err = fmt.Errorf("error executing statement %d of %d %q err = %w", i+1, len(batch), s.Statement, err)
+			return undoBatch(db, batch[:i], err)
+		}
+	}
+	return nil
+}
+
+// execMultiStatement submits the statements of a batch in one request. Multiple statements are only allowed for the
+// context of this request, every other request of the connection still runs a single statement.
+func execMultiStatement(db *sql.DB, batch []BatchStatement) error {
+	statements := make([]string, len(batch))
+	for i, s := range batch {
+		statements[i] = s.Statement
+	}
+	ctx, err := gosnowflake.WithMultiStatement(context.Background(), len(statements))
+	if err != nil {
+		return err
+	}
+	query := strings.Join(statements, ";\n")
+	log.Print("[DEBUG] exec multi stmt ", query)
+	_, err = db.ExecContext(ctx, query)
+	for _, statement := range statements {
+		sdk.InvalidateReadCache(db, statement)
+	}
+	return err
+}
+
+// undoBatch undoes the applied statements of a batch in reverse order, and returns the error that failed the batch.
+func undoBatch(db *sql.DB, applied []BatchStatement, err error) error {
+	var undoErrs []string
+	for i := len(applied) - 1; i >= 0; i-- {
+		for _, undo := range applied[i].Undo {
+			if undoErr := Exec(db, undo); undoErr != nil {
+				undoErrs = append(undoErrs, fmt.Sprintf("%q: %v", undo, undoErr))
+			}
+		}
+	}
+	if len(undoErrs) > 0 {
+		return fmt.Errorf("%w, rolling back %d applied statements failed err = %v", err, len(applied), strings.Join(undoErrs, "; "))
+	}
+	if len(applied) > 0 {
+		log.Printf("[DEBUG] rolled back %d applied statements of the failed batch", len(applied))
+	}
+	return err
+}
+
 // QueryRow will run stmt against the db and return the row. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns.
@@ -48,3 +139,9 @@ func Query(db *sql.DB, stmt string) (*sqlx.Rows, error) {
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
 	return sdb.Queryx(stmt)
 }
+
+// QueryAll will run stmt against the db and scan all the rows into dest, which is expected to be a pointer to a slice
+// of structs. SHOW statements are served from the read cache when it is enabled for the db.
+func QueryAll(db *sql.DB, dest interface{}, stmt string) error {
+	return sdk.SelectCached(db, dest, stmt)
+}
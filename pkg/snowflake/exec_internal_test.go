@@ -0,0 +1,63 @@
+package snowflake
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	snowflakedb "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+)
+
+var multiStatementBatch = []BatchStatement{
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-1"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-1"`}},
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-2"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-2"`}},
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-3"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-3"`}},
+}
+
+func TestExecBatchMultiStatement(t *testing.T) {
+	t.Run("only uses multi-statement requests on snowflake connections", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, _, err := sqlmock.New()
+		r.NoError(err)
+		defer mockDB.Close()
+		r.False(snowflakedb.SupportsMultiStatements(mockDB))
+
+		db, err := snowflakedb.Open("user:pass@acct.snowflakecomputing.com:443")
+		r.NoError(err)
+		defer db.Close()
+		r.True(snowflakedb.SupportsMultiStatements(db))
+		r.True(snowflakedb.SupportsMultiStatements(snowflakedb.OpenWithDSNFunc(func() (string, error) { return "", nil })))
+	})
+
+	t.Run("submits a multi-statement request", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New()
+		r.NoError(err)
+		defer mockDB.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(multiStatementBatch[0].Statement + ";\n" + multiStatementBatch[1].Statement + ";\n" + multiStatementBatch[2].Statement)).WillReturnResult(sqlmock.NewResult(3, 3))
+		r.NoError(execBatch(mockDB, multiStatementBatch, true))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("finds the failing statement of a multi-statement request", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+
+		grantErr := errors.New("grant failed")
+		mock.ExpectExec(multiStatementBatch[0].Statement + ";\n" + multiStatementBatch[1].Statement + ";\n" + multiStatementBatch[2].Statement).WillReturnError(grantErr)
+		mock.ExpectExec(multiStatementBatch[0].Statement).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(multiStatementBatch[1].Statement).WillReturnError(grantErr)
+		mock.ExpectExec(multiStatementBatch[0].Undo[0]).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err = execBatch(mockDB, multiStatementBatch, true)
+		r.ErrorIs(err, grantErr)
+		r.ErrorContains(err, "statement 2 of 3")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
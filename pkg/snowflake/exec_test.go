@@ -0,0 +1,129 @@
+package snowflake_test
+
+import (
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+var testBatch = []snowflake.BatchStatement{
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-1"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-1"`}},
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-2"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-2"`}},
+	{Statement: `GRANT USAGE ON DATABASE "db" TO ROLE "role-3"`, Undo: []string{`REVOKE USAGE ON DATABASE "db" FROM ROLE "role-3"`}},
+}
+
+func TestExecBatch(t *testing.T) {
+	t.Run("runs statements one by one", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+
+		for _, s := range testBatch {
+			mock.ExpectExec(s.Statement).WillReturnResult(sqlmock.NewResult(1, 1))
+		}
+		r.NoError(snowflake.ExecBatch(mockDB, testBatch))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("undoes applied statements on failure", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+
+		grantErr := errors.New("Role 'ROLE-3' does not exist or not authorized.")
+		mock.ExpectExec(testBatch[0].Statement).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(testBatch[1].Statement).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(testBatch[2].Statement).WillReturnError(grantErr)
+		mock.ExpectExec(testBatch[1].Undo[0]).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(testBatch[0].Undo[0]).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err = snowflake.ExecBatch(mockDB, testBatch)
+		r.ErrorIs(err, grantErr)
+		r.ErrorContains(err, `statement 3 of 3 "GRANT USAGE ON DATABASE \"db\" TO ROLE \"role-3\""`)
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("reports failed rollback", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+
+		mock.ExpectExec(testBatch[0].Statement).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(testBatch[1].Statement).WillReturnError(errors.New("grant failed"))
+		mock.ExpectExec(testBatch[0].Undo[0]).WillReturnError(errors.New("revoke failed"))
+
+		err = snowflake.ExecBatch(mockDB, testBatch)
+		r.ErrorContains(err, "grant failed")
+		r.ErrorContains(err, "revoke failed")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestExecMultiReportsFailingStatement(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	r.NoError(err)
+	defer mockDB.Close()
+
+	execErr := errors.New("exec failed")
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE t1 SET COMMENT = 'a'").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("ALTER TABLE t2 SET COMMENT = 'b'").WillReturnError(execErr)
+	mock.ExpectRollback()
+
+	err = snowflake.ExecMulti(mockDB, []string{"ALTER TABLE t1 SET COMMENT = 'a'", "ALTER TABLE t2 SET COMMENT = 'b'"})
+	r.ErrorIs(err, execErr)
+	r.ErrorContains(err, "statement 2 of 2")
+	r.NoError(mock.ExpectationsWereMet())
+}
+
+func TestLegacyWritesInvalidateReadCache(t *testing.T) {
+	type row struct {
+		Name string `db:"name"`
+	}
+
+	t.Run("role builder", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+		sdk.EnableReadCache(mockDB)
+
+		show := `SHOW ROLES LIKE 'role'`
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("role"))
+		mock.ExpectExec(`ALTER ROLE "role" SET COMMENT = 'comment'`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("role"))
+
+		var rows []row
+		r.NoError(sdk.SelectCached(mockDB, &rows, show))
+		r.NoError(snowflake.NewRoleBuilder(mockDB, "role").SetComment("comment"))
+		r.NoError(sdk.SelectCached(mockDB, &rows, show))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("parameter executor", func(t *testing.T) {
+		r := require.New(t)
+		mockDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		r.NoError(err)
+		defer mockDB.Close()
+		sdk.EnableReadCache(mockDB)
+
+		show := `SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT`
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("TIMEZONE"))
+		mock.ExpectExec(`ALTER ACCOUNT SET TIMEZONE = 'UTC'`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(show).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("TIMEZONE"))
+
+		var rows []row
+		r.NoError(sdk.SelectCached(mockDB, &rows, show))
+		r.NoError(snowflake.NewParameterExecutor(mockDB).Execute(`ALTER ACCOUNT SET TIMEZONE = 'UTC'`))
+		r.NoError(sdk.SelectCached(mockDB, &rows, show))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
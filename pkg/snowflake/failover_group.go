@@ -10,8 +10,15 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
-// FailoverGroupBuilder abstracts the creation of SQL queries for a Snowflake file format.
+const (
+	failoverGroupType    = "FAILOVER GROUP"
+	replicationGroupType = "REPLICATION GROUP"
+)
+
+// FailoverGroupBuilder abstracts the creation of SQL queries for a Snowflake failover or replication group. Both
+// kinds of groups share the same DDL, apart from failover related statements.
 type FailoverGroupBuilder struct {
+	groupType                         string
 	name                              string
 	objectTypes                       []string
 	allowedDatabases                  []string
@@ -77,13 +84,33 @@ func (b *FailoverGroupBuilder) WithReplicationScheduleTimeZone(replicationSchedu
 // CreateFailoverGroup returns a pointer to a Builder that abstracts the DDL operations for a failover group.
 func NewFailoverGroupBuilder(name string) *FailoverGroupBuilder {
 	return &FailoverGroupBuilder{
-		name: name,
+		groupType: failoverGroupType,
+		name:      name,
+	}
+}
+
+// NewReplicationGroupBuilder returns a pointer to a Builder that abstracts the DDL operations for a replication group.
+func NewReplicationGroupBuilder(name string) *FailoverGroupBuilder {
+	return &FailoverGroupBuilder{
+		groupType: replicationGroupType,
+		name:      name,
 	}
 }
 
+// GroupType returns the kind of group handled by the builder, i.e. FAILOVER GROUP or REPLICATION GROUP.
+func (b *FailoverGroupBuilder) GroupType() string {
+	return b.groupType
+}
+
+// SupportsFailover reports whether a secondary group handled by the builder can be promoted to the primary group.
+// Only failover groups can be promoted; replication groups are read-only replicas.
+func (b *FailoverGroupBuilder) SupportsFailover() bool {
+	return b.groupType == failoverGroupType
+}
+
 func (b *FailoverGroupBuilder) CreateFromReplica(name string) string {
 	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(` CREATE FAILOVER GROUP %v`, b.name))
+	q.WriteString(fmt.Sprintf(` CREATE %v %v`, b.groupType, b.name))
 	q.WriteString(fmt.Sprintf(` AS REPLICA OF %v`, name))
 	return q.String()
 }
@@ -93,7 +120,7 @@ func (b *FailoverGroupBuilder) Create() string {
 	q := strings.Builder{}
 	q.WriteString(`CREATE`)
 
-	q.WriteString(fmt.Sprintf(` FAILOVER GROUP %v`, b.name))
+	q.WriteString(fmt.Sprintf(` %v %v`, b.groupType, b.name))
 	q.WriteString(fmt.Sprintf(` OBJECT_TYPES = %v`, strings.Join(b.objectTypes, ",")))
 
 	if len(b.allowedDatabases) > 0 {
@@ -136,14 +163,14 @@ func (b *FailoverGroupBuilder) Create() string {
 
 // Rename returns the SQL query that will rename a failover group.
 func (b *FailoverGroupBuilder) Rename(name string) string {
-	s := fmt.Sprintf(`ALTER FAILOVER GROUP %v RENAME TO %v`, b.name, name)
+	s := fmt.Sprintf(`ALTER %v %v RENAME TO %v`, b.groupType, b.name, name)
 	b.name = name
 	return s
 }
 
 // ChangeObjectTypes returns the SQL query that will change the object types of a failover group.
 func (b *FailoverGroupBuilder) ChangeObjectTypes(objectTypes []string) string {
-	s := fmt.Sprintf(`ALTER FAILOVER GROUP %v SET OBJECT_TYPES = %v`, b.name, strings.Join(objectTypes, ","))
+	s := fmt.Sprintf(`ALTER %v %v SET OBJECT_TYPES = %v`, b.groupType, b.name, strings.Join(objectTypes, ","))
 	b.objectTypes = objectTypes
 	return s
 }
@@ -151,7 +178,7 @@ func (b *FailoverGroupBuilder) ChangeObjectTypes(objectTypes []string) string {
 // ChangeReplicationCronSchedule returns the SQL query that will change the replication schedule of a failover group.
 func (b *FailoverGroupBuilder) ChangeReplicationCronSchedule(replicationScheduleCronExpression string, replicationScheduleTimeZone string) string {
 	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(`ALTER FAILOVER GROUP %v SET REPLICATION_SCHEDULE = 'USING CRON %v`, b.name, replicationScheduleCronExpression))
+	q.WriteString(fmt.Sprintf(`ALTER %v %v SET REPLICATION_SCHEDULE = 'USING CRON %v`, b.groupType, b.name, replicationScheduleCronExpression))
 
 	if replicationScheduleTimeZone != "" {
 		q.WriteString(fmt.Sprintf(` %v`, replicationScheduleTimeZone))
@@ -164,42 +191,42 @@ func (b *FailoverGroupBuilder) ChangeReplicationCronSchedule(replicationSchedule
 
 // ChangeReplicationIntervalSchedule returns the SQL query that will change the replication schedule of a failover group.
 func (b *FailoverGroupBuilder) ChangeReplicationIntervalSchedule(replicationScheduleInterval int) string {
-	s := fmt.Sprintf(`ALTER FAILOVER GROUP %v SET REPLICATION_SCHEDULE = '%v MINUTE'`, b.name, replicationScheduleInterval)
+	s := fmt.Sprintf(`ALTER %v %v SET REPLICATION_SCHEDULE = '%v MINUTE'`, b.groupType, b.name, replicationScheduleInterval)
 	b.replicationScheduleInterval = replicationScheduleInterval
 	return s
 }
 
 // ChangeAllowedIntegrationTypes returns the SQL query that will change the allowed integration types of a failover group.
 func (b *FailoverGroupBuilder) ChangeAllowedIntegrationTypes(allowedIntegrationTypes []string) string {
-	s := fmt.Sprintf(`ALTER FAILOVER GROUP %v SET ALLOWED_INTEGRATION_TYPES = %v`, b.name, strings.Join(allowedIntegrationTypes, ","))
+	s := fmt.Sprintf(`ALTER %v %v SET ALLOWED_INTEGRATION_TYPES = %v`, b.groupType, b.name, strings.Join(allowedIntegrationTypes, ","))
 	b.allowedIntegrationTypes = allowedIntegrationTypes
 	return s
 }
 
 // AddAllowedDatabases returns the SQL query that will change the allowed databases of a failover group.
 func (b *FailoverGroupBuilder) AddAllowedDatabases(allowedDatabases []string) string {
-	return fmt.Sprintf(`ALTER FAILOVER GROUP %v ADD "%v" TO ALLOWED_DATABASES`, b.name, strings.Join(allowedDatabases, ","))
+	return fmt.Sprintf(`ALTER %v %v ADD "%v" TO ALLOWED_DATABASES`, b.groupType, b.name, strings.Join(allowedDatabases, ","))
 }
 
 // RemoveAllowedDatabases returns the SQL query that will change the allowed databases of a failover group.
 func (b *FailoverGroupBuilder) RemoveAllowedDatabases(allowedDatabases []string) string {
-	return fmt.Sprintf(`ALTER FAILOVER GROUP %v REMOVE "%v" FROM ALLOWED_DATABASES`, b.name, strings.Join(allowedDatabases, ","))
+	return fmt.Sprintf(`ALTER %v %v REMOVE "%v" FROM ALLOWED_DATABASES`, b.groupType, b.name, strings.Join(allowedDatabases, ","))
 }
 
 // AddAllowedShares returns the SQL query that will change the allowed shares of a failover group.
 func (b *FailoverGroupBuilder) AddAllowedShares(allowedShares []string) string {
-	return fmt.Sprintf(`ALTER FAILOVER GROUP %v ADD %v TO ALLOWED_SHARES`, b.name, strings.Join(allowedShares, ","))
+	return fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_SHARES`, b.groupType, b.name, strings.Join(allowedShares, ","))
 }
 
 // RemoveAllowedShares returns the SQL query that will change the allowed shares of a failover group.
 func (b *FailoverGroupBuilder) RemoveAllowedShares(allowedShares []string) string {
-	return fmt.Sprintf(`ALTER FAILOVER GROUP %v REMOVE %v FROM ALLOWED_SHARES`, b.name, strings.Join(allowedShares, ","))
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_SHARES`, b.groupType, b.name, strings.Join(allowedShares, ","))
 }
 
 // AddAllowedAccounts returns the SQL query that will change the allowed accounts of a failover group.
 func (b *FailoverGroupBuilder) AddAllowedAccounts(allowedAccounts []string) string {
 	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(`ALTER FAILOVER GROUP %v ADD %v TO ALLOWED_ACCOUNTS`, b.name, strings.Join(allowedAccounts, ",")))
+	q.WriteString(fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_ACCOUNTS`, b.groupType, b.name, strings.Join(allowedAccounts, ",")))
 	if b.ignoreEditionCheck {
 		q.WriteString(` IGNORE EDITION CHECK`)
 	}
@@ -208,22 +235,48 @@ func (b *FailoverGroupBuilder) AddAllowedAccounts(allowedAccounts []string) stri
 
 // RemoveAllowedAccounts returns the SQL query that will change the allowed accounts of a failover group.
 func (b *FailoverGroupBuilder) RemoveAllowedAccounts(allowedAccounts []string) string {
-	return fmt.Sprintf(`ALTER FAILOVER GROUP %v REMOVE %v FROM ALLOWED_ACCOUNTS`, b.name, strings.Join(allowedAccounts, ","))
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_ACCOUNTS`, b.groupType, b.name, strings.Join(allowedAccounts, ","))
 }
 
 // Drop returns the SQL query that will drop a failover group.
 func (b *FailoverGroupBuilder) Drop() string {
-	return fmt.Sprintf(`DROP FAILOVER GROUP %v`, b.name)
+	return fmt.Sprintf(`DROP %v %v`, b.groupType, b.name)
 }
 
 // Show returns the SQL query that will show a failover group.
 func (b *FailoverGroupBuilder) Show() string {
-	return "SHOW FAILOVER GROUPS"
+	return fmt.Sprintf("SHOW %vS", b.groupType)
+}
+
+// Refresh returns the SQL query that will refresh a secondary group from its primary.
+func (b *FailoverGroupBuilder) Refresh() string {
+	return fmt.Sprintf(`ALTER %v %v REFRESH`, b.groupType, b.name)
+}
+
+// Primary returns the SQL query that will promote a secondary failover group to serve as the primary group.
+func (b *FailoverGroupBuilder) Primary() string {
+	return fmt.Sprintf(`ALTER %v %v PRIMARY`, b.groupType, b.name)
+}
+
+// RefreshProgress returns the SQL query that will select the phases of the current or most recent refresh of a
+// secondary group, oldest first.
+func (b *FailoverGroupBuilder) RefreshProgress() string {
+	return fmt.Sprintf(`SELECT "PHASE_NAME" AS "phase_name", "START_TIME" AS "start_time", "END_TIME" AS "end_time", DATEDIFF('second', "START_TIME", CURRENT_TIMESTAMP()) AS "age_seconds" FROM TABLE(SNOWFLAKE.INFORMATION_SCHEMA.REPLICATION_GROUP_REFRESH_PROGRESS('%v')) ORDER BY "START_TIME"`, EscapeString(b.name))
 }
 
 // ListFailoverGroups returns a list of all failover groups in the account.
 func ListFailoverGroups(db *sql.DB, accountLocator string) ([]FailoverGroup, error) {
-	stmt := fmt.Sprintf("SHOW FAILOVER GROUPS IN ACCOUNT %s", accountLocator)
+	return NewFailoverGroupBuilder("").List(db, accountLocator)
+}
+
+// ListReplicationGroups returns a list of all replication groups in the account.
+func ListReplicationGroups(db *sql.DB, accountLocator string) ([]FailoverGroup, error) {
+	return NewReplicationGroupBuilder("").List(db, accountLocator)
+}
+
+// List returns all groups of the kind handled by the builder in the account.
+func (b *FailoverGroupBuilder) List(db *sql.DB, accountLocator string) ([]FailoverGroup, error) {
+	stmt := fmt.Sprintf("%v IN ACCOUNT %s", b.Show(), accountLocator)
 	rows, err := Query(db, stmt)
 	if err != nil {
 		return nil, err
@@ -233,7 +286,7 @@ func ListFailoverGroups(db *sql.DB, accountLocator string) ([]FailoverGroup, err
 	v := []FailoverGroup{}
 	err = sqlx.StructScan(rows, &v)
 	if errors.Is(err, sql.ErrNoRows) {
-		log.Println("[DEBUG] no failover groups found")
+		log.Printf("[DEBUG] no %vs found", strings.ToLower(b.groupType))
 		return nil, nil
 	}
 
@@ -261,17 +314,22 @@ type failoverGroupAllowedDatabase struct {
 }
 
 func ShowDatabasesInFailoverGroup(name string, db *sql.DB) ([]string, error) {
-	stmt := fmt.Sprintf(`SHOW DATABASES IN FAILOVER GROUP %v`, name)
+	return NewFailoverGroupBuilder(name).ListDatabases(db)
+}
+
+// ListDatabases returns the names of the databases in the group.
+func (b *FailoverGroupBuilder) ListDatabases(db *sql.DB) ([]string, error) {
+	stmt := fmt.Sprintf(`SHOW DATABASES IN %v %v`, b.groupType, b.name)
 	rows, err := Query(db, stmt)
 	if err != nil {
-		return nil, fmt.Errorf("error listing allowed databases for failover group %v err = %w", name, err)
+		return nil, fmt.Errorf("error listing allowed databases for %v %v err = %w", strings.ToLower(b.groupType), b.name, err)
 	}
 	defer rows.Close()
 
 	failoverGroupAllowedDatabase := []failoverGroupAllowedDatabase{}
 	err = sqlx.StructScan(rows, &failoverGroupAllowedDatabase)
 	if errors.Is(err, sql.ErrNoRows) {
-		log.Println("[DEBUG] no failover group databases found")
+		log.Printf("[DEBUG] no %v databases found", strings.ToLower(b.groupType))
 		return nil, nil
 	}
 
@@ -287,10 +345,15 @@ type failoverGroupAllowedShare struct {
 }
 
 func ShowSharesInFailoverGroup(name string, db *sql.DB) ([]string, error) {
-	stmt := fmt.Sprintf(`SHOW SHARES IN FAILOVER GROUP %v`, name)
+	return NewFailoverGroupBuilder(name).ListShares(db)
+}
+
+// ListShares returns the names of the shares in the group.
+func (b *FailoverGroupBuilder) ListShares(db *sql.DB) ([]string, error) {
+	stmt := fmt.Sprintf(`SHOW SHARES IN %v %v`, b.groupType, b.name)
 	rows, err := Query(db, stmt)
 	if err != nil {
-		return nil, fmt.Errorf("error listing allowed shares for failover group %v err = %w", name, err)
+		return nil, fmt.Errorf("error listing allowed shares for %v %v err = %w", strings.ToLower(b.groupType), b.name, err)
 	}
 
 	defer rows.Close()
@@ -298,7 +361,7 @@ func ShowSharesInFailoverGroup(name string, db *sql.DB) ([]string, error) {
 	failoverGroupAllowedShares := []failoverGroupAllowedShare{}
 	if err := sqlx.StructScan(rows, &failoverGroupAllowedShares); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			log.Println("[DEBUG] no failover group shares found")
+			log.Printf("[DEBUG] no %v shares found", strings.ToLower(b.groupType))
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to scan row for %s err = %w", stmt, err)
@@ -310,3 +373,29 @@ func ShowSharesInFailoverGroup(name string, db *sql.DB) ([]string, error) {
 	}
 	return result, nil
 }
+
+// RefreshPhase is a single phase of the refresh of a secondary group.
+type RefreshPhase struct {
+	PhaseName  string         `db:"phase_name"`
+	StartTime  sql.NullString `db:"start_time"`
+	EndTime    sql.NullString `db:"end_time"`
+	AgeSeconds sql.NullInt64  `db:"age_seconds"`
+}
+
+// ListRefreshPhases returns the phases of the current or most recent refresh of the group, oldest first.
+func (b *FailoverGroupBuilder) ListRefreshPhases(db *sql.DB) ([]RefreshPhase, error) {
+	rows, err := Query(db, b.RefreshProgress())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	phases := []RefreshPhase{}
+	if err := sqlx.StructScan(rows, &phases); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return phases, nil
+		}
+		return nil, err
+	}
+	return phases, nil
+}
@@ -0,0 +1,37 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverGroupBuilder(t *testing.T) {
+	r := require.New(t)
+
+	b := NewFailoverGroupBuilder("fg")
+	b.WithObjectTypes([]string{"DATABASES"}).WithAllowedDatabases([]string{"db"}).WithAllowedAccounts([]string{"org.acc"})
+	r.Equal(`CREATE FAILOVER GROUP fg OBJECT_TYPES = DATABASES ALLOWED_DATABASES = "db" ALLOWED_ACCOUNTS = org.acc`, b.Create())
+	r.Equal(`ALTER FAILOVER GROUP fg REFRESH`, b.Refresh())
+	r.True(b.SupportsFailover())
+	r.Equal(`ALTER FAILOVER GROUP fg PRIMARY`, b.Primary())
+	r.Equal(`SHOW FAILOVER GROUPS`, b.Show())
+	r.Equal(`DROP FAILOVER GROUP fg`, b.Drop())
+}
+
+func TestReplicationGroupBuilder(t *testing.T) {
+	r := require.New(t)
+
+	b := NewReplicationGroupBuilder("rg")
+	r.Equal("REPLICATION GROUP", b.GroupType())
+	r.False(b.SupportsFailover())
+	b.WithObjectTypes([]string{"DATABASES", "SHARES"}).WithAllowedAccounts([]string{"org.acc"}).WithReplicationScheduleInterval(10)
+	r.Equal(`CREATE REPLICATION GROUP rg OBJECT_TYPES = DATABASES,SHARES ALLOWED_ACCOUNTS = org.acc REPLICATION_SCHEDULE = '10 MINUTE'`, b.Create())
+	r.Equal(` CREATE REPLICATION GROUP rg AS REPLICA OF org.acc.rg`, b.CreateFromReplica("org.acc.rg"))
+	r.Equal(`ALTER REPLICATION GROUP rg SET OBJECT_TYPES = DATABASES`, b.ChangeObjectTypes([]string{"DATABASES"}))
+	r.Equal(`ALTER REPLICATION GROUP rg ADD "db" TO ALLOWED_DATABASES`, b.AddAllowedDatabases([]string{"db"}))
+	r.Equal(`ALTER REPLICATION GROUP rg REFRESH`, b.Refresh())
+	r.Equal(`SHOW REPLICATION GROUPS`, b.Show())
+	r.Equal(`DROP REPLICATION GROUP rg`, b.Drop())
+	r.Equal(`SELECT "PHASE_NAME" AS "phase_name", "START_TIME" AS "start_time", "END_TIME" AS "end_time", DATEDIFF('second', "START_TIME", CURRENT_TIMESTAMP()) AS "age_seconds" FROM TABLE(SNOWFLAKE.INFORMATION_SCHEMA.REPLICATION_GROUP_REFRESH_PROGRESS('rg')) ORDER BY "START_TIME"`, b.RefreshProgress())
+}
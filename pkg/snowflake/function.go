@@ -5,30 +5,34 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/jmoiron/sqlx"
 )
 
 // FunctionBuilder abstracts the creation of Function.
 type FunctionBuilder struct {
-	name              string
-	schema            string
-	db                string
-	argumentTypes     []string // (VARCHAR, VARCHAR)
-	args              []map[string]string
-	returnBehavior    string // VOLATILE, IMMUTABLE
-	nullInputBehavior string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
-	returnType        string
-	language          string
-	packages          []string
-	imports           []string // for Java / Python imports
-	handler           string   // for Java / Python handler
-	targetPath        string   // for Java / Python target path
-	comment           string
-	statement         string
-	runtimeVersion    string // for Python runtime version
-	secure            bool
+	name                       string
+	schema                     string
+	db                         string
+	argumentTypes              []string // (VARCHAR, VARCHAR)
+	args                       []map[string]string
+	returnBehavior             string // VOLATILE, IMMUTABLE
+	nullInputBehavior          string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
+	returnType                 string
+	language                   string
+	packages                   []string
+	imports                    []string // for Java / Python imports
+	handler                    string   // for Java / Python handler
+	targetPath                 string   // for Java / Python target path
+	externalAccessIntegrations []string
+	secrets                    map[string]string // secret variable name -> fully qualified secret name
+	comment                    string
+	statement                  string
+	runtimeVersion             string // for Python runtime version
+	secure                     bool
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -118,6 +122,18 @@ func (pb *FunctionBuilder) WithTargetPath(s string) *FunctionBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the function is allowed to use.
+func (pb *FunctionBuilder) WithExternalAccessIntegrations(s []string) *FunctionBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the secrets the function can read, keyed by the variable name used in the handler code.
+func (pb *FunctionBuilder) WithSecrets(s map[string]string) *FunctionBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithSecure sets the secure boolean to true
 // [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-function)
 func (pb *FunctionBuilder) WithSecure() *FunctionBuilder {
@@ -234,10 +250,41 @@ func (pb *FunctionBuilder) Create() (string, error) {
 		q.WriteString(fmt.Sprintf(" TARGET_PATH = '%v'", pb.targetPath))
 	}
 
+	q.WriteString(externalAccessClause(pb.externalAccessIntegrations, pb.secrets))
+
 	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
 	return q.String(), nil
 }
 
+// externalAccessClause renders the EXTERNAL_ACCESS_INTEGRATIONS and SECRETS parameters shared by functions and procedures.
+func externalAccessClause(integrations []string, secrets map[string]string) string {
+	var q strings.Builder
+	if len(integrations) > 0 {
+		q.WriteString(` EXTERNAL_ACCESS_INTEGRATIONS = (`)
+		names := []string{}
+		for _, integration := range integrations {
+			names = append(names, sdk.NewAccountObjectIdentifier(integration).FullyQualifiedName())
+		}
+		q.WriteString(strings.Join(names, ", "))
+		q.WriteString(`)`)
+	}
+	if len(secrets) > 0 {
+		q.WriteString(` SECRETS = (`)
+		variables := make([]string, 0, len(secrets))
+		for variable := range secrets {
+			variables = append(variables, variable)
+		}
+		sort.Strings(variables)
+		pairs := []string{}
+		for _, variable := range variables {
+			pairs = append(pairs, fmt.Sprintf(`'%v' = %v`, EscapeString(variable), secrets[variable]))
+		}
+		q.WriteString(strings.Join(pairs, ", "))
+		q.WriteString(`)`)
+	}
+	return q.String()
+}
+
 // Rename returns the SQL query that will rename the function.
 func (pb *FunctionBuilder) Rename(newName string) (string, error) {
 	oldName, err := pb.QualifiedName()
@@ -169,6 +169,27 @@ func TestFunctionCreateWithPythonFunction(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestFunctionCreateWithPythonFunctionWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getPythonFunction(true)
+	s.WithLanguage("PYTHON")
+	s.WithRuntimeVersion("3.8")
+	s.WithHandler("CoolFunc.test")
+	s.WithExternalAccessIntegrations([]string{"first_integration", "second_integration"})
+	s.WithSecrets(map[string]string{
+		"token": `"test_db"."test_schema"."token_secret"`,
+		"cred":  `"test_db"."test_schema"."cred_secret"`,
+	})
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(arg INT) RETURNS INT` +
+		` LANGUAGE PYTHON RUNTIME_VERSION = '3.8' HANDLER = 'CoolFunc.test'` +
+		` EXTERNAL_ACCESS_INTEGRATIONS = ("first_integration", "second_integration")` +
+		` SECRETS = ('cred' = "test_db"."test_schema"."cred_secret", 'token' = "test_db"."test_schema"."token_secret")` +
+		` AS $$` + pythonfunc + `$$`
+	r.Equal(expected, createStmnt)
+}
+
 func TestFunctionCreateWithPythonFunctionWithPackages(t *testing.T) {
 	r := require.New(t)
 	s := getPythonFunction(true)
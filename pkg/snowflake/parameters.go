@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/jmoiron/sqlx"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
@@ -514,6 +516,37 @@ func ParameterDefaults() map[string]ParameterDefault {
 				ObjectTypeFailoverGroup,
 			},
 		},
+		"EVENT_TABLE": {
+			TypeSet: []ParameterType{ParameterTypeObject, ParameterTypeAccount},
+			// EVENT_TABLE has no default value; it is unset rather than reset when removed.
+			DefaultValue: nil,
+			Validate: func(value string) (err error) {
+				parts := strings.Split(value, ".")
+				if len(parts) != 3 || slices.Contains(parts, "") {
+					return fmt.Errorf("%v is not a valid value for EVENT_TABLE, must be a fully qualified event table name <db_name>.<schema_name>.<table_name>", value)
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+			},
+		},
+		"LOG_LEVEL": {
+			TypeSet:      []ParameterType{ParameterTypeSession, ParameterTypeObject, ParameterTypeAccount},
+			DefaultValue: "OFF",
+			Validate: func(value string) (err error) {
+				if !slices.Contains([]string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL", "OFF"}, value) {
+					return fmt.Errorf("%v is not a valid value for LOG_LEVEL, must be one of \"TRACE\", \"DEBUG\", \"INFO\", \"WARN\", \"ERROR\", \"FATAL\" or \"OFF\"", value)
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+				ObjectTypeSchema,
+				ObjectTypeFunction,
+				ObjectTypeProcedure,
+			},
+		},
 		"MAX_CONCURRENCY_LEVEL": {
 			TypeSet:      []ParameterType{ParameterTypeObject},
 			DefaultValue: 0,
@@ -606,6 +639,22 @@ func ParameterDefaults() map[string]ParameterDefault {
 				ObjectTypeTask,
 			},
 		},
+		"TRACE_LEVEL": {
+			TypeSet:      []ParameterType{ParameterTypeSession, ParameterTypeObject, ParameterTypeAccount},
+			DefaultValue: "OFF",
+			Validate: func(value string) (err error) {
+				if !slices.Contains([]string{"ALWAYS", "ON_EVENT", "OFF"}, value) {
+					return fmt.Errorf("%v is not a valid value for TRACE_LEVEL, must be one of \"ALWAYS\", \"ON_EVENT\" or \"OFF\"", value)
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+				ObjectTypeSchema,
+				ObjectTypeFunction,
+				ObjectTypeProcedure,
+			},
+		},
 		"USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE": {
 			TypeSet:      []ParameterType{ParameterTypeObject, ParameterTypeAccount},
 			DefaultValue: "MEDIUM",
@@ -656,6 +705,8 @@ func GetParameterObjectTypeSetAsStrings() []string {
 		ObjectTypeReplicationGroup,
 		ObjectTypeFailoverGroup,
 		ObjectTypeTable,
+		ObjectTypeFunction,
+		ObjectTypeProcedure,
 	}
 	result := make([]string, 0, len(objectTypeSet))
 	for _, v := range objectTypeSet {
@@ -694,6 +745,7 @@ func NewParameterExecutor(db *sql.DB) *ParameterExecutor {
 
 func (v *ParameterExecutor) Execute(stmt string, args ...interface{}) error {
 	_, err := v.db.Exec(stmt, args...)
+	sdk.InvalidateReadCache(v.db, stmt)
 	return err
 }
 
@@ -744,6 +796,11 @@ func (v *AccountParameterBuilder) SetParameter() error {
 	return v.executor.Execute(stmt)
 }
 
+func (v *AccountParameterBuilder) UnsetParameter() error {
+	stmt := fmt.Sprintf("ALTER ACCOUNT UNSET %s", v.key)
+	return v.executor.Execute(stmt)
+}
+
 // SessionParameterBuilder abstracts the creation of SQL queries for Snowflake session parameters.
 type SessionParameterBuilder struct {
 	key       string
@@ -832,6 +889,22 @@ func (v *ObjectParameterBuilder) SetParameter() error {
 	return v.executor.Execute(stmt)
 }
 
+func (v *ObjectParameterBuilder) UnsetParameter() error {
+	if v.onAccount {
+		stmt := fmt.Sprintf("ALTER ACCOUNT UNSET %s", v.key)
+		return v.executor.Execute(stmt)
+	}
+	if v.objectType == "" {
+		return fmt.Errorf("object type is required when unsetting object parameters")
+	}
+	if v.objectIdentifier == "" {
+		return fmt.Errorf("object identifier is required when unsetting object parameters")
+	}
+
+	stmt := fmt.Sprintf("ALTER %s %s UNSET %s", v.objectType, v.objectIdentifier, v.key)
+	return v.executor.Execute(stmt)
+}
+
 type Parameter struct {
 	Key         sql.NullString `db:"key"`
 	Value       sql.NullString `db:"value"`
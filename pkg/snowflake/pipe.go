@@ -2,6 +2,7 @@ package snowflake
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -150,6 +151,28 @@ func (pb *PipeBuilder) RemoveErrorIntegration() string {
 	return fmt.Sprintf(`ALTER PIPE %v UNSET ERROR_INTEGRATION`, pb.QualifiedName())
 }
 
+// ChangePipeExecutionPaused returns the SQL query that will pause or resume the pipe.
+func (pb *PipeBuilder) ChangePipeExecutionPaused(paused bool) string {
+	return fmt.Sprintf(`ALTER PIPE %v SET PIPE_EXECUTION_PAUSED = %t`, pb.QualifiedName(), paused)
+}
+
+// Refresh returns the SQL query that will queue the staged files matching the optional
+// path prefix and modification time for loading by the pipe.
+func (pb *PipeBuilder) Refresh(prefix string, modifiedAfter string) string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`ALTER PIPE %v REFRESH`, pb.QualifiedName()))
+
+	if prefix != "" {
+		q.WriteString(fmt.Sprintf(` PREFIX = '%v'`, EscapeString(prefix)))
+	}
+
+	if modifiedAfter != "" {
+		q.WriteString(fmt.Sprintf(` MODIFIED_AFTER = '%v'`, EscapeString(modifiedAfter)))
+	}
+
+	return q.String()
+}
+
 // Drop returns the SQL query that will drop a pipe.
 func (pb *PipeBuilder) Drop() string {
 	return fmt.Sprintf(`DROP PIPE %v`, pb.QualifiedName())
@@ -160,6 +183,11 @@ func (pb *PipeBuilder) Show() string {
 	return fmt.Sprintf(`SHOW PIPES LIKE '%v' IN SCHEMA "%v"."%v"`, pb.name, pb.db, pb.schema)
 }
 
+// Status returns the SQL query that will return the current status of the pipe.
+func (pb *PipeBuilder) Status() string {
+	return fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS('%v') AS "status"`, EscapeString(pb.QualifiedName()))
+}
+
 type Pipe struct {
 	Createdon           string         `db:"created_on"`
 	Name                string         `db:"name"`
@@ -173,6 +201,40 @@ type Pipe struct {
 	ErrorIntegration    sql.NullString `db:"error_integration"`
 }
 
+type rawPipeStatus struct {
+	Status string `db:"status"`
+}
+
+// PipeStatus is the JSON document returned by SYSTEM$PIPE_STATUS.
+type PipeStatus struct {
+	ExecutionState                  string `json:"executionState"`
+	PendingFileCount                int    `json:"pendingFileCount"`
+	LastIngestedTimestamp           string `json:"lastIngestedTimestamp"`
+	LastIngestedFilePath            string `json:"lastIngestedFilePath"`
+	NotificationChannelName         string `json:"notificationChannelName"`
+	NumOutstandingMessagesOnChannel int    `json:"numOutstandingMessagesOnChannel"`
+	LastReceivedMessageTimestamp    string `json:"lastReceivedMessageTimestamp"`
+	LastForwardedMessageTimestamp   string `json:"lastForwardedMessageTimestamp"`
+	Error                           string `json:"error"`
+}
+
+// IsPaused reports whether the pipe execution has been paused through PIPE_EXECUTION_PAUSED.
+func (ps *PipeStatus) IsPaused() bool {
+	return ps.ExecutionState == "PAUSED"
+}
+
+func ScanPipeStatus(row *sqlx.Row) (*PipeStatus, error) {
+	raw := &rawPipeStatus{}
+	if err := row.StructScan(raw); err != nil {
+		return nil, err
+	}
+	status := &PipeStatus{}
+	if err := json.Unmarshal([]byte(raw.Status), status); err != nil {
+		return nil, fmt.Errorf("unable to parse pipe status %v err = %w", raw.Status, err)
+	}
+	return status, nil
+}
+
 func ScanPipe(row *sqlx.Row) (*Pipe, error) {
 	p := &Pipe{}
 	e := row.StructScan(p)
@@ -52,3 +52,23 @@ func TestPipeShow(t *testing.T) {
 	s := NewPipeBuilder("test_pipe", "test_db", "test_schema")
 	r.Equal(`SHOW PIPES LIKE 'test_pipe' IN SCHEMA "test_db"."test_schema"`, s.Show())
 }
+
+func TestPipeChangePipeExecutionPaused(t *testing.T) {
+	r := require.New(t)
+	s := NewPipeBuilder("test_pipe", "test_db", "test_schema")
+	r.Equal(`ALTER PIPE "test_db"."test_schema"."test_pipe" SET PIPE_EXECUTION_PAUSED = true`, s.ChangePipeExecutionPaused(true))
+	r.Equal(`ALTER PIPE "test_db"."test_schema"."test_pipe" SET PIPE_EXECUTION_PAUSED = false`, s.ChangePipeExecutionPaused(false))
+}
+
+func TestPipeRefresh(t *testing.T) {
+	r := require.New(t)
+	s := NewPipeBuilder("test_pipe", "test_db", "test_schema")
+	r.Equal(`ALTER PIPE "test_db"."test_schema"."test_pipe" REFRESH`, s.Refresh("", ""))
+	r.Equal(`ALTER PIPE "test_db"."test_schema"."test_pipe" REFRESH PREFIX = 'd1/' MODIFIED_AFTER = '2023-01-01T00:00:00Z'`, s.Refresh("d1/", "2023-01-01T00:00:00Z"))
+}
+
+func TestPipeStatus(t *testing.T) {
+	r := require.New(t)
+	s := NewPipeBuilder("test_pipe", "test_db", "test_schema")
+	r.Equal(`SELECT SYSTEM$PIPE_STATUS('"test_db"."test_schema"."test_pipe"') AS "status"`, s.Status())
+}
@@ -12,22 +12,24 @@ import (
 
 // ProcedureBuilder abstracts the creation of Stored Procedure.
 type ProcedureBuilder struct {
-	name              string
-	schema            string
-	db                string
-	argumentTypes     []string // (VARCHAR, VARCHAR)
-	args              []map[string]string
-	returnBehavior    string // VOLATILE, IMMUTABLE
-	nullInputBehavior string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
-	returnType        string
-	language          string // SQL, JAVASCRIPT, JAVA, SCALA
-	packages          []string
-	imports           []string // for Java / Python imports
-	handler           string   // for Java / Python handler
-	executeAs         string
-	comment           string
-	statement         string
-	runtimeVersion    string // for Python runtime version
+	name                       string
+	schema                     string
+	db                         string
+	argumentTypes              []string // (VARCHAR, VARCHAR)
+	args                       []map[string]string
+	returnBehavior             string // VOLATILE, IMMUTABLE
+	nullInputBehavior          string // "CALLED ON NULL INPUT" or "RETURNS NULL ON NULL INPUT"
+	returnType                 string
+	language                   string // SQL, JAVASCRIPT, JAVA, SCALA
+	packages                   []string
+	imports                    []string // for Java / Python imports
+	handler                    string   // for Java / Python handler
+	executeAs                  string
+	externalAccessIntegrations []string
+	secrets                    map[string]string // secret variable name -> fully qualified secret name
+	comment                    string
+	statement                  string
+	runtimeVersion             string // for Python runtime version
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -117,6 +119,18 @@ func (pb *ProcedureBuilder) WithHandler(s string) *ProcedureBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the procedure is allowed to use.
+func (pb *ProcedureBuilder) WithExternalAccessIntegrations(s []string) *ProcedureBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the secrets the procedure can read, keyed by the variable name used in the handler code.
+func (pb *ProcedureBuilder) WithSecrets(s map[string]string) *ProcedureBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithComment adds a comment to the ProcedureBuilder.
 func (pb *ProcedureBuilder) WithComment(c string) *ProcedureBuilder {
 	pb.comment = c
@@ -208,6 +222,7 @@ func (pb *ProcedureBuilder) Create() (string, error) {
 	if pb.handler != "" {
 		q.WriteString(fmt.Sprintf(" HANDLER = '%v'", pb.handler))
 	}
+	q.WriteString(externalAccessClause(pb.externalAccessIntegrations, pb.secrets))
 	if pb.comment != "" {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(pb.comment)))
 	}
@@ -55,44 +55,37 @@ func (b *RoleBuilder) Create() error {
 		}
 		q.WriteString(")")
 	}
-	_, err := b.db.Exec(q.String())
-	return err
+	return Exec(b.db, q.String())
 }
 
 func (b *RoleBuilder) SetComment(comment string) error {
 	q := fmt.Sprintf(`ALTER ROLE "%s" SET COMMENT = '%v'`, b.name, comment)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) UnsetComment() error {
 	q := fmt.Sprintf(`ALTER ROLE "%v" UNSET COMMENT`, b.name)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) UnsetTag(tag TagValue) error {
 	q := fmt.Sprintf(`ALTER ROLE %s UNSET TAG "%v"."%v"."%v"`, b.name, tag.Database, tag.Schema, tag.Name)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) SetTag(tag TagValue) error {
 	q := fmt.Sprintf(`ALTER ROLE %s SET TAG  "%v"."%v"."%v" = "%v"`, b.name, tag.Database, tag.Schema, tag.Name, tag.Value)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) ChangeTag(tag TagValue) error {
 	q := fmt.Sprintf(`ALTER ROLE "%s" SET TAG "%v"."%v"."%v" = "%v"`, b.name, tag.Database, tag.Schema, tag.Name, tag.Value)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) Drop() error {
 	q := fmt.Sprintf(`DROP ROLE "%s"`, b.name)
-	_, err := b.db.Exec(q)
-	return err
+	return Exec(b.db, q)
 }
 
 func (b *RoleBuilder) Show() (*Role, error) {
@@ -105,8 +98,7 @@ func (b *RoleBuilder) Show() (*Role, error) {
 
 func (b *RoleBuilder) Rename(newName string) error {
 	stmt := fmt.Sprintf(`ALTER ROLE "%s" RENAME TO "%s"`, b.name, newName)
-	_, err := b.db.Exec(stmt)
-	return err
+	return Exec(b.db, stmt)
 }
 
 type Role struct {
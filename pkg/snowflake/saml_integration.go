@@ -1,8 +1,13 @@
 package snowflake
 
 import (
+	"crypto/x509"
 	"database/sql"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -39,3 +44,114 @@ func ScanSamlIntegration(row *sqlx.Row) (*SamlIntegration, error) {
 	}
 	return r, nil
 }
+
+// SAMLIdPMetadata holds the settings of a SAML2 integration found in the metadata document of an identity provider.
+type SAMLIdPMetadata struct {
+	Issuer   string
+	SSOURL   string
+	X509Cert string
+}
+
+type samlEntityDescriptor struct {
+	EntityID         string                `xml:"entityID,attr"`
+	IDPSSODescriptor *samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors []struct {
+		Use         string `xml:"use,attr"`
+		Certificate string `xml:"KeyInfo>X509Data>X509Certificate"`
+	} `xml:"KeyDescriptor"`
+	SingleSignOnServices []struct {
+		Binding  string `xml:"Binding,attr"`
+		Location string `xml:"Location,attr"`
+	} `xml:"SingleSignOnService"`
+}
+
+// samlSSOBindings lists the bindings of the IdP SSO URL by preference, Snowflake redirecting the user to the IdP.
+var samlSSOBindings = []string{
+	"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+	"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+}
+
+// ParseSAMLIdPMetadata extracts the issuer, the SSO URL and the signing certificate from the SAML2 metadata document
+// of an identity provider. Both an EntityDescriptor and an EntitiesDescriptor document are accepted, the first entity
+// describing an IdP being used.
+func ParseSAMLIdPMetadata(metadata string) (*SAMLIdPMetadata, error) {
+	var root struct {
+		samlEntityDescriptor
+		EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+	}
+	if err := xml.Unmarshal([]byte(metadata), &root); err != nil {
+		return nil, fmt.Errorf("error parsing SAML metadata err = %w", err)
+	}
+
+	var entity *samlEntityDescriptor
+	entities := append([]samlEntityDescriptor{root.samlEntityDescriptor}, root.EntityDescriptors...)
+	for i := range entities {
+		if entities[i].IDPSSODescriptor != nil {
+			entity = &entities[i]
+			break
+		}
+	}
+	if entity == nil {
+		return nil, errors.New("SAML metadata does not describe an identity provider (IDPSSODescriptor)")
+	}
+	if entity.EntityID == "" {
+		return nil, errors.New("SAML metadata does not contain the entityID of the identity provider")
+	}
+
+	idp := entity.IDPSSODescriptor
+	ssoURL := ""
+	for _, binding := range samlSSOBindings {
+		for _, sso := range idp.SingleSignOnServices {
+			if ssoURL == "" && sso.Binding == binding {
+				ssoURL = sso.Location
+			}
+		}
+	}
+	if ssoURL == "" && len(idp.SingleSignOnServices) > 0 {
+		// an unknown binding is better than no SSO URL at all
+		ssoURL = idp.SingleSignOnServices[0].Location
+	}
+	if ssoURL == "" {
+		return nil, errors.New("SAML metadata does not contain a SingleSignOnService location")
+	}
+
+	cert := ""
+	for _, key := range idp.KeyDescriptors {
+		if cert == "" && (key.Use == "" || key.Use == "signing") {
+			cert = NormalizeSAMLCertificate(key.Certificate)
+		}
+	}
+	if cert == "" {
+		return nil, errors.New("SAML metadata does not contain a signing X509Certificate")
+	}
+
+	return &SAMLIdPMetadata{
+		Issuer:   entity.EntityID,
+		SSOURL:   ssoURL,
+		X509Cert: cert,
+	}, nil
+}
+
+// NormalizeSAMLCertificate returns the certificate as the single line of base64 expected by SAML2_X509_CERT, without
+// the PEM markers and line breaks.
+func NormalizeSAMLCertificate(cert string) string {
+	cert = strings.ReplaceAll(cert, "-----BEGIN CERTIFICATE-----", "")
+	cert = strings.ReplaceAll(cert, "-----END CERTIFICATE-----", "")
+	return strings.Join(strings.Fields(cert), "")
+}
+
+// ParseSAMLCertificate decodes the base64 encoded X.509 certificate of a SAML2 integration.
+func ParseSAMLCertificate(cert string) (*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(NormalizeSAMLCertificate(cert))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding X.509 certificate err = %w", err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing X.509 certificate err = %w", err)
+	}
+	return c, nil
+}
@@ -1,7 +1,9 @@
 package snowflake_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/stretchr/testify/require"
@@ -41,3 +43,71 @@ func TestSamlIntegration(t *testing.T) {
 	e := builder.Drop()
 	r.Equal(`DROP SECURITY INTEGRATION "test_saml_integration"`, e)
 }
+
+const samlTestCertificate = "MIIERTCCAq2gAwIBAgIJAKmtzjCD1+tqMA0GCSqGSIb3DQEBCwUAMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDAeFw0xODA4MTgyMzI0MjNaFw0yODA4MTUyMzI0MjNaMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDCCAaIwDQYJKoZIhvcNAQEBBQADggGPADCCAYoCggGBALhUlY3SkIOze+l8y6dBzM6p7B8OykJWlwizszU16Lih8D7KLhNJfahoVxbPxB3YFM/81PJLOeK2krvJ5zY6CJyQY3sPQAkZKI7I8qq9lmZ2g4QPqybNstXS6YUXJNUt/ixbbK/N97+LKTiSutbD1J7AoFnouMuLjlhN5VRZ43jez4xLSHVZaYuUFKn01Y9oLKbj46LQnZnJCAGpTgPqEQJr6GpVGw43bKyUpGoaPrdDRgRgtPMUWgFDkgcI3QiV1lsKfBs1t1E2UA7ACFnlJZpEuBtwgivzo3VeitiSaF3Jxh25EY5/vABpcgQQRz3RH2l8MMKdRsxb8VT3yh2S+CX55s+cN67LiCPr6f2u+KS1iKfB9mWN6o2S4lcmo82HIBbsuXJV0oA1HrGMyyc4Y9nng/I8iuAp8or1JrWRHQ+8NzO85DWK0rtvtLPxkvw0HK32glyuOP/9F05Z7+tiVIgn67buC0EdoUm1RSpibqmB1ST2PikslOlVbJuy4Ah93wIDAQABo1gwVjA1BgNVHREELjAsgippcC0xNzItMzEtMjgtNjQudXMtd2VzdC0yLmNvbXB1dGUuaW50ZXJuYWwwHQYDVR0OBBYEFAdsTxYfulJ5yunYtgYJHC9IcevzMA0GCSqGSIb3DQEBCwUAA4IBgQB3J6i7KreiHL8NPMglfWLHk1PZOgvIEEpKL+GRebvcbyqgcuc3VVPylq70VvGqhJxp1q/mzLfraUiypzfWFGm9zfwIg0H5TqRZYEPTvgIhIICjaDWRwZBDJG8D5G/KoV60DlUG0crPBlIuCCr/SRa5ZoDQqvucTfr3Rx4Ha6koXFSjoSXllR+jn4GnInhm/WH137a+v35PUcffNxfuehoGn6i4YeXF3cwJK4e35cOFW+dLbnaLk+Ty7HOGvpw86h979C6mJ9qEHYgq9rQyzlSPbLZGZSgVcIezunOaOsWm81BsXRNNJjzHGCqKf8RMhd8oZP55+2/SVRBwnkGyUNCuDPrJcymC95ZT2NW/KeWkz28HF2i31xQmecT2r3lQRSM8acvOXQsNEDCDvJvCzJT9c2AnsnO24r6arPXs/UWAxOI+MjclXPLkLD6uTHV+Oo8XZ7bOjegD5hL6/bKUWnNMurQNGrmi/jvqsCFLDKftl7ajuxKjtodnSuwhoY7NQy8="
+
+func samlTestMetadata(cert string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://www.okta.com/exk1234">
+  <md:IDPSSODescriptor WantAuthnRequestsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>MIIencryption</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>
+%s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://example.okta.com/app/sso/saml/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://example.okta.com/app/sso/saml"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, cert)
+}
+
+func TestParseSAMLIdPMetadata(t *testing.T) {
+	t.Run("entity descriptor", func(t *testing.T) {
+		r := require.New(t)
+		metadata, err := snowflake.ParseSAMLIdPMetadata(samlTestMetadata(samlTestCertificate[:64] + "\n" + samlTestCertificate[64:]))
+		r.NoError(err)
+		r.Equal("http://www.okta.com/exk1234", metadata.Issuer)
+		r.Equal("https://example.okta.com/app/sso/saml", metadata.SSOURL)
+		r.Equal(samlTestCertificate, metadata.X509Cert)
+	})
+
+	t.Run("entities descriptor", func(t *testing.T) {
+		r := require.New(t)
+		metadata, err := snowflake.ParseSAMLIdPMetadata(`<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>
+  <EntityDescriptor entityID="https://idp.example.com">
+    <IDPSSODescriptor>
+      <KeyDescriptor><KeyInfo><X509Data><X509Certificate>MIIcert</X509Certificate></X509Data></KeyInfo></KeyDescriptor>
+      <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP" Location="https://idp.example.com/sso"/>
+    </IDPSSODescriptor>
+  </EntityDescriptor>
+</EntitiesDescriptor>`)
+		r.NoError(err)
+		r.Equal("https://idp.example.com", metadata.Issuer)
+		r.Equal("https://idp.example.com/sso", metadata.SSOURL)
+		r.Equal("MIIcert", metadata.X509Cert)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		r := require.New(t)
+		_, err := snowflake.ParseSAMLIdPMetadata("not xml")
+		r.Error(err)
+		_, err = snowflake.ParseSAMLIdPMetadata(`<EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>`)
+		r.EqualError(err, "SAML metadata does not describe an identity provider (IDPSSODescriptor)")
+		_, err = snowflake.ParseSAMLIdPMetadata(`<EntityDescriptor entityID="https://idp.example.com"><IDPSSODescriptor/></EntityDescriptor>`)
+		r.EqualError(err, "SAML metadata does not contain a SingleSignOnService location")
+	})
+}
+
+func TestParseSAMLCertificate(t *testing.T) {
+	r := require.New(t)
+	cert, err := snowflake.ParseSAMLCertificate("-----BEGIN CERTIFICATE-----\n" + samlTestCertificate + "\n-----END CERTIFICATE-----")
+	r.NoError(err)
+	r.Equal(time.Date(2028, 8, 15, 23, 24, 23, 0, time.UTC), cert.NotAfter)
+
+	_, err = snowflake.ParseSAMLCertificate("MIICdummybase64certificate")
+	r.Error(err)
+}
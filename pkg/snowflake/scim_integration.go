@@ -3,10 +3,25 @@ package snowflake
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// SCIMProvisionerRoles are the roles SCIM integrations run as. Users and roles provisioned by an identity provider are
+// owned by one of them.
+var SCIMProvisionerRoles = []string{"OKTA_PROVISIONER", "AAD_PROVISIONER", "GENERIC_SCIM_PROVISIONER"}
+
+// IsSCIMProvisionerRole reports whether the role is one of the SCIM provisioner roles.
+func IsSCIMProvisionerRole(role string) bool {
+	for _, r := range SCIMProvisionerRoles {
+		if strings.EqualFold(r, role) {
+			return true
+		}
+	}
+	return false
+}
+
 // NewSCIMIntegrationBuilder returns a pointer to a Builder that abstracts the DDL operations for an api integration.
 //
 // Supported DDL operations are:
@@ -14,6 +14,8 @@ const (
 	ObjectTypeUser             ObjectType = "USER"
 	ObjectTypeShare            ObjectType = "SHARE"
 	ObjectTypeTask             ObjectType = "TASK"
+	ObjectTypeFunction         ObjectType = "FUNCTION"
+	ObjectTypeProcedure        ObjectType = "PROCEDURE"
 )
 
 func (o ObjectType) String() string {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -59,3 +60,37 @@ func ListStorageIntegrations(db *sql.DB) ([]StorageIntegration, error) {
 	}
 	return dbs, nil
 }
+
+// StorageIntegrationLocations returns the allowed and blocked locations of a storage integration from DESCRIBE INTEGRATION.
+func StorageIntegrationLocations(db *sql.DB, name string) (allowed []string, blocked []string, err error) {
+	stmt := NewStorageIntegrationBuilder(name).Describe()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	split := func(v string) []string {
+		var locations []string
+		for _, location := range strings.Split(v, ",") {
+			if location = strings.TrimSpace(location); location != "" {
+				locations = append(locations, location)
+			}
+		}
+		return locations
+	}
+	var k, pType string
+	var v, unused sql.NullString
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return nil, nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+		}
+		switch k {
+		case "STORAGE_ALLOWED_LOCATIONS":
+			allowed = split(v.String)
+		case "STORAGE_BLOCKED_LOCATIONS":
+			blocked = split(v.String)
+		}
+	}
+	return allowed, blocked, rows.Err()
+}
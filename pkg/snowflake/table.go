@@ -492,6 +492,24 @@ func NewTableWithColumnDefinitionsBuilder(name, db, schema string, columns Colum
 	}
 }
 
+// NewEventTableBuilder returns a pointer to a Builder that abstracts the DDL operations for an event table.
+// Event tables have a predefined set of columns, so any column definitions on the builder are ignored.
+//
+// Supported DDL operations are:
+//   - CREATE EVENT TABLE
+//   - ALTER TABLE
+//   - DROP TABLE
+//   - SHOW EVENT TABLES
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-event-table)
+func NewEventTableBuilder(name, db, schema string) *TableBuilder {
+	return &TableBuilder{
+		name:   name,
+		db:     db,
+		schema: schema,
+	}
+}
+
 // Create returns the SQL statement required to create a table.
 func (tb *TableBuilder) Create() string {
 	q := strings.Builder{}
@@ -517,6 +535,25 @@ func (tb *TableBuilder) Create() string {
 	return q.String()
 }
 
+// CreateEventTable returns the SQL statement required to create an event table.
+func (tb *TableBuilder) CreateEventTable() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE EVENT TABLE %v`, tb.QualifiedName()))
+
+	if tb.clusterBy != nil {
+		q.WriteString(fmt.Sprintf(` CLUSTER BY LINEAR(%v)`, tb.GetClusterKeyString()))
+	}
+
+	q.WriteString(fmt.Sprintf(` DATA_RETENTION_TIME_IN_DAYS = %d`, tb.dataRetentionTimeInDays))
+	q.WriteString(fmt.Sprintf(` CHANGE_TRACKING = %t`, tb.changeTracking))
+
+	if tb.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(tb.comment)))
+	}
+
+	return q.String()
+}
+
 // ChangeClusterBy returns the SQL query to change cluastering on table.
 func (tb *TableBuilder) ChangeClusterBy(cb string) string {
 	return fmt.Sprintf(`ALTER TABLE %v CLUSTER BY LINEAR(%v)`, tb.QualifiedName(), cb)
@@ -622,6 +659,11 @@ func (tb *TableBuilder) Show() string {
 	return fmt.Sprintf(`SHOW TABLES LIKE '%v' IN SCHEMA "%v"."%v"`, tb.name, tb.db, tb.schema)
 }
 
+// ShowEventTable returns the SQL query that will show an event table.
+func (tb *TableBuilder) ShowEventTable() string {
+	return fmt.Sprintf(`SHOW EVENT TABLES LIKE '%v' IN SCHEMA "%v"."%v"`, tb.name, tb.db, tb.schema)
+}
+
 func (tb *TableBuilder) ShowColumns() string {
 	return fmt.Sprintf(`DESC TABLE %s`, tb.QualifiedName())
 }
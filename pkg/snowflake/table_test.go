@@ -277,3 +277,21 @@ func TestTableRename(t *testing.T) {
 	s := NewTableBuilder("test_table1", "test_db", "test_schema")
 	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table1" RENAME TO "test_db"."test_schema"."test_table2"`, s.Rename("test_table2"))
 }
+
+func TestEventTableCreate(t *testing.T) {
+	r := require.New(t)
+	s := NewEventTableBuilder("test_events", "test_db", "test_schema")
+	r.Equal(`CREATE EVENT TABLE "test_db"."test_schema"."test_events" DATA_RETENTION_TIME_IN_DAYS = 0 CHANGE_TRACKING = false`, s.CreateEventTable())
+
+	s.WithClustering([]string{"timestamp"})
+	s.WithDataRetentionTimeInDays(7)
+	s.WithChangeTracking(true)
+	s.WithComment("Test's Comment")
+	r.Equal(`CREATE EVENT TABLE "test_db"."test_schema"."test_events" CLUSTER BY LINEAR(timestamp) DATA_RETENTION_TIME_IN_DAYS = 7 CHANGE_TRACKING = true COMMENT = 'Test\'s Comment'`, s.CreateEventTable())
+}
+
+func TestEventTableShow(t *testing.T) {
+	r := require.New(t)
+	s := NewEventTableBuilder("test_events", "test_db", "test_schema")
+	r.Equal(`SHOW EVENT TABLES LIKE 'test_events' IN SCHEMA "test_db"."test_schema"`, s.ShowEventTable())
+}
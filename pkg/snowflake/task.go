@@ -31,6 +31,12 @@ type TaskBuilder struct {
 	userTaskManagedInitialWarehouseSize string
 	errorIntegration                    string
 	allowOverlappingExecution           bool
+	finalize                            string
+	suspendTaskAfterNumFailures         int
+	taskAutoRetryAttempts               int
+	config                              string
+	serverlessTaskMinStatementSize      string
+	serverlessTaskMaxStatementSize      string
 }
 
 // GetFullName prepends db and schema to in parameter.
@@ -118,6 +124,42 @@ func (tb *TaskBuilder) WithErrorIntegration(s string) *TaskBuilder {
 	return tb
 }
 
+// WithFinalize makes the task the finalizer task of the given root task.
+func (tb *TaskBuilder) WithFinalize(rootTask string) *TaskBuilder {
+	tb.finalize = rootTask
+	return tb
+}
+
+// WithSuspendTaskAfterNumFailures adds the number of consecutive failed runs after which the task is suspended to the TaskBuilder.
+func (tb *TaskBuilder) WithSuspendTaskAfterNumFailures(n int) *TaskBuilder {
+	tb.suspendTaskAfterNumFailures = n
+	return tb
+}
+
+// WithTaskAutoRetryAttempts adds the number of automatic retries of a failed graph run to the TaskBuilder.
+func (tb *TaskBuilder) WithTaskAutoRetryAttempts(n int) *TaskBuilder {
+	tb.taskAutoRetryAttempts = n
+	return tb
+}
+
+// WithConfig adds a JSON configuration string, available to the tasks of the graph, to the TaskBuilder.
+func (tb *TaskBuilder) WithConfig(config string) *TaskBuilder {
+	tb.config = config
+	return tb
+}
+
+// WithServerlessTaskMinStatementSize adds the minimum serverless compute size to the TaskBuilder.
+func (tb *TaskBuilder) WithServerlessTaskMinStatementSize(size string) *TaskBuilder {
+	tb.serverlessTaskMinStatementSize = size
+	return tb
+}
+
+// WithServerlessTaskMaxStatementSize adds the maximum serverless compute size to the TaskBuilder.
+func (tb *TaskBuilder) WithServerlessTaskMaxStatementSize(size string) *TaskBuilder {
+	tb.serverlessTaskMaxStatementSize = size
+	return tb
+}
+
 // Task returns a pointer to a Builder that abstracts the DDL operations for a task.
 //
 // Supported DDL operations are:
@@ -183,6 +225,26 @@ func (tb *TaskBuilder) Create() string {
 		q.WriteString(fmt.Sprintf(` USER_TASK_TIMEOUT_MS = %v`, tb.userTaskTimeoutMS))
 	}
 
+	if tb.suspendTaskAfterNumFailures > 0 {
+		q.WriteString(fmt.Sprintf(` SUSPEND_TASK_AFTER_NUM_FAILURES = %v`, tb.suspendTaskAfterNumFailures))
+	}
+
+	if tb.taskAutoRetryAttempts > 0 {
+		q.WriteString(fmt.Sprintf(` TASK_AUTO_RETRY_ATTEMPTS = %v`, tb.taskAutoRetryAttempts))
+	}
+
+	if tb.warehouse == "" && tb.serverlessTaskMinStatementSize != "" {
+		q.WriteString(fmt.Sprintf(` SERVERLESS_TASK_MIN_STATEMENT_SIZE = '%v'`, EscapeString(tb.serverlessTaskMinStatementSize)))
+	}
+
+	if tb.warehouse == "" && tb.serverlessTaskMaxStatementSize != "" {
+		q.WriteString(fmt.Sprintf(` SERVERLESS_TASK_MAX_STATEMENT_SIZE = '%v'`, EscapeString(tb.serverlessTaskMaxStatementSize)))
+	}
+
+	if tb.config != "" {
+		q.WriteString(fmt.Sprintf(` CONFIG = $$%v$$`, tb.config))
+	}
+
 	if len(tb.after) > 0 {
 		after := make([]string, 0)
 		for _, a := range tb.after {
@@ -191,6 +253,10 @@ func (tb *TaskBuilder) Create() string {
 		q.WriteString(fmt.Sprintf(` AFTER %v`, strings.Join(after, ", ")))
 	}
 
+	if tb.finalize != "" {
+		q.WriteString(fmt.Sprintf(` FINALIZE = %v`, tb.GetFullName(tb.finalize)))
+	}
+
 	if tb.when != "" {
 		q.WriteString(fmt.Sprintf(` WHEN %v`, tb.when))
 	}
@@ -237,6 +303,56 @@ func (tb *TaskBuilder) RemoveTimeout() string {
 	return fmt.Sprintf(`ALTER TASK %v UNSET USER_TASK_TIMEOUT_MS`, tb.QualifiedName())
 }
 
+// ChangeSuspendTaskAfterNumFailures returns the sql that will change the number of consecutive failed runs after which the task is suspended.
+func (tb *TaskBuilder) ChangeSuspendTaskAfterNumFailures(n int) string {
+	return fmt.Sprintf(`ALTER TASK %v SET SUSPEND_TASK_AFTER_NUM_FAILURES = %v`, tb.QualifiedName(), n)
+}
+
+// RemoveSuspendTaskAfterNumFailures returns the sql that will reset the number of consecutive failed runs after which the task is suspended.
+func (tb *TaskBuilder) RemoveSuspendTaskAfterNumFailures() string {
+	return fmt.Sprintf(`ALTER TASK %v UNSET SUSPEND_TASK_AFTER_NUM_FAILURES`, tb.QualifiedName())
+}
+
+// ChangeTaskAutoRetryAttempts returns the sql that will change the number of automatic retries of a failed graph run.
+func (tb *TaskBuilder) ChangeTaskAutoRetryAttempts(n int) string {
+	return fmt.Sprintf(`ALTER TASK %v SET TASK_AUTO_RETRY_ATTEMPTS = %v`, tb.QualifiedName(), n)
+}
+
+// RemoveTaskAutoRetryAttempts returns the sql that will reset the number of automatic retries of a failed graph run.
+func (tb *TaskBuilder) RemoveTaskAutoRetryAttempts() string {
+	return fmt.Sprintf(`ALTER TASK %v UNSET TASK_AUTO_RETRY_ATTEMPTS`, tb.QualifiedName())
+}
+
+// ChangeServerlessTaskMinStatementSize returns the sql that will change the minimum serverless compute size of the task.
+func (tb *TaskBuilder) ChangeServerlessTaskMinStatementSize(size string) string {
+	return fmt.Sprintf(`ALTER TASK %v SET SERVERLESS_TASK_MIN_STATEMENT_SIZE = '%v'`, tb.QualifiedName(), EscapeString(size))
+}
+
+// RemoveServerlessTaskMinStatementSize returns the sql that will reset the minimum serverless compute size of the task.
+func (tb *TaskBuilder) RemoveServerlessTaskMinStatementSize() string {
+	return fmt.Sprintf(`ALTER TASK %v UNSET SERVERLESS_TASK_MIN_STATEMENT_SIZE`, tb.QualifiedName())
+}
+
+// ChangeServerlessTaskMaxStatementSize returns the sql that will change the maximum serverless compute size of the task.
+func (tb *TaskBuilder) ChangeServerlessTaskMaxStatementSize(size string) string {
+	return fmt.Sprintf(`ALTER TASK %v SET SERVERLESS_TASK_MAX_STATEMENT_SIZE = '%v'`, tb.QualifiedName(), EscapeString(size))
+}
+
+// RemoveServerlessTaskMaxStatementSize returns the sql that will reset the maximum serverless compute size of the task.
+func (tb *TaskBuilder) RemoveServerlessTaskMaxStatementSize() string {
+	return fmt.Sprintf(`ALTER TASK %v UNSET SERVERLESS_TASK_MAX_STATEMENT_SIZE`, tb.QualifiedName())
+}
+
+// ChangeConfig returns the sql that will change the configuration string of the task.
+func (tb *TaskBuilder) ChangeConfig(config string) string {
+	return fmt.Sprintf(`ALTER TASK %v SET CONFIG = $$%v$$`, tb.QualifiedName(), config)
+}
+
+// RemoveConfig returns the sql that will remove the configuration string of the task.
+func (tb *TaskBuilder) RemoveConfig() string {
+	return fmt.Sprintf(`ALTER TASK %v UNSET CONFIG`, tb.QualifiedName())
+}
+
 // ChangeComment returns the sql that will change the comment for the task.
 func (tb *TaskBuilder) ChangeComment(newComment string) string {
 	return fmt.Sprintf(`ALTER TASK %v SET COMMENT = '%v'`, tb.QualifiedName(), EscapeString(newComment))
@@ -307,6 +423,11 @@ func (tb *TaskBuilder) ChangeCondition(newCondition string) string {
 	return fmt.Sprintf(`ALTER TASK %v MODIFY WHEN %v`, tb.QualifiedName(), newCondition)
 }
 
+// RemoveCondition returns the sql that will remove the WHEN condition from the task.
+func (tb *TaskBuilder) RemoveCondition() string {
+	return fmt.Sprintf(`ALTER TASK %v REMOVE WHEN`, tb.QualifiedName())
+}
+
 // ChangeSQLStatement returns the sql that will update the sql the task executes.
 func (tb *TaskBuilder) ChangeSQLStatement(newStatement string) string {
 	return fmt.Sprintf(`ALTER TASK %v MODIFY AS %v`, tb.QualifiedName(), UnescapeString(newStatement))
@@ -322,6 +443,21 @@ func (tb *TaskBuilder) Resume() string {
 	return fmt.Sprintf(`ALTER TASK %v RESUME`, tb.QualifiedName())
 }
 
+// EnableDependents returns the sql that will resume the task and all of its dependent tasks.
+func (tb *TaskBuilder) EnableDependents() string {
+	return fmt.Sprintf(`SELECT SYSTEM$TASK_DEPENDENTS_ENABLE('%v')`, EscapeString(tb.QualifiedName()))
+}
+
+// Execute returns the sql that will trigger a single run of the task outside of its schedule.
+func (tb *TaskBuilder) Execute() string {
+	return fmt.Sprintf(`EXECUTE TASK %v`, tb.QualifiedName())
+}
+
+// LastRun returns the sql that will select the most recent non-scheduled run of the task from the task history.
+func (tb *TaskBuilder) LastRun() string {
+	return fmt.Sprintf(`SELECT "STATE" AS "state", "SCHEDULED_TIME" AS "scheduled_time", "COMPLETED_TIME" AS "completed_time", "ERROR_MESSAGE" AS "error_message" FROM TABLE("%v".INFORMATION_SCHEMA.TASK_HISTORY(TASK_NAME => '%v')) WHERE "SCHEMA_NAME" = '%v' AND "STATE" <> 'SCHEDULED' ORDER BY "SCHEDULED_TIME" DESC LIMIT 1`, EscapeString(tb.db), EscapeString(tb.name), EscapeString(tb.schema))
+}
+
 // Drop returns the sql that will remove the task.
 func (tb *TaskBuilder) Drop() string {
 	return fmt.Sprintf(`DROP TASK %v`, tb.QualifiedName())
@@ -388,6 +524,7 @@ type Task struct {
 	Condition                 *string        `db:"condition"`
 	ErrorIntegration          sql.NullString `db:"error_integration"`
 	AllowOverlappingExecution sql.NullString `db:"allow_overlapping_execution"`
+	Config                    sql.NullString `db:"config"`
 }
 
 func (t *Task) QualifiedName() string {
@@ -440,6 +577,21 @@ func ScanTask(row *sqlx.Row) (*Task, error) {
 	return t, e
 }
 
+// TaskRun represents a single run of a task in the task history.
+type TaskRun struct {
+	State         string         `db:"state"`
+	ScheduledTime sql.NullString `db:"scheduled_time"`
+	CompletedTime sql.NullString `db:"completed_time"`
+	ErrorMessage  sql.NullString `db:"error_message"`
+}
+
+// ScanTaskRun turns a sql row into a task run object.
+func ScanTaskRun(row *sqlx.Row) (*TaskRun, error) {
+	r := &TaskRun{}
+	e := row.StructScan(r)
+	return r, e
+}
+
 // TaskParams struct to represent a row of parameters.
 type TaskParams struct {
 	Key          string `db:"key"`
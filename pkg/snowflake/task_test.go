@@ -172,3 +172,68 @@ func TestSetAllowOverlappingExecution(t *testing.T) {
 	st := NewTaskBuilder("test_task", "test_db", "test_schema")
 	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET ALLOW_OVERLAPPING_EXECUTION = TRUE`, st.SetAllowOverlappingExecutionParameter())
 }
+
+func TestTaskCreateFinalizer(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_finalizer", "test_db", "test_schema")
+	st.WithWarehouse("test_wh")
+	st.WithFinalize("test_root")
+	st.WithStatement("SELECT 1")
+	r.Equal(`CREATE TASK "test_db"."test_schema"."test_finalizer" WAREHOUSE = "test_wh" FINALIZE = "test_db"."test_schema"."test_root" AS SELECT 1`, st.Create())
+}
+
+func TestRemoveCondition(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" REMOVE WHEN`, st.RemoveCondition())
+}
+
+func TestEnableDependents(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	r.Equal(`SELECT SYSTEM$TASK_DEPENDENTS_ENABLE('"test_db"."test_schema"."test_task"')`, st.EnableDependents())
+}
+
+func TestTaskCreateExecutionControls(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	st.WithSchedule("5 MINUTE")
+	st.WithSuspendTaskAfterNumFailures(3)
+	st.WithTaskAutoRetryAttempts(2)
+	st.WithServerlessTaskMinStatementSize("XSMALL")
+	st.WithServerlessTaskMaxStatementSize("LARGE")
+	st.WithConfig(`{"output_dir": "/temp/test_directory/"}`)
+	st.WithStatement("SELECT 1")
+	r.Equal(`CREATE TASK "test_db"."test_schema"."test_task" SCHEDULE = '5 MINUTE' SUSPEND_TASK_AFTER_NUM_FAILURES = 3 TASK_AUTO_RETRY_ATTEMPTS = 2 SERVERLESS_TASK_MIN_STATEMENT_SIZE = 'XSMALL' SERVERLESS_TASK_MAX_STATEMENT_SIZE = 'LARGE' CONFIG = $${"output_dir": "/temp/test_directory/"}$$ AS SELECT 1`, st.Create())
+
+	// serverless sizing only applies to tasks without a warehouse
+	st.WithWarehouse("test_wh")
+	r.Equal(`CREATE TASK "test_db"."test_schema"."test_task" WAREHOUSE = "test_wh" SCHEDULE = '5 MINUTE' SUSPEND_TASK_AFTER_NUM_FAILURES = 3 TASK_AUTO_RETRY_ATTEMPTS = 2 CONFIG = $${"output_dir": "/temp/test_directory/"}$$ AS SELECT 1`, st.Create())
+}
+
+func TestChangeExecutionControls(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET SUSPEND_TASK_AFTER_NUM_FAILURES = 5`, st.ChangeSuspendTaskAfterNumFailures(5))
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" UNSET SUSPEND_TASK_AFTER_NUM_FAILURES`, st.RemoveSuspendTaskAfterNumFailures())
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET TASK_AUTO_RETRY_ATTEMPTS = 1`, st.ChangeTaskAutoRetryAttempts(1))
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" UNSET TASK_AUTO_RETRY_ATTEMPTS`, st.RemoveTaskAutoRetryAttempts())
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET SERVERLESS_TASK_MIN_STATEMENT_SIZE = 'SMALL'`, st.ChangeServerlessTaskMinStatementSize("SMALL"))
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" UNSET SERVERLESS_TASK_MIN_STATEMENT_SIZE`, st.RemoveServerlessTaskMinStatementSize())
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET SERVERLESS_TASK_MAX_STATEMENT_SIZE = 'LARGE'`, st.ChangeServerlessTaskMaxStatementSize("LARGE"))
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" UNSET SERVERLESS_TASK_MAX_STATEMENT_SIZE`, st.RemoveServerlessTaskMaxStatementSize())
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" SET CONFIG = $${"a": 1}$$`, st.ChangeConfig(`{"a": 1}`))
+	r.Equal(`ALTER TASK "test_db"."test_schema"."test_task" UNSET CONFIG`, st.RemoveConfig())
+}
+
+func TestExecute(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	r.Equal(`EXECUTE TASK "test_db"."test_schema"."test_task"`, st.Execute())
+}
+
+func TestLastRun(t *testing.T) {
+	r := require.New(t)
+	st := NewTaskBuilder("test_task", "test_db", "test_schema")
+	r.Equal(`SELECT "STATE" AS "state", "SCHEDULED_TIME" AS "scheduled_time", "COMPLETED_TIME" AS "completed_time", "ERROR_MESSAGE" AS "error_message" FROM TABLE("test_db".INFORMATION_SCHEMA.TASK_HISTORY(TASK_NAME => 'test_task')) WHERE "SCHEMA_NAME" = 'test_schema' AND "STATE" <> 'SCHEDULED' ORDER BY "SCHEDULED_TIME" DESC LIMIT 1`, st.LastRun())
+}
@@ -28,9 +28,12 @@ type User struct {
 	Email                 sql.NullString `db:"email"`
 	FirstName             sql.NullString `db:"first_name"`
 	HasRsaPublicKey       bool           `db:"has_rsa_public_key"`
+	RsaPublicKeyFp        sql.NullString `db:"rsa_public_key_fp"`
+	RsaPublicKey2Fp       sql.NullString `db:"rsa_public_key_2_fp"`
 	LastName              sql.NullString `db:"last_name"`
 	LoginName             sql.NullString `db:"login_name"`
 	Name                  sql.NullString `db:"name"`
+	Type                  sql.NullString `db:"type"`
 }
 
 func ScanUser(row *sqlx.Row) (*User, error) {
@@ -81,12 +84,17 @@ func ScanUserDescription(rows *sqlx.Rows) (*User, error) {
 			r.FirstName = userProp.Value
 		case "RSA_PUBLIC_KEY_FP":
 			r.HasRsaPublicKey = userProp.Value.Valid
+			r.RsaPublicKeyFp = userProp.Value
+		case "RSA_PUBLIC_KEY_2_FP":
+			r.RsaPublicKey2Fp = userProp.Value
 		case "LAST_NAME":
 			r.LastName = userProp.Value
 		case "LOGIN_NAME":
 			r.LoginName = userProp.Value
 		case "NAME":
 			r.Name = userProp.Value
+		case "TYPE":
+			r.Type = userProp.Value
 		}
 	}
 